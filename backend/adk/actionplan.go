@@ -0,0 +1,31 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// actionPlanLevel is the lowest threat level that gets an action plan
+const actionPlanLevel = ThreatHigh
+
+// actionPlan builds ordered steps against a competitor: first exploit each
+// weakness, then defend against each strength. Competitors below
+// actionPlanLevel get no plan.
+func actionPlan(threatLevel string, competitor CompetitorData) []string {
+	if threatSeverity[threatLevel] < threatSeverity[actionPlanLevel] {
+		return nil
+	}
+
+	plan := make([]string, 0, len(competitor.Weaknesses)+len(competitor.Strengths))
+	for _, weakness := range competitor.Weaknesses {
+		plan = append(plan, fmt.Sprintf("Exploit their %s by %s",
+			strings.ToLower(weakness),
+			matchTactic(weakness, opportunityTactics, "positioning your offering directly against this gap")))
+	}
+	for _, strength := range competitor.Strengths {
+		plan = append(plan, fmt.Sprintf("Defend against their %s by %s",
+			strings.ToLower(strength),
+			matchTactic(strength, riskTactics, "reinforcing your own differentiation and monitoring them closely")))
+	}
+	return plan
+}
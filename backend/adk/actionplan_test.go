@@ -0,0 +1,57 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestActionPlan(t *testing.T) {
+	competitor := CompetitorData{
+		Strengths:  []string{"Strong brand"},
+		Weaknesses: []string{"High price", "Limited features"},
+	}
+
+	tests := []struct {
+		threatLevel string
+		wantSteps   int
+	}{
+		{ThreatHigh, 3},
+		{ThreatMedium, 0},
+		{ThreatLow, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.threatLevel, func(t *testing.T) {
+			plan := actionPlan(tt.threatLevel, competitor)
+			if len(plan) != tt.wantSteps {
+				t.Fatalf("Expected %d steps, got %v", tt.wantSteps, plan)
+			}
+			if tt.wantSteps == 0 {
+				return
+			}
+			if !strings.HasPrefix(plan[0], "Exploit their high price") {
+				t.Errorf("Expected weaknesses to be exploited first, got %q", plan[0])
+			}
+			if !strings.HasPrefix(plan[2], "Defend against their strong brand") {
+				t.Errorf("Expected strengths to be defended last, got %q", plan[2])
+			}
+		})
+	}
+}
+
+func TestActionPlanInReport(t *testing.T) {
+	report, err := NewCompetitorIntelligenceAgent().Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, analysis := range report.Competitors {
+		if analysis.ThreatLevel == ThreatHigh && len(analysis.ActionPlan) == 0 {
+			t.Errorf("Expected action plan for High-threat %s", analysis.CompetitorName)
+		}
+		if analysis.ThreatLevel != ThreatHigh && len(analysis.ActionPlan) != 0 {
+			t.Errorf("Expected no action plan for %s-threat %s", analysis.ThreatLevel, analysis.CompetitorName)
+		}
+	}
+}
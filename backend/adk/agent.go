@@ -17,76 +17,256 @@ type CompetitorData struct {
 	MarketShare float64  `json:"market_share"`
 	Strengths   []string `json:"strengths"`
 	Weaknesses  []string `json:"weaknesses"`
+	Funding     float64  `json:"funding,omitempty"`     // total raised, USD millions
+	Price       float64  `json:"price,omitempty"`       // list price, USD per month
+	PriceMax    float64  `json:"price_max,omitempty"`   // top of a price range, if any
+	GrowthRate  float64  `json:"growth_rate,omitempty"` // annual growth, percent
+	Aliases     []string `json:"aliases,omitempty"`
+	TechStack   []string `json:"tech_stack,omitempty"`
+	// Industries lists every researched industry this competitor appeared in
+	Industries []string `json:"industries,omitempty"`
+	KeyPeople  []Person `json:"key_people,omitempty"`
+	// Notes are analyst annotations carried through to the report
+	Notes []string `json:"notes,omitempty"`
+	// Relationships are partner/parent/acquisition links to other companies
+	Relationships []Relationship `json:"relationships,omitempty"`
+	// Category classes the competitor, e.g. direct or indirect
+	Category string `json:"category,omitempty"`
+	// Customers is the competitor's customer count, if known
+	Customers int `json:"customers,omitempty"`
+	// ObservedAt records when strengths and weaknesses were observed, keyed
+	// by their text; older entries weigh less in the analysis
+	ObservedAt map[string]time.Time `json:"observed_at,omitempty"`
+	// RecentNews holds the latest headlines about the competitor
+	RecentNews []NewsItem `json:"recent_news,omitempty"`
+
+	ScreenshotURL string         `json:"screenshot_url,omitempty"`
+	WebsiteStatus *WebsiteStatus `json:"website_status,omitempty"`
+
+	// Completeness is the fraction of expected fields populated (0-1)
+	Completeness float64 `json:"completeness"`
+
+	// NormalizedShare is MarketShare rescaled for charting when share
+	// normalization is enabled
+	NormalizedShare float64 `json:"normalized_share,omitempty"`
+
+	// ShareUncertainty is the spread of market share across weighted sources
+	ShareUncertainty float64 `json:"share_uncertainty,omitempty"`
+
+	// Confidence is the source's confidence in this record (0-1, 0 = unrated)
+	Confidence  float64   `json:"confidence,omitempty"`
+	RetrievedAt time.Time `json:"retrieved_at"`
+	Source      string    `json:"source,omitempty"`
+	// FieldSources names the data source that supplied each field, keyed by
+	// JSON field name; it is only set for data merged from several sources
+	FieldSources map[string]string `json:"field_sources,omitempty"`
 }
 
 // CompetitorAnalysis represents analyzed competitive positioning
 type CompetitorAnalysis struct {
-	CompetitorName     string   `json:"competitor_name"`
-	ThreatLevel        string   `json:"threat_level"`
-	Positioning        string   `json:"positioning"`
-	KeyDifferentiators []string `json:"key_differentiators"`
-	Opportunities      []string `json:"opportunities"`
-	Risks              []string `json:"risks"`
+	CompetitorName     string             `json:"competitor_name"`
+	ThreatLevel        string             `json:"threat_level"`
+	ThreatScore        float64            `json:"threat_score"`
+	EmergingThreat     bool               `json:"emerging_threat,omitempty"`
+	Rank               int                `json:"rank,omitempty"`
+	ScoreBreakdown     map[string]float64 `json:"score_breakdown,omitempty"`
+	MarketShare        float64            `json:"market_share"`
+	NormalizedShare    float64            `json:"normalized_share,omitempty"`
+	Funding            float64            `json:"funding,omitempty"`
+	Momentum           string             `json:"momentum"`
+	Positioning        string             `json:"positioning"`
+	KeyDifferentiators []string           `json:"key_differentiators"`
+	TechStack          []string           `json:"tech_stack,omitempty"`
+	Weaknesses         []string           `json:"weaknesses,omitempty"`
+	Opportunities      []string           `json:"opportunities"`
+	Risks              []string           `json:"risks"`
+	ScreenshotURL      string             `json:"screenshot_url,omitempty"`
+	WebsiteStatus      *WebsiteStatus     `json:"website_status,omitempty"`
+	Completeness       float64            `json:"completeness"`
+	Confidence         float64            `json:"confidence"`
+	Industries         []string           `json:"industries,omitempty"`
+	Leadership         []Person           `json:"leadership,omitempty"`
+	ActionPlan         []string           `json:"action_plan,omitempty"`
+	Notes              []string           `json:"notes,omitempty"`
+
+	// PositioningInferred marks positioning guessed from share, not pricing
+	PositioningInferred bool `json:"positioning_inferred,omitempty"`
+
+	// ShareUncertainty is the spread of market share across weighted sources
+	ShareUncertainty float64 `json:"share_uncertainty,omitempty"`
+
+	// NormalizedThreatScore is ThreatScore rescaled against the report's
+	// other competitors when score normalization is enabled
+	NormalizedThreatScore *float64 `json:"normalized_threat_score,omitempty"`
+
+	// Relationships are partner/parent/acquisition links to other companies
+	Relationships []Relationship `json:"relationships,omitempty"`
+
+	// ValuePosition places price against strengths: overpriced, bargain or fair
+	ValuePosition string `json:"value_position,omitempty"`
+
+	// PricingTier is the competitor's pricing tier label, e.g. Premium
+	PricingTier string `json:"pricing_tier,omitempty"`
+
+	// Category classes the competitor, e.g. direct or indirect
+	Category string `json:"category,omitempty"`
+
+	// Customers is the competitor's customer count, if known
+	Customers int `json:"customers,omitempty"`
+
+	// RecentNews holds the latest headlines about the competitor
+	RecentNews []NewsItem `json:"recent_news,omitempty"`
+
+	// BenchmarkNotes flag where the competitor departs from its industry's norms
+	BenchmarkNotes []string `json:"benchmark_notes,omitempty"`
+
+	// OpportunityPriorities scores each of Opportunities, which are listed
+	// in the same order
+	OpportunityPriorities []PrioritizedOpportunity `json:"opportunity_priorities,omitempty"`
+
+	// FeatureSupport rates each configured feature yes, partial or no
+	FeatureSupport map[string]FeatureSupport `json:"feature_support,omitempty"`
+
+	// Provenance names the data source behind each sourced field, keyed by
+	// JSON field name
+	Provenance map[string]string `json:"provenance,omitempty"`
+
+	// DataWarnings flag inputs that contradict each other or are malformed
+	DataWarnings []string `json:"data_warnings,omitempty"`
+
+	// Highlighted marks a competitor of interest, pinned to the top of the
+	// report with a FocusSummary and every Markdown section
+	Highlighted  bool   `json:"highlighted,omitempty"`
+	FocusSummary string `json:"focus_summary,omitempty"`
+
+	// EmergingPlayer marks a small, fast-growing competitor with several
+	// recent strengths, whatever its threat level
+	EmergingPlayer bool `json:"emerging_player,omitempty"`
+
+	// StalenessDiscount is how many points ThreatScore lost to threat decay
+	// because the competitor's data is stale
+	StalenessDiscount float64 `json:"staleness_discount,omitempty"`
 }
 
 // CompetitorReport represents the final intelligence report
 type CompetitorReport struct {
+	ID              string               `json:"id,omitempty"`
 	GeneratedAt     time.Time            `json:"generated_at"`
 	TargetCompany   string               `json:"target_company"`
 	Competitors     []CompetitorAnalysis `json:"competitors"`
 	MarketInsights  string               `json:"market_insights"`
 	Recommendations []string             `json:"recommendations"`
+
+	// ExecutiveSummary leads the report, emphasizing what Persona cares about
+	ExecutiveSummary string  `json:"executive_summary,omitempty"`
+	Persona          Persona `json:"persona,omitempty"`
+
+	RecommendationDetails []Recommendation `json:"recommendation_details,omitempty"`
+	ConfidenceNote        string           `json:"confidence_note,omitempty"`
+
+	// RecommendationGroups buckets Recommendations by category
+	RecommendationGroups []RecommendationGroup `json:"recommendation_groups,omitempty"`
+
+	// CategoryShares sums market share per competitor category when any
+	// competitor is categorized
+	CategoryShares []CategoryShare `json:"category_shares,omitempty"`
+
+	// OthersShare is the market share outside the tracked competitors when
+	// share normalization is enabled
+	OthersShare float64 `json:"others_share,omitempty"`
+
+	// OmittedCompetitors counts competitors filtered out below MinThreatLevel
+	OmittedCompetitors int `json:"omitted_competitors,omitempty"`
+
+	// TierOmissions counts competitors dropped per threat level by TierCaps
+	TierOmissions map[string]int `json:"tier_omissions,omitempty"`
+
+	// ExcludedByProduct counts competitors dropped by a products keyword filter
+	ExcludedByProduct int `json:"excluded_by_product,omitempty"`
+
+	Meta *ReportMeta `json:"meta,omitempty"`
+
+	// SkippedSteps names optional enrichment skipped to meet the time budget
+	SkippedSteps []string `json:"skipped_steps,omitempty"`
+
+	// Sampling notes how a large competitor set was reduced before analysis
+	Sampling *SamplingInfo `json:"sampling,omitempty"`
+
+	// Inputs records what the report was generated from; it is kept with
+	// stored reports so they can be replayed
+	Inputs *ReportInputs `json:"inputs,omitempty"`
+
+	// Pseudonyms maps pseudonyms back to competitor names in anonymized
+	// reports; it is only disclosed to authorized callers
+	Pseudonyms map[string]string `json:"pseudonyms,omitempty"`
+
+	// MarketViability judges whether the market is worth entering
+	MarketViability *MarketViability `json:"market_viability,omitempty"`
+
+	// FeatureMatrix compares configured features across competitors
+	FeatureMatrix *FeatureMatrix `json:"feature_matrix,omitempty"`
+
+	// EmergingPlayers names the emerging players analyzed, including any
+	// filtered or capped out of Competitors
+	EmergingPlayers []string `json:"emerging_players,omitempty"`
+
+	// ScoreNormalization names how NormalizedThreatScore was computed
+	ScoreNormalization ScoreNormalization `json:"score_normalization,omitempty"`
+
+	// Industries are the industries researched, and IndustrySource whether
+	// they were provided or inferred from the company name
+	Industries     []string       `json:"industries,omitempty"`
+	IndustrySource IndustrySource `json:"industry_source,omitempty"`
+
+	// Partial is set when later stages failed and only earlier results are present
+	Partial       bool     `json:"partial,omitempty"`
+	MissingStages []string `json:"missing_stages,omitempty"`
 }
 
 // CompetitorIntelligenceAgent provides tools for competitor analysis
 type CompetitorIntelligenceAgent struct {
 	Name        string
 	Description string
+	Config      Config
+
+	source           DataSource
+	store            ReportStore
+	enrichers        []Enricher
+	insightsTemplate *InsightsTemplate
+	timezone         *time.Location
+	analysisCache    *analysisCache
+	industryInferrer IndustryInferrer
 }
 
 // NewCompetitorIntelligenceAgent creates a new agent instance
-func NewCompetitorIntelligenceAgent() *CompetitorIntelligenceAgent {
-	return &CompetitorIntelligenceAgent{
+func NewCompetitorIntelligenceAgent(opts ...Option) *CompetitorIntelligenceAgent {
+	agent := &CompetitorIntelligenceAgent{
 		Name:        "CompetitorIntelligenceAgent",
 		Description: "Analyzes competitor data and generates competitive intelligence reports",
 	}
+	for _, opt := range opts {
+		opt(agent)
+	}
+	return agent
 }
 
-// MarketResearch searches for competitor data
+// MarketResearch searches for competitor data using the configured data
+// source, or returns caller-supplied data carried by ctx
 func (a *CompetitorIntelligenceAgent) MarketResearch(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
-	// Simulated market research - in production, this would call external APIs
-	// like Crunchbase, LinkedIn, or industry-specific data sources
-	competitors := []CompetitorData{
-		{
-			Name:        "Competitor A",
-			Website:     "https://competitor-a.com",
-			Industry:    industry,
-			Products:    []string{"Product 1", "Product 2", "Product 3"},
-			Pricing:     "Premium",
-			MarketShare: 25.5,
-			Strengths:   []string{"Strong brand", "Large customer base", "Innovation"},
-			Weaknesses:  []string{"High prices", "Slow support", "Limited features"},
-		},
-		{
-			Name:        "Competitor B",
-			Website:     "https://competitor-b.com",
-			Industry:    industry,
-			Products:    []string{"Product X", "Product Y"},
-			Pricing:     "Mid-range",
-			MarketShare: 18.2,
-			Strengths:   []string{"Affordable", "Good UX", "Fast growth"},
-			Weaknesses:  []string{"Limited market presence", "Newer player", "Fewer integrations"},
-		},
-		{
-			Name:        "Competitor C",
-			Website:     "https://competitor-c.com",
-			Industry:    industry,
-			Products:    []string{"Enterprise Suite"},
-			Pricing:     "Enterprise",
-			MarketShare: 12.8,
-			Strengths:   []string{"Enterprise features", "Security", "Compliance"},
-			Weaknesses:  []string{"Expensive", "Complex setup", "Steep learning curve"},
-		},
+	source := a.DataSource()
+	if data, ok := suppliedCompetitors(ctx); ok {
+		source = suppliedSource{data: data}
+	}
+	competitors, err := source.FetchCompetitors(ctx, companyName, industry)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source.Name(), err)
+	}
+
+	// Tag records with their origin for provenance and freshness tracking
+	for i := range competitors {
+		if competitors[i].Source == "" {
+			competitors[i].Source = source.Name()
+		}
 	}
 
 	return competitors, nil
@@ -94,112 +274,317 @@ func (a *CompetitorIntelligenceAgent) MarketResearch(ctx context.Context, compan
 
 // Analyze performs competitive positioning analysis
 func (a *CompetitorIntelligenceAgent) Analyze(ctx context.Context, data []CompetitorData) ([]CompetitorAnalysis, error) {
+	// Identical inputs analyzed in the same time bucket reuse an earlier
+	// result when caching is enabled, and concurrent identical inputs share a
+	// single computation
+	if a.analysisCache != nil {
+		if key, ok := analysisKey(data, analysisTime(ctx)); ok {
+			return a.analysisCache.load(ctx, key, func() []CompetitorAnalysis {
+				return a.analyze(ctx, data)
+			}), nil
+		}
+	}
+	return a.analyze(ctx, data), nil
+}
+
+// analyze computes the analyses for data, bypassing the cache
+func (a *CompetitorIntelligenceAgent) analyze(ctx context.Context, data []CompetitorData) []CompetitorAnalysis {
 	var analyses []CompetitorAnalysis
 
+	// Technologies every competitor shares are table stakes, not differentiators
+	usage := techUsage(data)
+
+	// Weaknesses several competitors share are the widest openings
+	frequency := weaknessFrequency(data)
+
 	for _, competitor := range data {
+		// Map numeric prices onto tier labels before positioning
+		competitor.Pricing = a.pricingTier(competitor)
+
+		// Put the most recently observed strengths and weaknesses first
+		competitor.Strengths = a.byRecency(competitor, competitor.Strengths, analysisTime(ctx))
+		competitor.Weaknesses = a.byRecency(competitor, competitor.Weaknesses, analysisTime(ctx))
+		competitor.Completeness = completeness(competitor)
+
 		analysis := CompetitorAnalysis{
-			CompetitorName: competitor.Name,
+			CompetitorName:  competitor.Name,
+			MarketShare:     competitor.MarketShare,
+			NormalizedShare: competitor.NormalizedShare,
+			Funding:         competitor.Funding,
+			Momentum:        a.momentumFor(competitor),
+			ScreenshotURL:   competitor.ScreenshotURL,
+			WebsiteStatus:   competitor.WebsiteStatus,
+			RecentNews:      competitor.RecentNews,
+			Completeness:    competitor.Completeness,
+			Confidence:      dataConfidence(competitor, analysisTime(ctx)),
+			Industries:      competitor.Industries,
+
+			ShareUncertainty: competitor.ShareUncertainty,
 		}
 
 		// Determine threat level based on market share
-		switch {
-		case competitor.MarketShare > 20:
-			analysis.ThreatLevel = "High"
-		case competitor.MarketShare > 10:
-			analysis.ThreatLevel = "Medium"
-		default:
-			analysis.ThreatLevel = "Low"
+		analysis.ThreatLevel = a.threatLevelFor(competitor.MarketShare)
+
+		// A fast-growing small player is a rising threat despite its share
+		if a.isEmerging(competitor) {
+			analysis.EmergingThreat = true
+			if analysis.ThreatLevel == ThreatLow {
+				analysis.ThreatLevel = ThreatMedium
+			}
 		}
+		analysis.EmergingPlayer = a.isEmergingPlayer(competitor, analysisTime(ctx))
 
-		// Determine positioning based on pricing
-		switch competitor.Pricing {
-		case "Premium":
-			analysis.Positioning = "Premium market leader"
-		case "Mid-range":
-			analysis.Positioning = "Value-focused challenger"
-		case "Enterprise":
-			analysis.Positioning = "Enterprise specialist"
-		default:
-			analysis.Positioning = "Undifferentiated"
+		// Score the threat and optionally explain how it was derived
+		breakdown := weightedThreatScoreBreakdown(competitor,
+			a.recencyWeight(competitor, competitor.Strengths, analysisTime(ctx)),
+			a.recencyWeight(competitor, competitor.Weaknesses, analysisTime(ctx)))
+		analysis.StalenessDiscount = a.decayStaleThreat(breakdown, competitor.RetrievedAt, analysisTime(ctx))
+		analysis.ThreatScore = sumBreakdown(breakdown)
+		if a.Config.IncludeScoreBreakdown {
+			analysis.ScoreBreakdown = breakdown
 		}
 
+		// Determine positioning from pricing, refined by any configured rules
+		analysis.Positioning, analysis.PositioningInferred = a.positioningFor(competitor)
+		analysis.ValuePosition = a.valuePosition(competitor)
+		analysis.PricingTier = competitor.Pricing
+
 		// Extract key differentiators from strengths
 		analysis.KeyDifferentiators = competitor.Strengths
+		analysis.Weaknesses = competitor.Weaknesses
+
+		// Credit technologies no other competitor uses as differentiators
+		analysis.TechStack = competitor.TechStack
+		for _, tech := range uniqueTech(competitor.TechStack, usage, len(data)) {
+			analysis.KeyDifferentiators = append(append([]string(nil), analysis.KeyDifferentiators...), techDifferentiator(tech))
+		}
+
+		// Credit a large installed base and flag counts at odds with share
+		// or malformed websites
+		analysis.Customers = competitor.Customers
+		if competitor.Customers >= a.largeCustomerBase() {
+			analysis.KeyDifferentiators = append(append([]string(nil), analysis.KeyDifferentiators...), customerDifferentiator(competitor.Customers))
+		}
+		analysis.DataWarnings = append(a.customerWarnings(competitor), websiteWarnings(competitor)...)
+		if analysis.StalenessDiscount > 0 {
+			analysis.DataWarnings = append(analysis.DataWarnings, stalenessWarning(analysis.StalenessDiscount, competitor.RetrievedAt, analysisTime(ctx)))
+		}
+
+		// Compare pricing and share against the industry's benchmark
+		analysis.BenchmarkNotes = a.benchmarkNotes(competitor)
+
+		// Rate support for each compared feature
+		analysis.FeatureSupport = a.featureSupport(competitor)
+
+		// Surface notable leadership, crediting strong teams as a differentiator
+		analysis.Leadership = notableLeaders(competitor.KeyPeople)
+		if strongLeadership(analysis.Leadership) {
+			analysis.KeyDifferentiators = append(append([]string(nil), analysis.KeyDifferentiators...), leadershipDifferentiator(analysis.Leadership))
+		}
 
-		// Generate opportunities based on competitor weaknesses
-		for _, weakness := range competitor.Weaknesses {
-			opportunity := fmt.Sprintf("Capitalize on %s weakness", weakness)
-			analysis.Opportunities = append(analysis.Opportunities, opportunity)
+		// Generate opportunities based on competitor weaknesses, most
+		// important first
+		analysis.OpportunityPriorities = a.prioritizedOpportunities(competitor, frequency)
+		for _, opportunity := range analysis.OpportunityPriorities {
+			analysis.Opportunities = append(analysis.Opportunities, opportunity.Opportunity)
 		}
 
 		// Generate risks based on competitor strengths
 		for _, strength := range competitor.Strengths {
-			risk := fmt.Sprintf("Competitor's %s advantage", strength)
-			analysis.Risks = append(analysis.Risks, risk)
+			analysis.Risks = append(analysis.Risks, a.riskText(strength))
 		}
 
+		// Plan concrete steps against the most threatening competitors
+		analysis.ActionPlan = actionPlan(analysis.ThreatLevel, competitor)
+		analysis.Notes = competitor.Notes
+		analysis.Relationships = a.relationshipsFor(competitor)
+		analysis.Category = a.categoryFor(competitor)
+
+		// Trace each field back to the source that supplied it
+		analysis.Provenance = provenanceFor(competitor, analysis)
+
 		analyses = append(analyses, analysis)
 	}
-
-	return analyses, nil
+	return analyses
 }
 
 // GenerateReport creates a comprehensive competitive intelligence report
 func (a *CompetitorIntelligenceAgent) GenerateReport(ctx context.Context, targetCompany string, analyses []CompetitorAnalysis) (*CompetitorReport, error) {
+	// Report only competitors at or above the configured threat threshold,
+	// keeping any highlighted ones regardless
+	analyses = append([]CompetitorAnalysis(nil), analyses...)
+	markHighlights(analyses, a.highlightsFor(ctx))
+	emerging := emergingPlayers(analyses)
+	analyses, omitted := filterByThreat(analyses, a.Config.MinThreatLevel)
+
+	// List only the strongest competitors within each capped threat tier
+	chain := a.tieBreakChain()
+	analyses, tierOmissions := capByTier(analyses, a.Config.TierCaps, chain)
+
 	report := &CompetitorReport{
-		GeneratedAt:   time.Now(),
-		TargetCompany: targetCompany,
-		Competitors:   analyses,
+		GeneratedAt:        a.reportTime(),
+		TargetCompany:      targetCompany,
+		Competitors:        make([]CompetitorAnalysis, len(analyses)),
+		OmittedCompetitors: omitted,
+		TierOmissions:      tierOmissions,
+		ExcludedByProduct:  productExclusions(ctx),
+		EmergingPlayers:    emerging,
 	}
+	copy(report.Competitors, analyses)
+
+	// Rank competitors by threat score, then list them in the chosen order
+	assignRanks(report.Competitors, chain)
+	sortAnalyses(report.Competitors, a.orderFor(ctx), chain)
+	pinHighlights(report.Competitors)
+
+	// Rescale threat scores within the report so markets can be compared
+	normalizeThreatScores(report.Competitors, a.Config.ScoreNormalization)
+	report.ScoreNormalization = a.Config.ScoreNormalization
+
+	// Compare features across competitors, noting ones nobody covers
+	report.FeatureMatrix = a.featureMatrix(report.Competitors)
 
 	// Generate market insights
-	totalMarketShare := 0.0
-	for _, analysis := range analyses {
-		for _, data := range analyses {
-			if data.CompetitorName == analysis.CompetitorName {
-				// This is a simplified calculation
-				totalMarketShare += 10.0
-			}
-		}
+	stats := computeInsightStats(targetCompany, analyses)
+	stats.FeatureGaps = featureGaps(report.FeatureMatrix)
+	stats.OmittedCount = omitted
+	stats.CappedCount = cappedCount(tierOmissions)
+	stats.ProductFilter = productFilterFor(ctx)
+	stats.ProductExcludedCount = productExclusions(ctx)
+	insights, err := a.renderInsights(stats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render market insights: %w", err)
 	}
+	report.MarketInsights = insights
+	report.CategoryShares = categoryShares(report.Competitors)
 
-	report.MarketInsights = fmt.Sprintf(
-		"The competitive landscape shows %d major players. "+
-			"High-threat competitors control significant market share. "+
-			"Opportunities exist in underserved segments.",
-		len(analyses),
-	)
+	// Summarize for the intended reader
+	report.Persona = a.personaFor(ctx)
+	report.ExecutiveSummary = executiveSummary(report.Persona, stats, analyses)
 
-	// Generate strategic recommendations
-	report.Recommendations = []string{
-		"Focus on differentiation in areas where competitors are weak",
-		"Target mid-market segment with competitive pricing",
-		"Invest in customer support to outperform competitors",
-		"Develop integrations to match competitor ecosystems",
-		"Monitor competitor pricing and adjust strategy quarterly",
+	// Lead the summary with an advisory when the market is not worth entering
+	report.MarketViability = a.marketViability(stats, analyses)
+	if report.MarketViability != nil && report.MarketViability.ReconsiderEntry {
+		report.ExecutiveSummary = report.MarketViability.Advisory + " " + report.ExecutiveSummary
 	}
 
+	// Generate strategic recommendations, ordered by priority then confidence
+	report.RecommendationDetails = a.buildRecommendations(analyses, a.rationaleFor(ctx))
+	report.Recommendations = recommendationTexts(report.RecommendationDetails)
+	report.RecommendationGroups = groupRecommendations(report.RecommendationDetails)
+	report.ConfidenceNote = confidenceNote(analyses, report.RecommendationDetails)
+
 	return report, nil
 }
 
 // Run executes the full competitor intelligence workflow
 func (a *CompetitorIntelligenceAgent) Run(ctx context.Context, companyName string, industry string) (*CompetitorReport, error) {
+	return a.RunIndustries(ctx, companyName, []string{industry})
+}
+
+// RunIndustries executes the workflow across several industries, researching
+// each one and merging the results so every competitor notes its industries
+func (a *CompetitorIntelligenceAgent) RunIndustries(ctx context.Context, companyName string, industries []string) (*CompetitorReport, error) {
+	if len(industries) == 0 {
+		return nil, fmt.Errorf("%w: at least one industry is required", ErrInvalidInput)
+	}
+	if data, ok := suppliedCompetitors(ctx); ok {
+		if err := ValidateCompetitors(data); err != nil {
+			return nil, err
+		}
+	}
+
+	// Infer the industry of known companies when none is requested
+	industries, industrySource := a.resolveIndustries(ctx, companyName, industries)
+
+	report, err := a.withRunRetry(ctx, func() (*CompetitorReport, error) {
+		return a.runOnce(ctx, companyName, industries)
+	})
+	if report != nil && industrySource != "" {
+		report.Industries = industries
+		report.IndustrySource = industrySource
+	}
+	return report, err
+}
+
+// runOnce executes a single attempt of the full pipeline
+func (a *CompetitorIntelligenceAgent) runOnce(ctx context.Context, companyName string, industries []string) (*CompetitorReport, error) {
+	deadline := a.budgetDeadline(time.Now())
+
 	// Step 1: Market Research
-	data, err := a.MarketResearch(ctx, companyName, industry)
-	if err != nil {
-		return nil, fmt.Errorf("market research failed: %w", err)
+	enterStage(ctx, StageResearch)
+	if err := injectedFailure(ctx, StageResearch); err != nil {
+		return nil, &StageError{Stage: StageResearch, Err: err}
 	}
+	var data []CompetitorData
+	for _, industry := range industries {
+		found, err := a.MarketResearch(ctx, companyName, industry)
+		if err != nil {
+			return nil, &StageError{Stage: StageResearch, Err: err}
+		}
+		data = append(data, found...)
+	}
+
+	// Capture source freshness before merging drops duplicate records
+	freshness := sourceFreshness(data)
+
+	// Collapse aliases and duplicate entries onto canonical competitors
+	data = a.NormalizeCompetitors(data)
+
+	// Focus on competitors in the requested product line
+	data, excluded := filterByProduct(data, productFilterFor(ctx))
+	ctx = context.WithValue(ctx, productExclusionsKey{}, excluded)
+
+	// Reduce very large markets to a manageable sample
+	data, sampling := a.sample(ctx, data)
+
+	// Rescale shares for charting, keeping the originals
+	var othersShare float64
+	if a.Config.NormalizeShares {
+		othersShare = normalizeShares(data)
+	}
+
+	// Decorate with optional enrichment; failures never abort the run and
+	// enrichers are skipped once the time budget is spent
+	skipped := a.enrichWithin(ctx, data, deadline)
 
 	// Step 2: Analysis
+	enterStage(ctx, StageAnalysis)
+	if err := injectedFailure(ctx, StageAnalysis); err != nil {
+		return nil, &StageError{Stage: StageAnalysis, Err: err}
+	}
+	ctx = context.WithValue(ctx, analysisTimeKey{}, time.Now())
+	ctx = withAnalysisSubject(ctx, companyName, industries)
 	analyses, err := a.Analyze(ctx, data)
 	if err != nil {
-		return nil, fmt.Errorf("analysis failed: %w", err)
+		return nil, &StageError{Stage: StageAnalysis, Err: err}
 	}
 
 	// Step 3: Generate Report
+	enterStage(ctx, StageReport)
+	if err := injectedFailure(ctx, StageReport); err != nil {
+		return a.partialAnalyses(companyName, analyses, &StageError{Stage: StageReport, Err: err})
+	}
 	report, err := a.GenerateReport(ctx, companyName, analyses)
 	if err != nil {
-		return nil, fmt.Errorf("report generation failed: %w", err)
+		return a.partialAnalyses(companyName, analyses, &StageError{Stage: StageReport, Err: err})
+	}
+	if len(freshness) > 0 {
+		report.Meta = &ReportMeta{SourcesLastUpdated: freshness}
+	}
+	report.OthersShare = othersShare
+	report.SkippedSteps = skipped
+	report.Sampling = sampling
+
+	// Step 4: Compare against and extend stored history
+	if a.store != nil {
+		enterStage(ctx, StagePersistence)
+		if err := injectedFailure(ctx, StagePersistence); err != nil {
+			return a.partialReport(report, &StageError{Stage: StagePersistence, Err: err})
+		}
+		if err := a.recordHistory(ctx, report, a.reportInputs(ctx, data)); err != nil {
+			return a.partialReport(report, &StageError{Stage: StagePersistence, Err: err})
+		}
 	}
 
 	return report, nil
@@ -0,0 +1,292 @@
+package adk
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// analysisCache is a bounded LRU of Analyze results keyed on a hash of the
+// input data and the analysis time bucket. Keys are content-derived, so
+// changed data simply misses, and time-dependent results such as threat
+// decay and confidence freshness expire with their bucket. It is safe for
+// concurrent use; simultaneous misses on one key are computed once. Results
+// are deep-copied in and out, so callers never share slices or maps.
+type analysisCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+	flights singleflight.Group
+
+	hits, misses int
+	// computed counts results computed on a miss, for tests
+	computed int
+}
+
+// analysisCacheEntry is one cached Analyze result, tagged with every run
+// subject that produced or reused it so operators can invalidate by company
+// or industry
+type analysisCacheEntry struct {
+	key      string
+	analyses []CompetitorAnalysis
+	subjects []analysisSubject
+}
+
+// analysisSubject is the company and industries a run analyzed
+type analysisSubject struct {
+	company    string
+	industries []string
+}
+
+// analysisSubjectKey carries the run's subject into Analyze
+type analysisSubjectKey struct{}
+
+// withAnalysisSubject records the company and industries being analyzed
+func withAnalysisSubject(ctx context.Context, company string, industries []string) context.Context {
+	return context.WithValue(ctx, analysisSubjectKey{}, analysisSubject{company: company, industries: industries})
+}
+
+// subjectFor returns the run's subject, if Analyze was called from a run
+func subjectFor(ctx context.Context) (analysisSubject, bool) {
+	subject, ok := ctx.Value(analysisSubjectKey{}).(analysisSubject)
+	return subject, ok
+}
+
+// matches reports whether the subject is for company and industry, where
+// an empty filter matches anything
+func (s analysisSubject) matches(company, industry string) bool {
+	if company != "" && !strings.EqualFold(strings.TrimSpace(s.company), strings.TrimSpace(company)) {
+		return false
+	}
+	if industry != "" && !slices.ContainsFunc(s.industries, func(candidate string) bool {
+		return strings.EqualFold(strings.TrimSpace(candidate), strings.TrimSpace(industry))
+	}) {
+		return false
+	}
+	return true
+}
+
+// newAnalysisCache creates a cache holding at most size results
+func newAnalysisCache(size int) *analysisCache {
+	return &analysisCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// analysisCacheBucket is how long a cached result stands for analyses run
+// at a later time; recency, decay and freshness barely move within it
+const analysisCacheBucket = time.Hour
+
+// analysisKey hashes the competitor data together with the time bucket the
+// analysis runs in; ok is false if the data cannot be encoded
+func analysisKey(data []CompetitorData, at time.Time) (key string, ok bool) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", false
+	}
+	hash := sha256.New()
+	hash.Write([]byte(at.UTC().Truncate(analysisCacheBucket).Format(time.RFC3339)))
+	hash.Write(encoded)
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// cloneAnalyses deep-copies analyses so cached results share no slices,
+// maps or pointers with callers
+func cloneAnalyses(analyses []CompetitorAnalysis) []CompetitorAnalysis {
+	if analyses == nil {
+		return nil
+	}
+	out := make([]CompetitorAnalysis, len(analyses))
+	for i, analysis := range analyses {
+		analysis.ScoreBreakdown = maps.Clone(analysis.ScoreBreakdown)
+		analysis.KeyDifferentiators = slices.Clone(analysis.KeyDifferentiators)
+		analysis.TechStack = slices.Clone(analysis.TechStack)
+		analysis.Weaknesses = slices.Clone(analysis.Weaknesses)
+		analysis.Opportunities = slices.Clone(analysis.Opportunities)
+		analysis.Risks = slices.Clone(analysis.Risks)
+		if analysis.WebsiteStatus != nil {
+			status := *analysis.WebsiteStatus
+			analysis.WebsiteStatus = &status
+		}
+		analysis.Industries = slices.Clone(analysis.Industries)
+		analysis.Leadership = slices.Clone(analysis.Leadership)
+		analysis.ActionPlan = slices.Clone(analysis.ActionPlan)
+		analysis.Notes = slices.Clone(analysis.Notes)
+		if analysis.NormalizedThreatScore != nil {
+			score := *analysis.NormalizedThreatScore
+			analysis.NormalizedThreatScore = &score
+		}
+		analysis.Relationships = slices.Clone(analysis.Relationships)
+		analysis.RecentNews = slices.Clone(analysis.RecentNews)
+		analysis.BenchmarkNotes = slices.Clone(analysis.BenchmarkNotes)
+		analysis.OpportunityPriorities = slices.Clone(analysis.OpportunityPriorities)
+		analysis.FeatureSupport = maps.Clone(analysis.FeatureSupport)
+		analysis.Provenance = maps.Clone(analysis.Provenance)
+		analysis.DataWarnings = slices.Clone(analysis.DataWarnings)
+		out[i] = analysis
+	}
+	return out
+}
+
+// load returns the cached analyses for key, calling compute on a miss.
+// Callers missing on the same key at once wait for a single compute and
+// each get their own deep copy of its result; each caller is tagged with its
+// own run subject. Keys carry the time bucket, so runs at different analysis
+// times never share a flight.
+func (c *analysisCache) load(ctx context.Context, key string, compute func() []CompetitorAnalysis) []CompetitorAnalysis {
+	subject, tagged := subjectFor(ctx)
+	if cached, hit := c.get(key); hit {
+		if tagged {
+			c.tag(key, subject)
+		}
+		return cached
+	}
+
+	shared, _, _ := c.flights.Do(key, func() (any, error) {
+		// An earlier flight may have stored the result since the miss above
+		if cached, ok := c.peek(key); ok {
+			return cached, nil
+		}
+		analyses := compute()
+		c.put(key, analyses, analysisSubject{}, false)
+		c.mu.Lock()
+		c.computed++
+		c.mu.Unlock()
+		return analyses, nil
+	})
+	if tagged {
+		c.tag(key, subject)
+	}
+	return cloneAnalyses(shared.([]CompetitorAnalysis))
+}
+
+// peek returns a copy of the cached analyses for key without counting a
+// hit or miss
+func (c *analysisCache) peek(key string) ([]CompetitorAnalysis, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return cloneAnalyses(element.Value.(*analysisCacheEntry).analyses), true
+}
+
+// get returns a copy of the cached analyses for key
+func (c *analysisCache) get(key string) ([]CompetitorAnalysis, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.order.MoveToFront(element)
+	return cloneAnalyses(element.Value.(*analysisCacheEntry).analyses), true
+}
+
+// put stores a copy of analyses, evicting the least recently used result
+func (c *analysisCache) put(key string, analyses []CompetitorAnalysis, subject analysisSubject, tagged bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stored := cloneAnalyses(analyses)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*analysisCacheEntry)
+		entry.analyses = stored
+		if tagged {
+			entry.addSubject(subject)
+		}
+		c.order.MoveToFront(element)
+		return
+	}
+
+	entry := &analysisCacheEntry{key: key, analyses: stored}
+	if tagged {
+		entry.subjects = []analysisSubject{subject}
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*analysisCacheEntry).key)
+	}
+}
+
+// tag records that subject reused the entry for key
+func (c *analysisCache) tag(key string, subject analysisSubject) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*analysisCacheEntry).addSubject(subject)
+	}
+}
+
+// addSubject tags the entry with subject unless it already is, so entries
+// reused by the same run over and over do not grow
+func (e *analysisCacheEntry) addSubject(subject analysisSubject) {
+	for _, existing := range e.subjects {
+		if existing.company == subject.company && slices.Equal(existing.industries, subject.industries) {
+			return
+		}
+	}
+	e.subjects = append(e.subjects, subject)
+}
+
+// invalidate evicts entries used by any run for company and industry, or
+// every entry when both are empty, returning how many were evicted
+func (c *analysisCache) invalidate(company, industry string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	evicted := 0
+	for key, element := range c.entries {
+		entry := element.Value.(*analysisCacheEntry)
+		if company != "" || industry != "" {
+			if !slices.ContainsFunc(entry.subjects, func(s analysisSubject) bool { return s.matches(company, industry) }) {
+				continue
+			}
+		}
+		c.order.Remove(element)
+		delete(c.entries, key)
+		evicted++
+	}
+	return evicted
+}
+
+// InvalidateAnalysisCache evicts cached analyses produced for company and
+// industry (either may be empty to match any), or clears the cache when
+// both are empty. It returns how many entries were evicted.
+func (a *CompetitorIntelligenceAgent) InvalidateAnalysisCache(company, industry string) int {
+	if a.analysisCache == nil {
+		return 0
+	}
+	return a.analysisCache.invalidate(company, industry)
+}
+
+// AnalysisCacheStats reports Analyze cache hits and misses (zero when the
+// cache is disabled)
+func (a *CompetitorIntelligenceAgent) AnalysisCacheStats() (hits, misses int) {
+	if a.analysisCache == nil {
+		return 0, 0
+	}
+	a.analysisCache.mu.Lock()
+	defer a.analysisCache.mu.Unlock()
+	return a.analysisCache.hits, a.analysisCache.misses
+}
@@ -0,0 +1,325 @@
+package adk
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAnalyze_Cache(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(2))
+	ctx := context.Background()
+
+	data := []CompetitorData{{Name: "A", MarketShare: 30, Strengths: []string{"Brand"}}}
+	changed := []CompetitorData{{Name: "A", MarketShare: 31, Strengths: []string{"Brand"}}}
+
+	steps := []struct {
+		name       string
+		data       []CompetitorData
+		wantHits   int
+		wantMisses int
+	}{
+		{"first call misses", data, 0, 1},
+		{"identical input hits", []CompetitorData{{Name: "A", MarketShare: 30, Strengths: []string{"Brand"}}}, 1, 1},
+		{"changed input misses", changed, 1, 2},
+		{"original still cached", data, 2, 2},
+	}
+
+	for _, step := range steps {
+		analyses, err := agent.Analyze(ctx, step.data)
+		if err != nil {
+			t.Fatalf("%s: Analyze() error = %v", step.name, err)
+		}
+		if analyses[0].MarketShare != step.data[0].MarketShare {
+			t.Errorf("%s: expected share %v, got %v", step.name, step.data[0].MarketShare, analyses[0].MarketShare)
+		}
+		if hits, misses := agent.AnalysisCacheStats(); hits != step.wantHits || misses != step.wantMisses {
+			t.Errorf("%s: expected %d hits and %d misses, got %d and %d", step.name, step.wantHits, step.wantMisses, hits, misses)
+		}
+	}
+}
+
+func TestAnalyze_CacheEviction(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(1))
+	ctx := context.Background()
+
+	first := []CompetitorData{{Name: "A"}}
+	second := []CompetitorData{{Name: "B"}}
+	for _, data := range [][]CompetitorData{first, second, first} {
+		if _, err := agent.Analyze(ctx, data); err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+	}
+
+	if hits, misses := agent.AnalysisCacheStats(); hits != 0 || misses != 3 {
+		t.Errorf("Expected the evicted entry to miss, got %d hits and %d misses", hits, misses)
+	}
+}
+
+func TestAnalyze_CacheReturnsCopies(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(1))
+	data := []CompetitorData{{Name: "A", MarketShare: 30}}
+
+	first, _ := agent.Analyze(context.Background(), data)
+	first[0].CompetitorName = "Mutated"
+
+	second, _ := agent.Analyze(context.Background(), data)
+	if second[0].CompetitorName != "A" {
+		t.Errorf("Expected callers not to share cached results, got %q", second[0].CompetitorName)
+	}
+}
+
+func TestInvalidateAnalysisCache(t *testing.T) {
+	// Supplied data is stable across runs, unlike the stub's timestamps
+	saas := WithCompetitorData(context.Background(), []CompetitorData{{Name: "Rival", MarketShare: 30}})
+	fintech := WithCompetitorData(context.Background(), []CompetitorData{{Name: "Lender", MarketShare: 20}})
+
+	tests := []struct {
+		name        string
+		company     string
+		industry    string
+		wantEvicted int
+		wantSaaSHit bool
+	}{
+		{name: "Unrelated company", company: "Other", wantEvicted: 0, wantSaaSHit: true},
+		{name: "Unrelated industry", company: "Acme", industry: "Retail", wantEvicted: 0, wantSaaSHit: true},
+		{name: "Targeted company", company: "acme", wantEvicted: 1},
+		{name: "Targeted industry", industry: "SaaS", wantEvicted: 1},
+		{name: "Everything", wantEvicted: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(10))
+			if _, err := agent.Run(saas, "Acme", "SaaS"); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if _, err := agent.Run(fintech, "Beta", "Fintech"); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if evicted := agent.InvalidateAnalysisCache(tt.company, tt.industry); evicted != tt.wantEvicted {
+				t.Errorf("Expected %d evicted, got %d", tt.wantEvicted, evicted)
+			}
+
+			if _, err := agent.Run(saas, "Acme", "SaaS"); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if hits, _ := agent.AnalysisCacheStats(); (hits == 1) != tt.wantSaaSHit {
+				t.Errorf("Expected SaaS entry cached %v, got %d hits", tt.wantSaaSHit, hits)
+			}
+		})
+	}
+}
+
+func TestInvalidateAnalysisCache_Disabled(t *testing.T) {
+	if evicted := NewCompetitorIntelligenceAgent().InvalidateAnalysisCache("", ""); evicted != 0 {
+		t.Errorf("Expected nothing evicted without a cache, got %d", evicted)
+	}
+}
+
+func TestAnalyze_CacheConcurrent(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(4))
+	data := []CompetitorData{
+		{Name: "A", MarketShare: 30, Strengths: []string{"Brand"}},
+		{Name: "B", MarketShare: 10, Weaknesses: []string{"Support"}},
+	}
+
+	const callers = 16
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	results := make([][]CompetitorAnalysis, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			ctx := withAnalysisSubject(context.Background(), "TestCorp", []string{"SaaS"})
+			analyses, err := agent.Analyze(ctx, data)
+			if err != nil {
+				t.Errorf("Analyze() error = %v", err)
+				return
+			}
+			// Callers own their results
+			analyses[0].CompetitorName = "mutated"
+			results[i] = analyses
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if computed := agent.analysisCache.computed; computed != 1 {
+		t.Errorf("Expected 1 computation, got %d", computed)
+	}
+	for i, analyses := range results {
+		if len(analyses) != len(data) || analyses[1].CompetitorName != "B" {
+			t.Errorf("Caller %d: unexpected analyses %+v", i, analyses)
+		}
+	}
+	if evicted := agent.InvalidateAnalysisCache("TestCorp", ""); evicted != 1 {
+		t.Errorf("Expected the shared entry tagged for TestCorp, evicted %d", evicted)
+	}
+}
+
+func TestAnalysisCache_SingleFlight(t *testing.T) {
+	cache := newAnalysisCache(4)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	compute := func() []CompetitorAnalysis {
+		calls.Add(1)
+		<-release
+		return []CompetitorAnalysis{{CompetitorName: "A"}}
+	}
+
+	const callers = 8
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if analyses := cache.load(context.Background(), "key", compute); len(analyses) != 1 {
+				t.Errorf("Expected the shared result, got %+v", analyses)
+			}
+		}()
+	}
+
+	// Hold the first computation until every caller has missed
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Expected concurrent identical loads to compute once, got %d", got)
+	}
+}
+
+func TestAnalyze_CacheTimeBucket(t *testing.T) {
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(4), WithThreatDecay(30*24*time.Hour, 30*24*time.Hour))
+	data := []CompetitorData{{Name: "A", MarketShare: 30, Strengths: []string{"Brand"}, RetrievedAt: now.AddDate(0, -2, 0)}}
+
+	steps := []struct {
+		name       string
+		at         time.Time
+		wantHits   int
+		wantMisses int
+	}{
+		{"first call misses", now, 0, 1},
+		{"same bucket hits", now.Add(30 * time.Minute), 1, 1},
+		{"later bucket misses", now.AddDate(0, 1, 0), 1, 2},
+		{"earlier bucket still cached", now.Add(10 * time.Minute), 2, 2},
+	}
+
+	var discounts []float64
+	for _, step := range steps {
+		ctx := context.WithValue(context.Background(), analysisTimeKey{}, step.at)
+		analyses, err := agent.Analyze(ctx, data)
+		if err != nil {
+			t.Fatalf("%s: Analyze() error = %v", step.name, err)
+		}
+		if hits, misses := agent.AnalysisCacheStats(); hits != step.wantHits || misses != step.wantMisses {
+			t.Errorf("%s: expected %d hits and %d misses, got %d and %d", step.name, step.wantHits, step.wantMisses, hits, misses)
+		}
+		discounts = append(discounts, analyses[0].StalenessDiscount)
+	}
+
+	// A month on, the data is staler and the cached discount must not be reused
+	if discounts[2] <= discounts[0] {
+		t.Errorf("Expected a larger discount a month later, got %v then %v", discounts[0], discounts[2])
+	}
+	if discounts[1] != discounts[0] || discounts[3] != discounts[0] {
+		t.Errorf("Expected the same discount within a bucket, got %v", discounts)
+	}
+}
+
+func TestAnalyze_CacheDeepCopy(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(2), WithScoreBreakdown(true))
+	ctx := context.Background()
+	data := func() []CompetitorData {
+		return []CompetitorData{{Name: "A", MarketShare: 30, Strengths: []string{"Brand"}, Weaknesses: []string{"Support"}}}
+	}
+
+	first, err := agent.Analyze(ctx, data())
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	want := first[0].KeyDifferentiators[0]
+	wantScore := first[0].ScoreBreakdown[ScoreComponentMarketShare]
+
+	// Mutating one caller's result must not reach the cache or later callers
+	first[0].KeyDifferentiators[0] = "mutated"
+	first[0].ScoreBreakdown[ScoreComponentMarketShare] = -1
+	first[0].Weaknesses[0] = "mutated"
+
+	second, err := agent.Analyze(ctx, data())
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if hits, _ := agent.AnalysisCacheStats(); hits != 1 {
+		t.Fatalf("Expected a cache hit, got %d hits", hits)
+	}
+	if got := second[0].KeyDifferentiators[0]; got != want {
+		t.Errorf("Expected differentiator %q, got %q", want, got)
+	}
+	if got := second[0].ScoreBreakdown[ScoreComponentMarketShare]; got != wantScore {
+		t.Errorf("Expected score component %v, got %v", wantScore, got)
+	}
+	if got := second[0].Weaknesses[0]; got != "Support" {
+		t.Errorf("Expected weakness %q, got %q", "Support", got)
+	}
+}
+
+func TestAnalyze_CacheConcurrentTimeBuckets(t *testing.T) {
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	agent := NewCompetitorIntelligenceAgent(WithAnalysisCache(4), WithThreatDecay(30*24*time.Hour, 30*24*time.Hour))
+	data := []CompetitorData{{Name: "A", MarketShare: 30, Strengths: []string{"Brand"}, RetrievedAt: now.AddDate(0, -2, 0)}}
+	times := []time.Time{now, now.AddDate(0, 1, 0)}
+
+	const callers = 16
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	results := make([][]CompetitorAnalysis, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			ctx := context.WithValue(context.Background(), analysisTimeKey{}, times[i%len(times)])
+			analyses, err := agent.Analyze(ctx, data)
+			if err != nil {
+				t.Errorf("Analyze() error = %v", err)
+				return
+			}
+			// Callers sharing a flight still own their slices
+			analyses[0].KeyDifferentiators[0] = "mutated"
+			results[i] = analyses
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if computed := agent.analysisCache.computed; computed != len(times) {
+		t.Errorf("Expected one computation per time bucket, got %d", computed)
+	}
+	for i, analyses := range results {
+		if i < len(times) {
+			continue
+		}
+		if want := results[i%len(times)][0].StalenessDiscount; analyses[0].StalenessDiscount != want {
+			t.Errorf("Caller %d: expected discount %v for its bucket, got %v", i, want, analyses[0].StalenessDiscount)
+		}
+	}
+	if results[0][0].StalenessDiscount == results[1][0].StalenessDiscount {
+		t.Errorf("Expected buckets a month apart to differ, both got %v", results[0][0].StalenessDiscount)
+	}
+
+	cached, err := agent.Analyze(context.WithValue(context.Background(), analysisTimeKey{}, now), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if cached[0].KeyDifferentiators[0] == "mutated" {
+		t.Error("Expected the cached result unaffected by callers' changes")
+	}
+}
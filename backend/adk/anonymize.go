@@ -0,0 +1,200 @@
+package adk
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Anonymized returns a copy of the report with every competitor name
+// replaced by a pseudonym ("Competitor 1", "Competitor 2", ...) wherever it
+// appears in any case, plus the pseudonym-to-name mapping. Pseudonyms follow threat
+// rank, so they do not leak the display order. Data that can identify a
+// competitor other than by name (the report ID and inputs, leadership,
+// screenshots, news links and website details) is dropped; all other data
+// is kept.
+func (r *CompetitorReport) Anonymized() (*CompetitorReport, map[string]string) {
+	ordered := make([]int, len(r.Competitors))
+	for i := range ordered {
+		ordered[i] = i
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return r.Competitors[ordered[i]].Rank < r.Competitors[ordered[j]].Rank
+	})
+
+	mapping := make(map[string]string, len(r.Competitors))
+	pseudonyms := make(map[string]string, len(r.Competitors))
+	for n, i := range ordered {
+		name := r.Competitors[i].CompetitorName
+		if _, seen := pseudonyms[name]; seen || name == "" {
+			continue
+		}
+		pseudonym := fmt.Sprintf("Competitor %d", n+1)
+		pseudonyms[name] = pseudonym
+		mapping[pseudonym] = name
+	}
+
+	// Emerging players filtered out of Competitors still need pseudonyms,
+	// numbered after every listed competitor
+	next := len(r.Competitors) + 1
+	for _, name := range r.EmergingPlayers {
+		if _, seen := pseudonyms[name]; seen || name == "" {
+			continue
+		}
+		pseudonym := fmt.Sprintf("Competitor %d", next)
+		next++
+		pseudonyms[name] = pseudonym
+		mapping[pseudonym] = name
+	}
+
+	replacer := newNameReplacer(pseudonyms)
+	replaceAll := func(values []string) []string {
+		if values == nil {
+			return nil
+		}
+		out := make([]string, len(values))
+		for i, value := range values {
+			out[i] = replacer.Replace(value)
+		}
+		return out
+	}
+
+	out := *r
+	out.ID = ""
+	out.Inputs = nil
+	out.MarketInsights = replacer.Replace(r.MarketInsights)
+	out.ExecutiveSummary = replacer.Replace(r.ExecutiveSummary)
+	out.ConfidenceNote = replacer.Replace(r.ConfidenceNote)
+	out.Recommendations = replaceAll(r.Recommendations)
+	out.EmergingPlayers = replaceAll(r.EmergingPlayers)
+	if r.MarketViability != nil {
+		viability := *r.MarketViability
+		viability.TopThreat = replacer.Replace(viability.TopThreat)
+		viability.Advisory = replacer.Replace(viability.Advisory)
+		out.MarketViability = &viability
+	}
+	if r.FeatureMatrix != nil {
+		matrix := *r.FeatureMatrix
+		matrix.Competitors = make([]string, len(r.FeatureMatrix.Competitors))
+		for i, name := range r.FeatureMatrix.Competitors {
+			matrix.Competitors[i] = pseudonyms[name]
+		}
+		out.FeatureMatrix = &matrix
+	}
+
+	out.RecommendationDetails = make([]Recommendation, len(r.RecommendationDetails))
+	for i, rec := range r.RecommendationDetails {
+		rec.Text = replacer.Replace(rec.Text)
+		rec.Rationale = replacer.Replace(rec.Rationale)
+		rec.Sources = replaceAll(rec.Sources)
+		out.RecommendationDetails[i] = rec
+	}
+	out.RecommendationGroups = make([]RecommendationGroup, len(r.RecommendationGroups))
+	for i, group := range r.RecommendationGroups {
+		group.Recommendations = replaceAll(group.Recommendations)
+		out.RecommendationGroups[i] = group
+	}
+
+	out.Competitors = make([]CompetitorAnalysis, len(r.Competitors))
+	for i, analysis := range r.Competitors {
+		analysis.CompetitorName = pseudonyms[analysis.CompetitorName]
+		analysis.Positioning = replacer.Replace(analysis.Positioning)
+		analysis.KeyDifferentiators = replaceAll(withoutLeaderNames(analysis.KeyDifferentiators))
+		analysis.Weaknesses = replaceAll(analysis.Weaknesses)
+		analysis.Opportunities = replaceAll(analysis.Opportunities)
+		if analysis.OpportunityPriorities != nil {
+			priorities := make([]PrioritizedOpportunity, len(analysis.OpportunityPriorities))
+			for j, opportunity := range analysis.OpportunityPriorities {
+				opportunity.Opportunity = replacer.Replace(opportunity.Opportunity)
+				opportunity.Weakness = replacer.Replace(opportunity.Weakness)
+				priorities[j] = opportunity
+			}
+			analysis.OpportunityPriorities = priorities
+		}
+		analysis.Risks = replaceAll(analysis.Risks)
+		analysis.ActionPlan = replaceAll(analysis.ActionPlan)
+		analysis.Notes = replaceAll(analysis.Notes)
+		analysis.BenchmarkNotes = replaceAll(analysis.BenchmarkNotes)
+		analysis.DataWarnings = anonymizeWarnings(replacer, analysis.DataWarnings)
+		analysis.Leadership = nil
+		analysis.ScreenshotURL = ""
+		if analysis.WebsiteStatus != nil {
+			status := *analysis.WebsiteStatus
+			status.Error = ""
+			analysis.WebsiteStatus = &status
+		}
+		if analysis.RecentNews != nil {
+			news := make([]NewsItem, len(analysis.RecentNews))
+			for j, item := range analysis.RecentNews {
+				news[j] = NewsItem{Title: replacer.Replace(item.Title), PublishedAt: item.PublishedAt, Sentiment: item.Sentiment}
+			}
+			analysis.RecentNews = news
+		}
+		if analysis.Relationships != nil {
+			rels := make([]Relationship, len(analysis.Relationships))
+			for j, rel := range analysis.Relationships {
+				rels[j] = Relationship{Type: rel.Type, Target: replacer.Replace(rel.Target)}
+			}
+			analysis.Relationships = rels
+		}
+		if analysis.Highlighted {
+			// Rebuild from the anonymized fields rather than rewriting text
+			analysis.FocusSummary = focusSummary(analysis)
+		}
+		out.Competitors[i] = analysis
+	}
+	return &out, mapping
+}
+
+// anonymizeWarnings replaces names in data warnings, dropping the website
+// quoted in a malformed website warning
+func anonymizeWarnings(replacer *nameReplacer, warnings []string) []string {
+	if warnings == nil {
+		return nil
+	}
+	out := make([]string, len(warnings))
+	for i, warning := range warnings {
+		if strings.HasPrefix(warning, "Website ") && strings.HasSuffix(warning, invalidWebsiteWarning) {
+			out[i] = "Website " + invalidWebsiteWarning
+			continue
+		}
+		out[i] = replacer.Replace(warning)
+	}
+	return out
+}
+
+// nameReplacer swaps competitor names for pseudonyms regardless of case, so
+// names lowercased inside generated text are caught too
+type nameReplacer struct {
+	pattern    *regexp.Regexp
+	pseudonyms map[string]string
+}
+
+// newNameReplacer builds a replacer from a name-to-pseudonym mapping
+func newNameReplacer(pseudonyms map[string]string) *nameReplacer {
+	r := &nameReplacer{pseudonyms: make(map[string]string, len(pseudonyms))}
+	if len(pseudonyms) == 0 {
+		return r
+	}
+
+	// Match longer names first so one name containing another is handled
+	names := make([]string, 0, len(pseudonyms))
+	for name, pseudonym := range pseudonyms {
+		names = append(names, regexp.QuoteMeta(name))
+		r.pseudonyms[strings.ToLower(name)] = pseudonym
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	r.pattern = regexp.MustCompile("(?i)" + strings.Join(names, "|"))
+	return r
+}
+
+// Replace returns s with every competitor name replaced
+func (r *nameReplacer) Replace(s string) string {
+	if r.pattern == nil {
+		return s
+	}
+	return r.pattern.ReplaceAllStringFunc(s, func(name string) string {
+		return r.pseudonyms[strings.ToLower(name)]
+	})
+}
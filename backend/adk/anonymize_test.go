@@ -0,0 +1,160 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAnonymized(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithOrderBy(OrderName))
+	ctx := WithCompetitorData(context.Background(), []CompetitorData{
+		{Name: "Acme", MarketShare: 30, Weaknesses: []string{"Slow support"}, Notes: []string{"Acme is hiring"}},
+		{Name: "Acme Cloud", MarketShare: 12, Weaknesses: []string{"Few integrations"}},
+		{Name: "Zeta", MarketShare: 5, Confidence: 0.1},
+	})
+
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	anonymized, mapping := report.Anonymized()
+
+	want := map[string]string{"Competitor 1": "Acme", "Competitor 2": "Acme Cloud", "Competitor 3": "Zeta"}
+	for pseudonym, name := range want {
+		if mapping[pseudonym] != name {
+			t.Errorf("Expected %s to map to %s, got %q", pseudonym, name, mapping[pseudonym])
+		}
+	}
+
+	out, err := anonymized.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	for _, name := range []string{"Acme", "Zeta"} {
+		if strings.Contains(string(out), name) {
+			t.Errorf("Expected %q to be replaced everywhere, found it in:\n%s", name, out)
+		}
+	}
+
+	// Names are replaced consistently and other data is preserved
+	for i, analysis := range anonymized.Competitors {
+		original := report.Competitors[i]
+		if mapping[analysis.CompetitorName] != original.CompetitorName {
+			t.Errorf("Expected %s to stand for %s", analysis.CompetitorName, original.CompetitorName)
+		}
+		if analysis.MarketShare != original.MarketShare || analysis.ThreatScore != original.ThreatScore {
+			t.Errorf("Expected %s data preserved", analysis.CompetitorName)
+		}
+	}
+	if !strings.Contains(string(out), "Competitor 1 is hiring") {
+		t.Error("Expected names inside notes to be replaced")
+	}
+	if !strings.Contains(anonymized.ConfidenceNote, "Competitor 3") {
+		t.Errorf("Expected the confidence note to use the pseudonym, got %q", anonymized.ConfidenceNote)
+	}
+
+	// The original report is left untouched
+	if report.Competitors[0].CompetitorName != "Acme" {
+		t.Errorf("Expected the source report unchanged, got %s", report.Competitors[0].CompetitorName)
+	}
+	var decoded CompetitorReport
+	if err := json.Unmarshal(out, &decoded); err != nil || decoded.Pseudonyms != nil {
+		t.Errorf("Expected no mapping in the anonymized report itself, got %v, %v", decoded.Pseudonyms, err)
+	}
+}
+
+func TestAnonymized_NoIdentifyingData(t *testing.T) {
+	store := NewMemoryReportStore()
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(store))
+	ctx := WithCompetitorData(context.Background(), []CompetitorData{
+		{
+			Name: "Acme", MarketShare: 30, Strengths: []string{"Acme brand"},
+			KeyPeople: []Person{
+				{Name: "Wile Coyote", Role: "CEO", YearsExperience: 20},
+				{Name: "Road Runner", Role: "CTO"},
+			},
+			ScreenshotURL: "https://shots.example/acme.png",
+			WebsiteStatus: &WebsiteStatus{StatusCode: 503, Error: "GET https://acme.example: unavailable", CheckedAt: time.Now()},
+			RecentNews:    []NewsItem{{Title: "Acme raises funding", Link: "https://news.example/acme", Sentiment: SentimentPositive}},
+		},
+		{Name: "Zeta", MarketShare: 5},
+	})
+
+	run, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	report, err := store.Get(context.Background(), run.ID)
+	if err != nil || report.Inputs == nil {
+		t.Fatalf("Expected a stored report with inputs, got %v", err)
+	}
+	report.Competitors[0].DataWarnings = []string{`Website "acme.com" ` + invalidWebsiteWarning, "Acme data is thin"}
+
+	anonymized, _ := report.Anonymized()
+	out, err := json.Marshal(anonymized)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, name := range []string{"acme", "zeta", "wile coyote", "road runner"} {
+		if strings.Contains(strings.ToLower(string(out)), name) {
+			t.Errorf("Expected no trace of %q, found it in:\n%s", name, out)
+		}
+	}
+	if anonymized.ID != "" {
+		t.Errorf("Expected the report ID cleared, got %q", anonymized.ID)
+	}
+	if news := anonymized.Competitors[0].RecentNews; len(news) != 1 || news[0].Title != "Competitor 1 raises funding" {
+		t.Errorf("Expected news titles anonymized, got %+v", news)
+	}
+	if warnings := anonymized.Competitors[0].DataWarnings; len(warnings) != 2 || warnings[1] != "Competitor 1 data is thin" {
+		t.Errorf("Expected data warnings anonymized, got %v", warnings)
+	}
+}
+
+func TestRun_WithoutSaving(t *testing.T) {
+	store := NewMemoryReportStore()
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(store))
+
+	report, err := agent.Run(WithoutSaving(context.Background()), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.ID != "" {
+		t.Errorf("Expected no report ID, got %q", report.ID)
+	}
+	if stored, _ := store.List(context.Background(), "TestCorp"); len(stored) != 0 {
+		t.Errorf("Expected nothing stored, got %d reports", len(stored))
+	}
+}
+
+func TestAnonymized_Highlighted(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	ctx := WithHighlights(WithCompetitorData(context.Background(), []CompetitorData{
+		{Name: "Acme", MarketShare: 30, Strengths: []string{"Acme ecosystem"}, Weaknesses: []string{"Slow support"}},
+		{Name: "Zeta", MarketShare: 5},
+	}), "Acme")
+
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(report.Competitors[0].FocusSummary, "Acme") {
+		t.Fatalf("Expected a focus summary naming Acme, got %q", report.Competitors[0].FocusSummary)
+	}
+
+	anonymized, _ := report.Anonymized()
+	out, err := json.Marshal(anonymized)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(strings.ToLower(string(out)), "acme") {
+		t.Errorf("Expected no trace of Acme, found it in:\n%s", out)
+	}
+	if summary := anonymized.Competitors[0].FocusSummary; !strings.HasPrefix(summary, "Competitor 1 is a High threat") {
+		t.Errorf("Expected the focus summary to use the pseudonym, got %q", summary)
+	}
+}
@@ -0,0 +1,95 @@
+package adk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// IndustryBenchmark holds the norms competitors in one industry are compared
+// against
+type IndustryBenchmark struct {
+	// PriceTier is the typical pricing tier, one of the price band tiers
+	PriceTier string `json:"price_tier,omitempty"`
+	// LeaderShare is the market share, in percent, at which a competitor is
+	// considered a share leader in the industry
+	LeaderShare float64 `json:"leader_share,omitempty"`
+}
+
+// ParseIndustryBenchmarks parses "industry=tier,leader_share;..." entries
+// such as "SaaS=Premium,25;Retail=Budget,40". Either value may be left
+// empty, as in "Fintech=,30".
+func ParseIndustryBenchmarks(spec string) (map[string]IndustryBenchmark, error) {
+	benchmarks := make(map[string]IndustryBenchmark)
+	for _, entry := range strings.Split(spec, ";") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		industry, values, ok := strings.Cut(entry, "=")
+		industry = strings.TrimSpace(industry)
+		if !ok || industry == "" {
+			return nil, fmt.Errorf("%w: industry benchmark %q must be industry=tier,leader_share", ErrInvalidInput, entry)
+		}
+
+		tier, share, _ := strings.Cut(values, ",")
+		benchmark := IndustryBenchmark{PriceTier: strings.TrimSpace(tier)}
+		if share = strings.TrimSpace(share); share != "" {
+			parsed, err := strconv.ParseFloat(share, 64)
+			if err != nil || parsed <= 0 || parsed > 100 {
+				return nil, fmt.Errorf("%w: industry benchmark %q has an invalid leader share", ErrInvalidInput, entry)
+			}
+			benchmark.LeaderShare = parsed
+		}
+		benchmarks[industry] = benchmark
+	}
+	return benchmarks, nil
+}
+
+// benchmarkFor finds the benchmark for a competitor's industry, trying its
+// primary industry before every other researched industry
+func (a *CompetitorIntelligenceAgent) benchmarkFor(competitor CompetitorData) (string, IndustryBenchmark, bool) {
+	for _, industry := range append([]string{competitor.Industry}, competitor.Industries...) {
+		for name, benchmark := range a.Config.IndustryBenchmarks {
+			if industry != "" && strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(industry)) {
+				return name, benchmark, true
+			}
+		}
+	}
+	return "", IndustryBenchmark{}, false
+}
+
+// tierIndex returns a tier's position in bands, cheapest first, or -1
+func tierIndex(tier string, bands []PriceBand) int {
+	for i, band := range bands {
+		if strings.EqualFold(band.Tier, tier) {
+			return i
+		}
+	}
+	return -1
+}
+
+// benchmarkNotes flags where a competitor departs from its industry's norms:
+// pricing in a different tier than is typical, or a share at or above the
+// industry's leader threshold. Pricing must already be resolved to a tier.
+func (a *CompetitorIntelligenceAgent) benchmarkNotes(competitor CompetitorData) []string {
+	industry, benchmark, ok := a.benchmarkFor(competitor)
+	if !ok {
+		return nil
+	}
+
+	var notes []string
+	bands := a.priceBands()
+	actual, typical := tierIndex(competitor.Pricing, bands), tierIndex(benchmark.PriceTier, bands)
+	if actual >= 0 && typical >= 0 && actual != typical {
+		direction := "low"
+		if actual > typical {
+			direction = "high"
+		}
+		notes = append(notes, fmt.Sprintf("Unusually %s price for %s (%s vs typical %s)", direction, industry, bands[actual].Tier, bands[typical].Tier))
+	}
+
+	if benchmark.LeaderShare > 0 && competitor.MarketShare >= benchmark.LeaderShare {
+		notes = append(notes, fmt.Sprintf("Share leader for %s (%.1f%% vs %.1f%% leader threshold)", industry, competitor.MarketShare, benchmark.LeaderShare))
+	}
+	return notes
+}
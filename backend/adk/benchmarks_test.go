@@ -0,0 +1,66 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze_BenchmarkNotes(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithIndustryBenchmarks(map[string]IndustryBenchmark{
+		"SaaS": {PriceTier: "Premium", LeaderShare: 25},
+	}))
+
+	data := []CompetitorData{
+		{Name: "Cheap", Industry: "saas", Price: 15, MarketShare: 10},
+		{Name: "Typical", Industry: "SaaS", Pricing: "Premium", MarketShare: 12},
+		{Name: "Leader", Industry: "SaaS", Pricing: "Enterprise", MarketShare: 30},
+		{Name: "Elsewhere", Industry: "Retail", Price: 15, MarketShare: 10},
+	}
+
+	analyses, err := agent.Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	want := map[string][]string{
+		"Cheap":     {"Unusually low price for SaaS (Budget vs typical Premium)"},
+		"Typical":   nil,
+		"Leader":    {"Unusually high price for SaaS (Enterprise vs typical Premium)", "Share leader for SaaS (30.0% vs 25.0% leader threshold)"},
+		"Elsewhere": nil,
+	}
+	for _, analysis := range analyses {
+		got := strings.Join(analysis.BenchmarkNotes, "|")
+		if expected := strings.Join(want[analysis.CompetitorName], "|"); got != expected {
+			t.Errorf("%s: expected notes %q, got %q", analysis.CompetitorName, expected, got)
+		}
+	}
+}
+
+func TestParseIndustryBenchmarks(t *testing.T) {
+	benchmarks, err := ParseIndustryBenchmarks("SaaS=Premium,25; Retail = Budget ; Fintech=,30")
+	if err != nil {
+		t.Fatalf("ParseIndustryBenchmarks() error = %v", err)
+	}
+
+	want := map[string]IndustryBenchmark{
+		"SaaS":    {PriceTier: "Premium", LeaderShare: 25},
+		"Retail":  {PriceTier: "Budget"},
+		"Fintech": {LeaderShare: 30},
+	}
+	if len(benchmarks) != len(want) {
+		t.Fatalf("Expected %d benchmarks, got %+v", len(want), benchmarks)
+	}
+	for industry, benchmark := range want {
+		if benchmarks[industry] != benchmark {
+			t.Errorf("%s: expected %+v, got %+v", industry, benchmark, benchmarks[industry])
+		}
+	}
+
+	for _, spec := range []string{"Premium,25", "SaaS=Premium,lots", "SaaS=Premium,120"} {
+		if _, err := ParseIndustryBenchmarks(spec); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("ParseIndustryBenchmarks(%q): expected ErrInvalidInput, got %v", spec, err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// enrichWithin runs the configured enrichers, skipping any that would start
+// after deadline and cutting short one that runs past it. A zero deadline
+// means no budget. It returns the names of the enrichers skipped or cut
+// short; core analysis never depends on them.
+func (a *CompetitorIntelligenceAgent) enrichWithin(ctx context.Context, data []CompetitorData, deadline time.Time) []string {
+	if deadline.IsZero() {
+		a.enrich(ctx, data)
+		return nil
+	}
+
+	var skipped []string
+	for _, enricher := range a.enrichers {
+		if !time.Now().Before(deadline) {
+			skipped = append(skipped, enricher.Name())
+			continue
+		}
+
+		enrichCtx, cancel := context.WithDeadline(ctx, deadline)
+		err := enricher.Enrich(enrichCtx, data)
+		if errors.Is(enrichCtx.Err(), context.DeadlineExceeded) {
+			skipped = append(skipped, enricher.Name())
+		} else if err != nil {
+			log.Printf("enrichment %s failed: %v", enricher.Name(), err)
+		}
+		cancel()
+	}
+
+	if len(skipped) > 0 {
+		log.Printf("time budget exhausted, skipped enrichment: %v", skipped)
+	}
+	return skipped
+}
+
+// budgetDeadline returns when optional work must stop for a run started at
+// start, or the zero time when no budget is configured
+func (a *CompetitorIntelligenceAgent) budgetDeadline(start time.Time) time.Time {
+	if a.Config.TimeBudget <= 0 {
+		return time.Time{}
+	}
+	return start.Add(a.Config.TimeBudget)
+}
@@ -0,0 +1,75 @@
+package adk
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// slowEnricher marks competitors after a delay, giving up when ctx ends
+type slowEnricher struct {
+	name  string
+	delay time.Duration
+	ran   bool
+}
+
+func (e *slowEnricher) Name() string { return e.name }
+
+func (e *slowEnricher) Enrich(ctx context.Context, data []CompetitorData) error {
+	e.ran = true
+	select {
+	case <-time.After(e.delay):
+		for i := range data {
+			data[i].ScreenshotURL = "https://img.example.com/" + e.name
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestRun_TimeBudget(t *testing.T) {
+	slow := &slowEnricher{name: "slow", delay: time.Second}
+	later := &slowEnricher{name: "later"}
+	agent := NewCompetitorIntelligenceAgent(WithTimeBudget(20*time.Millisecond), WithEnricher(slow), WithEnricher(later))
+
+	start := time.Now()
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the budget to cut the run short, took %v", elapsed)
+	}
+	if len(report.Competitors) == 0 || report.MarketInsights == "" {
+		t.Error("Expected a complete core report despite the budget")
+	}
+	if len(report.SkippedSteps) != 2 || report.SkippedSteps[0] != "slow" || report.SkippedSteps[1] != "later" {
+		t.Errorf("Expected both enrichers reported as skipped, got %v", report.SkippedSteps)
+	}
+	if later.ran {
+		t.Error("Expected the enricher after the budget ran out not to start")
+	}
+	for _, analysis := range report.Competitors {
+		if analysis.ScreenshotURL != "" {
+			t.Errorf("Expected no enrichment output, got %q", analysis.ScreenshotURL)
+		}
+	}
+}
+
+func TestRun_TimeBudgetNotExceeded(t *testing.T) {
+	fast := &slowEnricher{name: "fast"}
+	agent := NewCompetitorIntelligenceAgent(WithTimeBudget(time.Minute), WithEnricher(fast))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(report.SkippedSteps) != 0 {
+		t.Errorf("Expected nothing skipped within budget, got %v", report.SkippedSteps)
+	}
+	if report.Competitors[0].ScreenshotURL == "" {
+		t.Error("Expected enrichment to run within budget")
+	}
+}
@@ -0,0 +1,45 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// CanonicalJSON renders the report in a stable, diff-friendly form.
+// Object keys are sorted, competitors are ordered by name, recommendations
+// are sorted lexically and the volatile generated_at and meta fields are dropped, so
+// identical inputs always produce byte-identical output.
+func (r *CompetitorReport) CanonicalJSON() ([]byte, error) {
+	canonical := *r
+
+	canonical.Competitors = append([]CompetitorAnalysis(nil), r.Competitors...)
+	sort.SliceStable(canonical.Competitors, func(i, j int) bool {
+		return canonical.Competitors[i].CompetitorName < canonical.Competitors[j].CompetitorName
+	})
+
+	canonical.Recommendations = append([]string(nil), r.Recommendations...)
+	sort.Strings(canonical.Recommendations)
+
+	raw, err := json.Marshal(&canonical)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round-trip through a generic map so every object, including structs,
+	// is emitted with sorted keys
+	var generic map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	delete(generic, "generated_at")
+	delete(generic, "meta")
+
+	out, err := json.MarshalIndent(generic, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
@@ -0,0 +1,63 @@
+package adk
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCompetitorReport_CanonicalJSON tests that canonical output is byte-stable
+func TestCompetitorReport_CanonicalJSON(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	ctx := context.Background()
+
+	first, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	second, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	second.GeneratedAt = first.GeneratedAt.Add(time.Hour)
+
+	// Reverse slice order to prove ordering is normalized
+	last := second.Competitors[len(second.Competitors)-1].CompetitorName
+	for i, j := 0, len(second.Competitors)-1; i < j; i, j = i+1, j-1 {
+		second.Competitors[i], second.Competitors[j] = second.Competitors[j], second.Competitors[i]
+	}
+	for i, j := 0, len(second.Recommendations)-1; i < j; i, j = i+1, j-1 {
+		second.Recommendations[i], second.Recommendations[j] = second.Recommendations[j], second.Recommendations[i]
+	}
+
+	a, err := first.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("CanonicalJSON() error = %v", err)
+	}
+
+	b, err := second.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("CanonicalJSON() error = %v", err)
+	}
+
+	if !bytes.Equal(a, b) {
+		t.Errorf("Expected byte-identical canonical output, got:\n%s\nvs\n%s", a, b)
+	}
+
+	if strings.Contains(string(a), "generated_at") {
+		t.Error("Canonical JSON should not contain generated_at")
+	}
+
+	// Keys within competitor objects must be sorted
+	if strings.Index(string(a), `"competitor_name"`) > strings.Index(string(a), `"threat_level"`) {
+		t.Error("Expected competitor_name to sort before threat_level")
+	}
+
+	// The original report must not be mutated
+	if second.Competitors[0].CompetitorName != last {
+		t.Errorf("CanonicalJSON mutated the report competitors order: %s first", second.Competitors[0].CompetitorName)
+	}
+}
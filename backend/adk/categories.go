@@ -0,0 +1,66 @@
+package adk
+
+import "strings"
+
+// RecommendationCategory groups recommendations by the kind of advice
+type RecommendationCategory string
+
+// Recommendation categories, in report order
+const (
+	CategoryPricing RecommendationCategory = "Pricing"
+	CategoryProduct RecommendationCategory = "Product"
+	CategoryGTM     RecommendationCategory = "GTM"
+	CategoryOps     RecommendationCategory = "Ops"
+)
+
+// categoryOrder is the order groups appear in reports
+var categoryOrder = []RecommendationCategory{CategoryPricing, CategoryProduct, CategoryGTM, CategoryOps}
+
+// categoryKeywords infer a category for recommendations that no rule
+// generated, such as configured house recommendations; first match wins
+var categoryKeywords = []struct {
+	category RecommendationCategory
+	keywords []string
+}{
+	{CategoryPricing, []string{"pric", "discount", "bundle"}},
+	{CategoryOps, []string{"support", "onboarding", "process", "hire", "hiring", "operation"}},
+	{CategoryProduct, []string{"feature", "integration", "product", "roadmap", "differentiat"}},
+}
+
+// RecommendationGroup lists one category's recommendations in priority order
+type RecommendationGroup struct {
+	Category        RecommendationCategory `json:"category"`
+	Recommendations []string               `json:"recommendations"`
+}
+
+// inferCategory guesses a category from recommendation wording, defaulting
+// to go-to-market advice
+func inferCategory(text string) RecommendationCategory {
+	lower := strings.ToLower(text)
+	for _, rule := range categoryKeywords {
+		for _, keyword := range rule.keywords {
+			if strings.Contains(lower, keyword) {
+				return rule.category
+			}
+		}
+	}
+	return CategoryGTM
+}
+
+// groupRecommendations buckets recommendations by category, keeping their
+// order within each group and omitting empty groups
+func groupRecommendations(recommendations []Recommendation) []RecommendationGroup {
+	var groups []RecommendationGroup
+	for _, category := range categoryOrder {
+		group := RecommendationGroup{Category: category}
+		for _, rec := range recommendations {
+			if rec.Category == category {
+				group.Recommendations = append(group.Recommendations, rec.Text)
+			}
+		}
+		if len(group.Recommendations) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,63 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRecommendationCategories(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithDefaultRecommendations("Run a partner marketing campaign", "Offer annual billing discounts"))
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := map[string]RecommendationCategory{
+		"Focus on differentiation in areas where competitors are weak": CategoryProduct,
+		"Target mid-market segment with competitive pricing":           CategoryPricing,
+		"Invest in customer support to outperform competitors":         CategoryOps,
+		"Develop integrations to match competitor ecosystems":          CategoryProduct,
+		"Monitor competitor pricing and adjust strategy quarterly":     CategoryPricing,
+		"Run a partner marketing campaign":                             CategoryGTM,
+		"Offer annual billing discounts":                               CategoryPricing,
+	}
+	for _, rec := range report.RecommendationDetails {
+		if rec.Category != want[rec.Text] {
+			t.Errorf("%q: expected category %s, got %s", rec.Text, want[rec.Text], rec.Category)
+		}
+	}
+
+	var order []string
+	grouped := 0
+	for _, group := range report.RecommendationGroups {
+		order = append(order, string(group.Category))
+		for _, text := range group.Recommendations {
+			grouped++
+			if want[text] != group.Category {
+				t.Errorf("%q: expected in %s group, found in %s", text, want[text], group.Category)
+			}
+		}
+	}
+	if got := strings.Join(order, ","); got != "Pricing,Product,GTM,Ops" {
+		t.Errorf("Expected groups in category order, got %s", got)
+	}
+	if grouped != len(report.Recommendations) {
+		t.Errorf("Expected every recommendation grouped once, got %d of %d", grouped, len(report.Recommendations))
+	}
+
+	markdown := report.ToMarkdown(FormatOptions{})
+	if !strings.Contains(markdown, "**Pricing**\n\n1. Target mid-market segment with competitive pricing\n") {
+		t.Errorf("Expected grouped recommendations in Markdown, got:\n%s", markdown)
+	}
+}
+
+func TestGroupRecommendations_SkipsEmpty(t *testing.T) {
+	groups := groupRecommendations([]Recommendation{
+		{Text: "Hire support staff", Category: CategoryOps},
+		{Text: "Cut prices", Category: CategoryPricing},
+	})
+	if len(groups) != 2 || groups[0].Category != CategoryPricing || groups[1].Category != CategoryOps {
+		t.Errorf("Expected Pricing then Ops groups only, got %+v", groups)
+	}
+}
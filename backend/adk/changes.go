@@ -0,0 +1,99 @@
+package adk
+
+import (
+	"math"
+	"sort"
+)
+
+// CompetitorChange is a material difference in one competitor between two reports
+type CompetitorChange struct {
+	CompetitorName  string  `json:"competitor_name"`
+	FromShare       float64 `json:"from_share"`
+	ToShare         float64 `json:"to_share"`
+	ShareDelta      float64 `json:"share_delta"`
+	FromThreatLevel string  `json:"from_threat_level,omitempty"`
+	ToThreatLevel   string  `json:"to_threat_level,omitempty"`
+	FromRank        int     `json:"from_rank,omitempty"`
+	ToRank          int     `json:"to_rank,omitempty"`
+	// Added and Removed mark competitors present in only one report
+	Added   bool `json:"added,omitempty"`
+	Removed bool `json:"removed,omitempty"`
+}
+
+// materialChange reports whether a move is significant under minShareChange.
+// Threat level changes are always material; a zero threshold surfaces any
+// share movement.
+func materialChange(shareDelta float64, fromLevel, toLevel string, minShareChange float64) bool {
+	if fromLevel != toLevel {
+		return true
+	}
+	if minShareChange <= 0 {
+		return shareDelta != 0
+	}
+	return math.Abs(shareDelta) > minShareChange
+}
+
+// DiffReports lists competitors that changed materially from previous to
+// current: share moving more than minShareChange points, a threat level
+// change, or a competitor appearing or disappearing. Results are sorted by
+// name.
+func DiffReports(previous, current *CompetitorReport, minShareChange float64) []CompetitorChange {
+	prior := make(map[string]CompetitorAnalysis, len(previous.Competitors))
+	for _, analysis := range previous.Competitors {
+		prior[nameKey(analysis.CompetitorName)] = analysis
+	}
+
+	changes := []CompetitorChange{}
+	seen := make(map[string]bool, len(current.Competitors))
+	for _, analysis := range current.Competitors {
+		key := nameKey(analysis.CompetitorName)
+		seen[key] = true
+
+		before, ok := prior[key]
+		if !ok {
+			changes = append(changes, CompetitorChange{
+				CompetitorName: analysis.CompetitorName,
+				ToShare:        analysis.MarketShare,
+				ShareDelta:     analysis.MarketShare,
+				ToThreatLevel:  analysis.ThreatLevel,
+				ToRank:         analysis.Rank,
+				Added:          true,
+			})
+			continue
+		}
+
+		delta := round2(analysis.MarketShare - before.MarketShare)
+		if !materialChange(delta, before.ThreatLevel, analysis.ThreatLevel, minShareChange) {
+			continue
+		}
+		changes = append(changes, CompetitorChange{
+			CompetitorName:  analysis.CompetitorName,
+			FromShare:       before.MarketShare,
+			ToShare:         analysis.MarketShare,
+			ShareDelta:      delta,
+			FromThreatLevel: before.ThreatLevel,
+			ToThreatLevel:   analysis.ThreatLevel,
+			FromRank:        before.Rank,
+			ToRank:          analysis.Rank,
+		})
+	}
+
+	for _, analysis := range previous.Competitors {
+		if seen[nameKey(analysis.CompetitorName)] {
+			continue
+		}
+		changes = append(changes, CompetitorChange{
+			CompetitorName:  analysis.CompetitorName,
+			FromShare:       analysis.MarketShare,
+			ShareDelta:      -analysis.MarketShare,
+			FromThreatLevel: analysis.ThreatLevel,
+			FromRank:        analysis.Rank,
+			Removed:         true,
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].CompetitorName < changes[j].CompetitorName
+	})
+	return changes
+}
@@ -0,0 +1,77 @@
+package adk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffReports_Threshold(t *testing.T) {
+	previous := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{CompetitorName: "Small Move", MarketShare: 10, ThreatLevel: ThreatMedium},
+		{CompetitorName: "Big Move", MarketShare: 12, ThreatLevel: ThreatMedium},
+		{CompetitorName: "Level Change", MarketShare: 20, ThreatLevel: ThreatMedium},
+		{CompetitorName: "Gone", MarketShare: 5, ThreatLevel: ThreatLow},
+	}}
+	current := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{CompetitorName: "Small Move", MarketShare: 12, ThreatLevel: ThreatMedium},
+		{CompetitorName: "Big Move", MarketShare: 22, ThreatLevel: ThreatMedium},
+		{CompetitorName: "Level Change", MarketShare: 21, ThreatLevel: ThreatHigh},
+		{CompetitorName: "New", MarketShare: 3, ThreatLevel: ThreatLow},
+	}}
+
+	tests := []struct {
+		name      string
+		threshold float64
+		want      []string
+	}{
+		{name: "No threshold", want: []string{"Big Move", "Gone", "Level Change", "New", "Small Move"}},
+		{name: "Five points", threshold: 5, want: []string{"Big Move", "Gone", "Level Change", "New"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := DiffReports(previous, current, tt.threshold)
+			if len(changes) != len(tt.want) {
+				t.Fatalf("Expected changes for %v, got %+v", tt.want, changes)
+			}
+			for i, change := range changes {
+				if change.CompetitorName != tt.want[i] {
+					t.Errorf("Expected change %d for %s, got %s", i, tt.want[i], change.CompetitorName)
+				}
+			}
+		})
+	}
+
+	changes := DiffReports(previous, current, 5)
+	if big := changes[0]; big.ShareDelta != 10 || big.FromShare != 12 || big.ToShare != 22 {
+		t.Errorf("Expected a 10-point move from 12 to 22, got %+v", big)
+	}
+	if !changes[1].Removed || !changes[3].Added {
+		t.Errorf("Expected Gone removed and New added, got %+v", changes)
+	}
+}
+
+func TestMaterialRankingChanges(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []*CompetitorReport{
+		{ID: "r1", GeneratedAt: base, Competitors: []CompetitorAnalysis{
+			{CompetitorName: "A", Rank: 1, MarketShare: 20, ThreatLevel: ThreatMedium},
+			{CompetitorName: "B", Rank: 2, MarketShare: 19, ThreatLevel: ThreatMedium},
+			{CompetitorName: "C", Rank: 3, MarketShare: 8, ThreatLevel: ThreatLow},
+		}},
+		{ID: "r2", GeneratedAt: base.Add(time.Hour), Competitors: []CompetitorAnalysis{
+			{CompetitorName: "A", Rank: 3, MarketShare: 18, ThreatLevel: ThreatMedium},
+			{CompetitorName: "B", Rank: 2, MarketShare: 19, ThreatLevel: ThreatMedium},
+			{CompetitorName: "C", Rank: 1, MarketShare: 18, ThreatLevel: ThreatMedium},
+		}},
+	}
+
+	if changes := RankingChanges(history); len(changes) != 2 {
+		t.Errorf("Expected every rank move without a threshold, got %+v", changes)
+	}
+
+	changes := MaterialRankingChanges(history, 5)
+	if len(changes) != 1 || changes[0].CompetitorName != "C" {
+		t.Errorf("Expected only C's 10-point move to surface, got %+v", changes)
+	}
+}
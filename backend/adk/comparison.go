@@ -0,0 +1,67 @@
+package adk
+
+import "fmt"
+
+// ReportComparison describes how one stored report differs from another
+type ReportComparison struct {
+	A string `json:"a"`
+	B string `json:"b"`
+	// Changes are the material competitor moves from A to B, as DiffReports
+	Changes []CompetitorChange `json:"changes"`
+	// ThreatDeltas count how many more (or fewer) competitors B places at
+	// each threat level than A
+	ThreatDeltas           map[string]int `json:"threat_deltas"`
+	AddedRecommendations   []string       `json:"added_recommendations"`
+	RemovedRecommendations []string       `json:"removed_recommendations"`
+	// Warnings flag reports that may not be meaningfully comparable
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// CompareReports diffs report a against report b: competitor changes beyond
+// minShareChange, threat level counts, and recommendations gained or lost.
+// Reports for different companies are still compared but carry a warning.
+func CompareReports(a, b *CompetitorReport, minShareChange float64) *ReportComparison {
+	comparison := &ReportComparison{
+		A:                      a.ID,
+		B:                      b.ID,
+		Changes:                DiffReports(a, b, minShareChange),
+		ThreatDeltas:           threatDeltas(a, b),
+		AddedRecommendations:   missingRecommendations(b.Recommendations, a.Recommendations),
+		RemovedRecommendations: missingRecommendations(a.Recommendations, b.Recommendations),
+	}
+	if nameKey(a.TargetCompany) != nameKey(b.TargetCompany) {
+		comparison.Warnings = append(comparison.Warnings,
+			fmt.Sprintf("Reports analyze different companies (%s and %s); deltas may not be meaningful", a.TargetCompany, b.TargetCompany))
+	}
+	return comparison
+}
+
+// threatDeltas counts competitors per threat level in b minus those in a,
+// covering every level either report uses
+func threatDeltas(a, b *CompetitorReport) map[string]int {
+	deltas := make(map[string]int)
+	for _, analysis := range a.Competitors {
+		deltas[analysis.ThreatLevel]--
+	}
+	for _, analysis := range b.Competitors {
+		deltas[analysis.ThreatLevel]++
+	}
+	return deltas
+}
+
+// missingRecommendations lists, in order, the recommendations in from that
+// other lacks, ignoring case and spacing
+func missingRecommendations(from, other []string) []string {
+	present := make(map[string]bool, len(other))
+	for _, text := range other {
+		present[nameKey(text)] = true
+	}
+
+	missing := []string{}
+	for _, text := range from {
+		if !present[nameKey(text)] {
+			missing = append(missing, text)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,63 @@
+package adk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompareReports(t *testing.T) {
+	a := &CompetitorReport{
+		ID:            "a",
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{
+			{CompetitorName: "Rising", MarketShare: 10, ThreatLevel: ThreatMedium},
+			{CompetitorName: "Gone", MarketShare: 5, ThreatLevel: ThreatLow},
+		},
+		Recommendations: []string{"Keep", "Dropped"},
+	}
+	b := &CompetitorReport{
+		ID:            "b",
+		TargetCompany: " testcorp ",
+		Competitors: []CompetitorAnalysis{
+			{CompetitorName: "Rising", MarketShare: 25, ThreatLevel: ThreatHigh},
+			{CompetitorName: "New", MarketShare: 3, ThreatLevel: ThreatLow},
+		},
+		Recommendations: []string{"keep", "Added"},
+	}
+
+	comparison := CompareReports(a, b, 0)
+
+	if comparison.A != "a" || comparison.B != "b" {
+		t.Errorf("Expected ids a and b, got %q and %q", comparison.A, comparison.B)
+	}
+	if len(comparison.Changes) != 3 {
+		t.Errorf("Expected 3 competitor changes, got %+v", comparison.Changes)
+	}
+
+	wantThreats := map[string]int{ThreatHigh: 1, ThreatMedium: -1, ThreatLow: 0}
+	for level, want := range wantThreats {
+		if got, ok := comparison.ThreatDeltas[level]; !ok || got != want {
+			t.Errorf("Expected %s delta %d, got %d (present %v)", level, want, got, ok)
+		}
+	}
+
+	if len(comparison.AddedRecommendations) != 1 || comparison.AddedRecommendations[0] != "Added" {
+		t.Errorf("Expected Added recommended, got %v", comparison.AddedRecommendations)
+	}
+	if len(comparison.RemovedRecommendations) != 1 || comparison.RemovedRecommendations[0] != "Dropped" {
+		t.Errorf("Expected Dropped removed, got %v", comparison.RemovedRecommendations)
+	}
+	if len(comparison.Warnings) != 0 {
+		t.Errorf("Expected no warnings for the same company, got %v", comparison.Warnings)
+	}
+}
+
+func TestCompareReports_DifferentCompanies(t *testing.T) {
+	a := &CompetitorReport{TargetCompany: "TestCorp"}
+	b := &CompetitorReport{TargetCompany: "OtherCorp"}
+
+	comparison := CompareReports(a, b, 0)
+	if len(comparison.Warnings) != 1 || !strings.Contains(comparison.Warnings[0], "OtherCorp") {
+		t.Errorf("Expected a different-company warning, got %v", comparison.Warnings)
+	}
+}
@@ -0,0 +1,101 @@
+package adk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Competitor categories; any other label is accepted and rolled up as-is
+const (
+	CompetitorDirect   = "direct"
+	CompetitorIndirect = "indirect"
+	// CompetitorOther buckets competitors with no category
+	CompetitorOther = "other"
+)
+
+// competitorCategoryOrder lists the categories shown first in rollups;
+// custom categories follow alphabetically and CompetitorOther comes last
+var competitorCategoryOrder = []string{CompetitorDirect, CompetitorIndirect}
+
+// CategoryShare is the combined market share of one competitor category
+type CategoryShare struct {
+	Category    string  `json:"category"`
+	Share       float64 `json:"share"`
+	Competitors int     `json:"competitors"`
+}
+
+// categoryFor returns a competitor's category, preferring the data's own
+// over one configured by name
+func (a *CompetitorIntelligenceAgent) categoryFor(competitor CompetitorData) string {
+	category := competitor.Category
+	if category == "" {
+		for name, configured := range a.Config.CompetitorCategories {
+			if nameKey(name) == nameKey(competitor.Name) {
+				category = configured
+			}
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(category))
+}
+
+// categoryShares sums market share by competitor category. It is nil when
+// no competitor is categorized, since a lone "other" bucket says nothing.
+func categoryShares(analyses []CompetitorAnalysis) []CategoryShare {
+	totals := make(map[string]*CategoryShare)
+	categorized := false
+	for _, analysis := range analyses {
+		category := analysis.Category
+		if category == "" {
+			category = CompetitorOther
+		} else {
+			categorized = true
+		}
+		if totals[category] == nil {
+			totals[category] = &CategoryShare{Category: category}
+		}
+		totals[category].Share += analysis.MarketShare
+		totals[category].Competitors++
+	}
+	if !categorized {
+		return nil
+	}
+
+	rank := func(category string) int {
+		for i, known := range competitorCategoryOrder {
+			if category == known {
+				return i
+			}
+		}
+		if category == CompetitorOther {
+			return len(competitorCategoryOrder) + 1
+		}
+		return len(competitorCategoryOrder)
+	}
+
+	shares := make([]CategoryShare, 0, len(totals))
+	for _, total := range totals {
+		total.Share = round2(total.Share)
+		shares = append(shares, *total)
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if ri, rj := rank(shares[i].Category), rank(shares[j].Category); ri != rj {
+			return ri < rj
+		}
+		return shares[i].Category < shares[j].Category
+	})
+	return shares
+}
+
+// writeCategoryShares renders the category rollup as a Markdown table
+func writeCategoryShares(b *strings.Builder, shares []CategoryShare) {
+	if len(shares) == 0 {
+		return
+	}
+	b.WriteString("### Market Share by Category\n\n")
+	b.WriteString("| Category | Share | Competitors |\n|---|---|---|\n")
+	for _, share := range shares {
+		fmt.Fprintf(b, "| %s | %s%% | %d |\n", share.Category, formatFloat(share.Share), share.Competitors)
+	}
+	b.WriteString("\n")
+}
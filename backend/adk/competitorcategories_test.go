@@ -0,0 +1,64 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCategoryShares(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithCompetitorCategories(map[string]string{
+		"gamma": "Indirect",
+		"acme":  CompetitorIndirect, // overridden by the data's own category
+	}))
+	ctx := context.Background()
+	analyses, err := agent.Analyze(ctx, []CompetitorData{
+		{Name: "Acme", MarketShare: 30.5, Category: "Direct"},
+		{Name: "Beta", MarketShare: 10.25, Category: CompetitorDirect},
+		{Name: "Gamma", MarketShare: 5},
+		{Name: "Delta", MarketShare: 4, Category: "adjacent"},
+		{Name: "Epsilon", MarketShare: 2},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	report, err := agent.GenerateReport(ctx, "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	want := []CategoryShare{
+		{Category: CompetitorDirect, Share: 40.75, Competitors: 2},
+		{Category: CompetitorIndirect, Share: 5, Competitors: 1},
+		{Category: "adjacent", Share: 4, Competitors: 1},
+		{Category: CompetitorOther, Share: 2, Competitors: 1},
+	}
+	if len(report.CategoryShares) != len(want) {
+		t.Fatalf("Expected %d categories, got %+v", len(want), report.CategoryShares)
+	}
+	var total, competitorTotal float64
+	for i, share := range report.CategoryShares {
+		if share != want[i] {
+			t.Errorf("Expected %+v, got %+v", want[i], share)
+		}
+		total += share.Share
+	}
+	for _, analysis := range report.Competitors {
+		competitorTotal += analysis.MarketShare
+	}
+	if round2(total) != round2(competitorTotal) {
+		t.Errorf("Expected category shares to sum to %v, got %v", competitorTotal, total)
+	}
+
+	md := report.ToMarkdown(FormatOptions{})
+	if !strings.Contains(md, "### Market Share by Category") || !strings.Contains(md, "| direct | 40.75% | 2 |") {
+		t.Errorf("Expected the category breakdown in Markdown, got:\n%s", md)
+	}
+}
+
+func TestCategoryShares_Uncategorized(t *testing.T) {
+	shares := categoryShares([]CompetitorAnalysis{{CompetitorName: "Acme", MarketShare: 30}})
+	if shares != nil {
+		t.Errorf("Expected no breakdown without categories, got %+v", shares)
+	}
+}
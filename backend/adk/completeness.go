@@ -0,0 +1,33 @@
+package adk
+
+// sparseConfidence is the confidence multiplier for a record with none of
+// the expected fields; fully populated records are not discounted
+const sparseConfidence = 0.5
+
+// completenessFields are the fields an analysis expects a competitor to have
+var completenessFields = []func(CompetitorData) bool{
+	func(c CompetitorData) bool { return c.Website != "" },
+	func(c CompetitorData) bool { return c.Industry != "" },
+	func(c CompetitorData) bool { return len(c.Products) > 0 },
+	func(c CompetitorData) bool { return c.Pricing != "" || c.Price > 0 },
+	func(c CompetitorData) bool { return c.MarketShare > 0 },
+	func(c CompetitorData) bool { return len(c.Strengths) > 0 },
+	func(c CompetitorData) bool { return len(c.Weaknesses) > 0 },
+}
+
+// completeness is the fraction of expected fields the competitor populates
+func completeness(competitor CompetitorData) float64 {
+	populated := 0
+	for _, present := range completenessFields {
+		if present(competitor) {
+			populated++
+		}
+	}
+	return round2(float64(populated) / float64(len(completenessFields)))
+}
+
+// completenessFactor scales confidence linearly from sparseConfidence for an
+// empty record up to 1 for a complete one
+func completenessFactor(completeness float64) float64 {
+	return sparseConfidence + (1-sparseConfidence)*completeness
+}
@@ -0,0 +1,65 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCompleteness(t *testing.T) {
+	tests := []struct {
+		name string
+		data CompetitorData
+		want float64
+	}{
+		{"fully populated", completeData("Full"), 1},
+		{"numeric price counts as pricing", func() CompetitorData {
+			data := completeData("Priced")
+			data.Pricing, data.Price = "", 49
+			return data
+		}(), 1},
+		{"name only", CompetitorData{Name: "Ghost"}, 0},
+		{"missing pricing and strengths", func() CompetitorData {
+			data := completeData("Thin")
+			data.Pricing, data.Strengths = "", nil
+			return data
+		}(), 0.71},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := completeness(tt.data); got != tt.want {
+				t.Errorf("Expected completeness %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAnalyze_Completeness(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	sparse := CompetitorData{Name: "Sparse Corp", MarketShare: 12}
+
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{completeData("Full Corp"), sparse})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	full, thin := analyses[0], analyses[1]
+	if full.Completeness != 1 {
+		t.Errorf("Expected a fully populated competitor to score 1, got %v", full.Completeness)
+	}
+	if thin.Completeness >= full.Completeness {
+		t.Errorf("Expected a sparse competitor to score lower, got %v", thin.Completeness)
+	}
+	if thin.Confidence >= full.Confidence {
+		t.Errorf("Expected sparse data to lower confidence, got %v vs %v", thin.Confidence, full.Confidence)
+	}
+}
+
+func TestToMarkdown_Completeness(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "Thin", Completeness: 0.71}}}
+
+	if out := report.ToMarkdown(FormatOptions{}); !strings.Contains(out, "- **Data completeness:** 71%") {
+		t.Errorf("Expected completeness in Markdown, got:\n%s", out)
+	}
+}
@@ -0,0 +1,108 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Freshness window: data younger than freshFor is fully trusted, and trust
+// declines linearly to staleConfidence at staleAfter
+const (
+	freshFor        = 30 * 24 * time.Hour
+	staleAfter      = 180 * 24 * time.Hour
+	staleConfidence = 0.5
+)
+
+// Confidence bands used for report notes
+const (
+	highConfidence     = 0.8
+	moderateConfidence = 0.5
+)
+
+// dataConfidence combines source confidence with data freshness and
+// completeness, discounting competitors whose website failed a reachability
+// check
+func dataConfidence(competitor CompetitorData, now time.Time) float64 {
+	confidence := competitor.Confidence
+	if confidence <= 0 {
+		confidence = 1
+	}
+	confidence = min(confidence, 1) * freshnessFactor(competitor.RetrievedAt, now)
+	confidence *= completenessFactor(completeness(competitor))
+	if competitor.WebsiteStatus != nil && !competitor.WebsiteStatus.Reachable {
+		confidence *= unreachablePenalty
+	}
+	return round2(confidence)
+}
+
+// freshnessFactor discounts data by age; unknown retrieval times are not penalized
+func freshnessFactor(retrievedAt, now time.Time) float64 {
+	if retrievedAt.IsZero() {
+		return 1
+	}
+	age := now.Sub(retrievedAt)
+	switch {
+	case age <= freshFor:
+		return 1
+	case age >= staleAfter:
+		return staleConfidence
+	default:
+		progress := float64(age-freshFor) / float64(staleAfter-freshFor)
+		return 1 - progress*(1-staleConfidence)
+	}
+}
+
+// analysisConfidence treats unrated analyses as fully confident
+func analysisConfidence(analysis CompetitorAnalysis) float64 {
+	if analysis.Confidence <= 0 {
+		return 1
+	}
+	return analysis.Confidence
+}
+
+// averageConfidence is the mean confidence of the analyses (0 when empty)
+func averageConfidence(analyses []CompetitorAnalysis) float64 {
+	if len(analyses) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, analysis := range analyses {
+		total += analysisConfidence(analysis)
+	}
+	return round2(total / float64(len(analyses)))
+}
+
+// confidenceNote summarizes how much the recommendations can be trusted
+func confidenceNote(analyses []CompetitorAnalysis, recommendations []Recommendation) string {
+	if len(analyses) == 0 {
+		return "Low confidence: no competitor data was available to support these recommendations."
+	}
+
+	total := 0.0
+	for _, rec := range recommendations {
+		total += rec.Confidence
+	}
+	overall := total / float64(len(recommendations))
+
+	var weak []string
+	for _, analysis := range analyses {
+		if analysisConfidence(analysis) < moderateConfidence {
+			weak = append(weak, analysis.CompetitorName)
+		}
+	}
+
+	var note string
+	switch {
+	case overall >= highConfidence:
+		note = "High confidence: recommendations are backed by fresh, well-sourced data."
+	case overall >= moderateConfidence:
+		note = "Moderate confidence: some recommendations rest on thin or stale data."
+	default:
+		note = "Low confidence: recommendations rest largely on thin or stale data."
+	}
+	if len(weak) > 0 {
+		note += fmt.Sprintf(" Treat findings about %s with caution.", strings.Join(weak, ", "))
+	}
+	return note
+}
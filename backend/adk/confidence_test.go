@@ -0,0 +1,114 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// completeData returns a record with every expected field populated, so
+// confidence tests are not discounted for completeness
+func completeData(name string) CompetitorData {
+	return CompetitorData{
+		Name:        name,
+		Website:     "https://example.com",
+		Industry:    "SaaS",
+		Products:    []string{"Platform"},
+		Pricing:     "Mid-range",
+		MarketShare: 10,
+		Strengths:   []string{"Brand"},
+		Weaknesses:  []string{"Slow support"},
+	}
+}
+
+// TestDataConfidence tests source confidence and freshness discounting
+func TestDataConfidence(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	with := func(confidence float64, retrievedAt time.Time) CompetitorData {
+		data := completeData("Example")
+		data.Confidence = confidence
+		data.RetrievedAt = retrievedAt
+		return data
+	}
+
+	tests := []struct {
+		name string
+		data CompetitorData
+		want float64
+	}{
+		{name: "Unrated and undated", data: with(0, time.Time{}), want: 1},
+		{name: "Fresh", data: with(0.9, now.Add(-24*time.Hour)), want: 0.9},
+		{name: "Stale", data: with(1, now.Add(-365*24*time.Hour)), want: 0.5},
+		{name: "Halfway stale", data: with(0, now.Add(-105*24*time.Hour)), want: 0.75},
+		{name: "Empty record", data: CompetitorData{}, want: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dataConfidence(tt.data, now); got != tt.want {
+				t.Errorf("dataConfidence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGenerateReport_RecommendationConfidence tests that low-confidence data propagates
+func TestGenerateReport_RecommendationConfidence(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	ctx := context.Background()
+
+	solid := completeData("Solid Corp")
+	solid.Pricing, solid.MarketShare = "Premium", 25
+	solid.Weaknesses = []string{"High prices"}
+	solid.Confidence, solid.RetrievedAt = 0.95, time.Now()
+
+	rumor := completeData("Rumor Corp")
+	rumor.Confidence, rumor.RetrievedAt = 0.3, time.Now().Add(-400*24*time.Hour)
+
+	analyses, err := agent.Analyze(ctx, []CompetitorData{solid, rumor})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	report, err := agent.GenerateReport(ctx, "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	byText := make(map[string]Recommendation)
+	for _, rec := range report.RecommendationDetails {
+		byText[rec.Text] = rec
+	}
+
+	support := byText["Invest in customer support to outperform competitors"]
+	if support.Confidence != 0.15 {
+		t.Errorf("Expected support recommendation confidence 0.15 from Rumor Corp, got %v", support.Confidence)
+	}
+	if len(support.Sources) != 1 || support.Sources[0] != "Rumor Corp" {
+		t.Errorf("Expected Rumor Corp as the only source, got %v", support.Sources)
+	}
+
+	pricing := byText["Target mid-market segment with competitive pricing"]
+	if pricing.Confidence != 0.95 {
+		t.Errorf("Expected pricing recommendation confidence 0.95 from Solid Corp, got %v", pricing.Confidence)
+	}
+
+	// Equal priority: the high-confidence recommendation sorts first
+	pricingIdx, supportIdx := -1, -1
+	for i, text := range report.Recommendations {
+		switch text {
+		case pricing.Text:
+			pricingIdx = i
+		case support.Text:
+			supportIdx = i
+		}
+	}
+	if pricingIdx > supportIdx {
+		t.Errorf("Expected high-confidence recommendation before low-confidence one, got %v", report.Recommendations)
+	}
+
+	if !strings.Contains(report.ConfidenceNote, "Rumor Corp") {
+		t.Errorf("Expected confidence note to flag Rumor Corp, got %q", report.ConfidenceNote)
+	}
+}
@@ -0,0 +1,60 @@
+package adk
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Customer count signals
+const (
+	// defaultLargeCustomerBase is the customer count credited as a large
+	// installed base
+	defaultLargeCustomerBase = 10000
+	// maxCustomerScore is the threat score a saturated customer base adds
+	maxCustomerScore = 10.0
+	// tinyShare is the market share, in percent, below which a large
+	// customer base looks inconsistent
+	tinyShare = 1.0
+)
+
+// largeCustomerBase returns the configured installed base threshold
+func (a *CompetitorIntelligenceAgent) largeCustomerBase() int {
+	if a.Config.LargeCustomerBase > 0 {
+		return a.Config.LargeCustomerBase
+	}
+	return defaultLargeCustomerBase
+}
+
+// customerScore scales a customer count onto 0-maxCustomerScore
+// logarithmically, saturating at ten times the default large base
+func customerScore(customers int) float64 {
+	if customers <= 1 {
+		return 0
+	}
+	saturation := math.Log10(defaultLargeCustomerBase * 10)
+	return math.Min(math.Log10(float64(customers))/saturation, 1) * maxCustomerScore
+}
+
+// customerDifferentiator describes a large installed base
+func customerDifferentiator(customers int) string {
+	return fmt.Sprintf("Large installed base (%s customers)", groupThousands(customers))
+}
+
+// customerWarnings flags customer counts at odds with market share
+func (a *CompetitorIntelligenceAgent) customerWarnings(competitor CompetitorData) []string {
+	if competitor.Customers < a.largeCustomerBase() || competitor.MarketShare <= 0 || competitor.MarketShare >= tinyShare {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s customers is inconsistent with a %s%% market share",
+		groupThousands(competitor.Customers), formatFloat(competitor.MarketShare))}
+}
+
+// groupThousands formats n with comma thousands separators
+func groupThousands(n int) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0 && s[i-1] != '-'; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze_Customers(t *testing.T) {
+	tests := []struct {
+		name              string
+		opts              []Option
+		competitor        CompetitorData
+		wantDifferentiate bool
+		wantWarning       bool
+	}{
+		{
+			name:              "Large base",
+			competitor:        CompetitorData{Name: "Acme", MarketShare: 12, Customers: 25000},
+			wantDifferentiate: true,
+		},
+		{
+			name:       "Small base",
+			competitor: CompetitorData{Name: "Acme", MarketShare: 12, Customers: 800},
+		},
+		{
+			name:              "Configured threshold",
+			opts:              []Option{WithLargeCustomerBase(500)},
+			competitor:        CompetitorData{Name: "Acme", MarketShare: 12, Customers: 800},
+			wantDifferentiate: true,
+		},
+		{
+			name:              "Tiny share",
+			competitor:        CompetitorData{Name: "Acme", MarketShare: 0.5, Customers: 1200000},
+			wantDifferentiate: true,
+			wantWarning:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyses, err := NewCompetitorIntelligenceAgent(tt.opts...).Analyze(context.Background(), []CompetitorData{tt.competitor})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			analysis := analyses[0]
+
+			found := false
+			for _, d := range analysis.KeyDifferentiators {
+				if strings.HasPrefix(d, "Large installed base") {
+					found = true
+				}
+			}
+			if found != tt.wantDifferentiate {
+				t.Errorf("Expected installed base differentiator %v, got %v", tt.wantDifferentiate, analysis.KeyDifferentiators)
+			}
+			if (len(analysis.DataWarnings) > 0) != tt.wantWarning {
+				t.Errorf("Expected data warning %v, got %v", tt.wantWarning, analysis.DataWarnings)
+			}
+			if analysis.Customers != tt.competitor.Customers {
+				t.Errorf("Expected customers %d, got %d", tt.competitor.Customers, analysis.Customers)
+			}
+		})
+	}
+}
+
+func TestThreatScore_Customers(t *testing.T) {
+	without := threatScoreBreakdown(CompetitorData{MarketShare: 10})
+	with := threatScoreBreakdown(CompetitorData{MarketShare: 10, Customers: 100000})
+
+	if _, ok := without[ScoreComponentCustomers]; ok {
+		t.Error("Expected no customers component without a customer count")
+	}
+	if with[ScoreComponentCustomers] != maxCustomerScore {
+		t.Errorf("Expected a saturated customers component of %v, got %v", maxCustomerScore, with[ScoreComponentCustomers])
+	}
+	if sumBreakdown(with) <= sumBreakdown(without) {
+		t.Error("Expected a customer base to raise the threat score")
+	}
+}
+
+func TestGroupThousands(t *testing.T) {
+	tests := map[int]string{0: "0", 999: "999", 1000: "1,000", 1234567: "1,234,567", -12000: "-12,000"}
+	for n, want := range tests {
+		if got := groupThousands(n); got != want {
+			t.Errorf("groupThousands(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
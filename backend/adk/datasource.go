@@ -0,0 +1,92 @@
+package adk
+
+import (
+	"context"
+	"time"
+)
+
+// DataSource supplies raw competitor data for market research
+type DataSource interface {
+	// Name identifies the source in provenance and logs
+	Name() string
+	// FetchCompetitors returns competitors of companyName in industry
+	FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error)
+}
+
+// StubDataSource returns simulated market data
+type StubDataSource struct{}
+
+// Name identifies the source
+func (StubDataSource) Name() string {
+	return "stub"
+}
+
+// FetchCompetitors returns a fixed set of sample competitors
+func (StubDataSource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	// Simulated market research - in production, this would call external APIs
+	// like Crunchbase, LinkedIn, or industry-specific data sources
+	retrievedAt := time.Now()
+	competitors := []CompetitorData{
+		{
+			Name:        "Competitor A",
+			Website:     "https://competitor-a.com",
+			Industry:    industry,
+			Products:    []string{"Product 1", "Product 2", "Product 3"},
+			Pricing:     "Premium",
+			MarketShare: 25.5,
+			Strengths:   []string{"Strong brand", "Large customer base", "Innovation"},
+			Weaknesses:  []string{"High prices", "Slow support", "Limited features"},
+			TechStack:   []string{"Go", "PostgreSQL", "Kubernetes", "React"},
+			Funding:     250,
+			KeyPeople: []Person{
+				{Name: "Alex Rivera", Role: "CEO", YearsExperience: 22},
+				{Name: "Sam Chen", Role: "CTO", YearsExperience: 15},
+			},
+			RetrievedAt: retrievedAt,
+		},
+		{
+			Name:        "Competitor B",
+			Website:     "https://competitor-b.com",
+			Industry:    industry,
+			Products:    []string{"Product X", "Product Y"},
+			Pricing:     "Mid-range",
+			MarketShare: 18.2,
+			Strengths:   []string{"Affordable", "Good UX", "Fast growth"},
+			Weaknesses:  []string{"Limited market presence", "Newer player", "Fewer integrations"},
+			TechStack:   []string{"Node.js", "PostgreSQL", "Kubernetes", "React"},
+			Funding:     80,
+			KeyPeople: []Person{
+				{Name: "Jordan Lee", Role: "Founder & CEO", YearsExperience: 6},
+			},
+			RetrievedAt: retrievedAt,
+		},
+		{
+			Name:        "Competitor C",
+			Website:     "https://competitor-c.com",
+			Industry:    industry,
+			Products:    []string{"Enterprise Suite"},
+			Pricing:     "Enterprise",
+			MarketShare: 12.8,
+			Strengths:   []string{"Enterprise features", "Security", "Compliance"},
+			Weaknesses:  []string{"Expensive", "Complex setup", "Steep learning curve"},
+			TechStack:   []string{"Java", "Oracle", "Kubernetes"},
+			Funding:     400,
+			KeyPeople: []Person{
+				{Name: "Morgan Patel", Role: "CEO", YearsExperience: 25},
+				{Name: "Casey Brooks", Role: "Chief Information Security Officer", YearsExperience: 18},
+				{Name: "Riley Kim", Role: "Head of Partnerships", YearsExperience: 9},
+			},
+			RetrievedAt: retrievedAt,
+		},
+	}
+
+	return competitors, nil
+}
+
+// DataSource returns the configured data source, defaulting to the stub
+func (a *CompetitorIntelligenceAgent) DataSource() DataSource {
+	if a.source == nil {
+		return StubDataSource{}
+	}
+	return a.source
+}
@@ -0,0 +1,105 @@
+package adk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// threatColors maps threat levels to GraphViz fill colors
+var threatColors = map[string]string{
+	"High":   "#e74c3c",
+	"Medium": "#f39c12",
+	"Low":    "#2ecc71",
+}
+
+// ToDOT renders the report as a GraphViz digraph. Competitors are filled by
+// threat level and linked to shared strength and weakness nodes, so
+// competitors with common traits cluster together. Output is sorted and
+// therefore deterministic.
+func (r *CompetitorReport) ToDOT() (string, error) {
+	competitors := append([]CompetitorAnalysis(nil), r.Competitors...)
+	sort.SliceStable(competitors, func(i, j int) bool {
+		return competitors[i].CompetitorName < competitors[j].CompetitorName
+	})
+
+	strengths := make(map[string]string)
+	weaknesses := make(map[string]string)
+	var edges []string
+
+	for _, competitor := range competitors {
+		if competitor.CompetitorName == "" {
+			return "", fmt.Errorf("competitor without a name cannot be rendered")
+		}
+		from := dotID("competitor", competitor.CompetitorName)
+		for _, strength := range competitor.KeyDifferentiators {
+			key := nameKey(strength)
+			if _, ok := strengths[key]; !ok {
+				strengths[key] = strength
+			}
+			edges = append(edges, fmt.Sprintf("  %s -> %s [color=\"#27ae60\"];", from, dotID("strength", key)))
+		}
+		for _, weakness := range competitor.Weaknesses {
+			key := nameKey(weakness)
+			if _, ok := weaknesses[key]; !ok {
+				weaknesses[key] = weakness
+			}
+			edges = append(edges, fmt.Sprintf("  %s -> %s [color=\"#c0392b\", style=dashed];", from, dotID("weakness", key)))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote("positioning"))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [fontname=\"Helvetica\"];\n")
+
+	for _, competitor := range competitors {
+		color, ok := threatColors[competitor.ThreatLevel]
+		if !ok {
+			color = "#bdc3c7"
+		}
+		label := fmt.Sprintf("%s\\n%s threat", dotEscape(competitor.CompetitorName), dotEscape(competitor.ThreatLevel))
+		fmt.Fprintf(&b, "  %s [label=\"%s\", shape=box, style=filled, fillcolor=%s];\n",
+			dotID("competitor", competitor.CompetitorName), label, dotQuote(color))
+	}
+
+	for _, key := range sortedKeys(strengths) {
+		fmt.Fprintf(&b, "  %s [label=%s, shape=ellipse, color=\"#27ae60\"];\n", dotID("strength", key), dotQuote(strengths[key]))
+	}
+	for _, key := range sortedKeys(weaknesses) {
+		fmt.Fprintf(&b, "  %s [label=%s, shape=ellipse, color=\"#c0392b\"];\n", dotID("weakness", key), dotQuote(weaknesses[key]))
+	}
+
+	for _, edge := range edges {
+		b.WriteString(edge)
+		b.WriteByte('\n')
+	}
+	b.WriteString("}\n")
+
+	return b.String(), nil
+}
+
+// dotID builds a quoted node identifier namespaced by kind
+func dotID(kind, name string) string {
+	return dotQuote(kind + ":" + name)
+}
+
+// dotQuote wraps a value as a quoted DOT string
+func dotQuote(value string) string {
+	return `"` + dotEscape(value) + `"`
+}
+
+// dotEscape escapes characters that are significant inside DOT strings
+func dotEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// sortedKeys returns map keys in ascending order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
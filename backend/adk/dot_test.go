@@ -0,0 +1,63 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestCompetitorReport_ToDOT tests DOT output structure and determinism
+func TestCompetitorReport_ToDOT(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	ctx := context.Background()
+
+	analyses, err := agent.Analyze(ctx, []CompetitorData{
+		{Name: "Acme \"Prime\"", MarketShare: 25, Strengths: []string{"Brand", "Support"}, Weaknesses: []string{"Price"}},
+		{Name: "Beta", MarketShare: 15, Strengths: []string{"brand"}, Weaknesses: []string{"Price", "Scale"}},
+		{Name: "Gamma", MarketShare: 5, Strengths: []string{"Speed"}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	report, err := agent.GenerateReport(ctx, "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	dot, err := report.ToDOT()
+	if err != nil {
+		t.Fatalf("ToDOT() error = %v", err)
+	}
+
+	if !strings.HasPrefix(dot, "digraph \"positioning\" {\n") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("Expected a digraph block, got:\n%s", dot)
+	}
+
+	if strings.Count(dot, "{") != strings.Count(dot, "}") {
+		t.Error("Expected balanced braces")
+	}
+
+	for _, node := range []string{`"competitor:Acme \"Prime\"" [label=`, `"competitor:Beta" [label=`, `"competitor:Gamma" [label=`} {
+		if strings.Count(dot, node) != 1 {
+			t.Errorf("Expected exactly one node declaration %s", node)
+		}
+	}
+
+	// Shared traits collapse to a single intermediary node
+	if strings.Count(dot, `"strength:brand" [label=`) != 1 || strings.Count(dot, `"weakness:price" [label=`) != 1 {
+		t.Error("Expected shared strength and weakness nodes declared once")
+	}
+	if strings.Count(dot, `-> "weakness:price"`) != 2 {
+		t.Error("Expected both competitors linked to the shared weakness")
+	}
+
+	if !strings.Contains(dot, `fillcolor="#e74c3c"`) || !strings.Contains(dot, `fillcolor="#2ecc71"`) {
+		t.Error("Expected nodes colored by threat level")
+	}
+
+	again, _ := report.ToDOT()
+	if dot != again {
+		t.Error("Expected deterministic DOT output")
+	}
+}
@@ -0,0 +1,111 @@
+package adk
+
+import (
+	"bytes"
+	"html/template"
+	"time"
+)
+
+// emailThreatColors highlight threat levels in the email digest table
+var emailThreatColors = map[string]string{
+	ThreatHigh:   "#b91c1c",
+	ThreatMedium: "#b45309",
+	ThreatLow:    "#15803d",
+}
+
+// emailTemplate lays out the digest with inline styles only, since mail
+// clients strip style sheets and block remote assets
+var emailTemplate = template.Must(template.New("email").Funcs(template.FuncMap{
+	"threatColor": func(level string) string {
+		if color, ok := emailThreatColors[level]; ok {
+			return color
+		}
+		return "#374151"
+	},
+	"share": formatFloat,
+}).Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:0;background-color:#f3f4f6;">
+<table role="presentation" width="100%" cellpadding="0" cellspacing="0" style="background-color:#f3f4f6;">
+<tr><td align="center" style="padding:24px;">
+<table role="presentation" width="600" cellpadding="0" cellspacing="0" style="max-width:600px;background-color:#ffffff;font-family:Arial,Helvetica,sans-serif;color:#111827;">
+<tr><td style="padding:24px 24px 8px 24px;">
+<h1 style="margin:0;font-size:22px;">Competitive Intelligence: {{.TargetCompany}}</h1>
+<p style="margin:4px 0 0 0;font-size:12px;color:#6b7280;">Generated {{.GeneratedAt}}</p>
+</td></tr>
+{{- if .Summary}}
+<tr><td style="padding:8px 24px;font-size:14px;line-height:20px;">{{.Summary}}</td></tr>
+{{- end}}
+{{- if .TopThreat}}
+<tr><td style="padding:8px 24px;">
+<p style="margin:0;padding:12px;border-left:4px solid {{threatColor .TopThreat.ThreatLevel}};background-color:#f9fafb;font-size:14px;">
+<strong>Top threat:</strong> {{.TopThreat.CompetitorName}} ({{.TopThreat.ThreatLevel}}, {{share .TopThreat.MarketShare}}% market share)
+</p>
+</td></tr>
+{{- end}}
+{{- if .Competitors}}
+<tr><td style="padding:8px 24px;">
+<table role="presentation" width="100%" cellpadding="6" cellspacing="0" style="border-collapse:collapse;font-size:13px;">
+<tr style="background-color:#f3f4f6;text-align:left;"><th style="padding:6px;">Competitor</th><th style="padding:6px;">Threat</th><th style="padding:6px;">Share</th></tr>
+{{- range .Competitors}}
+<tr style="border-top:1px solid #e5e7eb;"><td style="padding:6px;">{{.CompetitorName}}</td><td style="padding:6px;color:{{threatColor .ThreatLevel}};font-weight:bold;">{{.ThreatLevel}}</td><td style="padding:6px;">{{share .MarketShare}}%</td></tr>
+{{- end}}
+</table>
+</td></tr>
+{{- end}}
+{{- if .Recommendations}}
+<tr><td style="padding:8px 24px 24px 24px;">
+<h2 style="margin:0 0 8px 0;font-size:16px;">Recommendations</h2>
+<ul style="margin:0;padding-left:20px;font-size:14px;line-height:20px;">
+{{- range .Recommendations}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+</td></tr>
+{{- end}}
+</table>
+</td></tr>
+</table>
+</body>
+</html>
+`))
+
+// emailDigest is the data rendered by emailTemplate
+type emailDigest struct {
+	TargetCompany   string
+	GeneratedAt     string
+	Summary         string
+	TopThreat       *CompetitorAnalysis
+	Competitors     []CompetitorAnalysis
+	Recommendations []string
+}
+
+// ToEmailHTML renders a compact HTML summary of the report for an email
+// body: the summary, top threat, a threat table and recommendations. It
+// uses inline styles and references no external resources, and renders
+// the same report identically every time.
+func (r *CompetitorReport) ToEmailHTML(opts FormatOptions) ([]byte, error) {
+	r = r.Truncated(opts.MaxFieldLength)
+
+	digest := emailDigest{
+		TargetCompany:   r.TargetCompany,
+		GeneratedAt:     r.GeneratedAt.Format(time.RFC1123),
+		Summary:         r.ExecutiveSummary,
+		Competitors:     r.Competitors,
+		Recommendations: r.Recommendations,
+	}
+	if digest.Summary == "" {
+		digest.Summary = r.MarketInsights
+	}
+	for i := range r.Competitors {
+		if digest.TopThreat == nil || r.Competitors[i].ThreatScore > digest.TopThreat.ThreatScore {
+			digest.TopThreat = &r.Competitors[i]
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := emailTemplate.Execute(&buf, digest); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,84 @@
+package adk
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToEmailHTML(t *testing.T) {
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		GeneratedAt:   time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		Competitors: []CompetitorAnalysis{
+			{CompetitorName: "Tiny", ThreatLevel: ThreatLow, ThreatScore: 12, MarketShare: 4},
+			{CompetitorName: "Acme & Co", ThreatLevel: ThreatHigh, ThreatScore: 81, MarketShare: 32.5},
+		},
+		MarketInsights:  "The market is concentrated.",
+		Recommendations: []string{"Invest in onboarding", "Undercut <Acme> on price"},
+	}
+
+	html, err := report.ToEmailHTML(FormatOptions{})
+	if err != nil {
+		t.Fatalf("ToEmailHTML() error = %v", err)
+	}
+	out := string(html)
+
+	for _, want := range []string{
+		"Competitive Intelligence: TestCorp",
+		"Generated Fri, 01 Mar 2024 12:00:00 UTC",
+		"The market is concentrated.",
+		"<strong>Top threat:</strong> Acme &amp; Co (High, 32.5% market share)",
+		"<li>Invest in onboarding</li>",
+		"<li>Undercut &lt;Acme&gt; on price</li>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected email to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	for _, external := range []string{"http:", "https:", "<link", "<img", "<script", "<style", "src=", "url("} {
+		if strings.Contains(out, external) {
+			t.Errorf("Expected no external resource references, found %q", external)
+		}
+	}
+
+	again, _ := report.ToEmailHTML(FormatOptions{})
+	if !bytes.Equal(html, again) {
+		t.Error("Expected rendering to be deterministic")
+	}
+}
+
+func TestToEmailHTML_Empty(t *testing.T) {
+	report := &CompetitorReport{TargetCompany: "TestCorp", ExecutiveSummary: "Nothing to report."}
+
+	html, err := report.ToEmailHTML(FormatOptions{})
+	if err != nil {
+		t.Fatalf("ToEmailHTML() error = %v", err)
+	}
+	out := string(html)
+
+	if !strings.Contains(out, "Nothing to report.") {
+		t.Errorf("Expected the executive summary, got:\n%s", out)
+	}
+	if strings.Contains(out, "Top threat") || strings.Contains(out, "Recommendations") {
+		t.Errorf("Expected empty sections to be left out, got:\n%s", out)
+	}
+}
+
+func TestExport_Email(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithMaxFieldLength(FormatEmail, 10))
+	report := &CompetitorReport{
+		TargetCompany:   "TestCorp",
+		Recommendations: []string{"A very long recommendation"},
+	}
+
+	out, err := agent.Export(report, FormatEmail)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if strings.Contains(string(out), "A very long recommendation") {
+		t.Errorf("Expected recommendations truncated for email, got:\n%s", out)
+	}
+}
@@ -0,0 +1,75 @@
+package adk
+
+import (
+	"strings"
+	"time"
+)
+
+// Defaults for flagging emerging players: small competitors growing fast on
+// the back of several recent strengths
+const (
+	defaultEmergingMaxShare     = 10.0
+	defaultEmergingMinStrengths = 2
+)
+
+// recentObservationWeight is the recency weight at or above which a
+// strength counts as recent: observed within one half-life, or undated
+const recentObservationWeight = 0.5
+
+// emergingMaxShare returns the configured emerging player share ceiling
+func (a *CompetitorIntelligenceAgent) emergingMaxShare() float64 {
+	if a.Config.EmergingMaxShare > 0 {
+		return a.Config.EmergingMaxShare
+	}
+	return defaultEmergingMaxShare
+}
+
+// emergingMinStrengths returns how many recent strengths an emerging
+// player needs
+func (a *CompetitorIntelligenceAgent) emergingMinStrengths() int {
+	if a.Config.EmergingMinStrengths > 0 {
+		return a.Config.EmergingMinStrengths
+	}
+	return defaultEmergingMinStrengths
+}
+
+// isEmergingPlayer reports whether the competitor is a likely disruptor:
+// share at or below the ceiling, growth at or above the emerging rate, and
+// enough recent strengths to back it up
+func (a *CompetitorIntelligenceAgent) isEmergingPlayer(competitor CompetitorData, now time.Time) bool {
+	if competitor.MarketShare > a.emergingMaxShare() || !a.isEmerging(competitor) {
+		return false
+	}
+	recent := 0
+	for _, strength := range competitor.Strengths {
+		if a.observationWeight(competitor, strength, now) >= recentObservationWeight {
+			recent++
+		}
+	}
+	return recent >= a.emergingMinStrengths()
+}
+
+// emergingPlayers names the competitors flagged as emerging players, in
+// the given order
+func emergingPlayers(analyses []CompetitorAnalysis) []string {
+	var names []string
+	for _, analysis := range analyses {
+		if analysis.EmergingPlayer {
+			names = append(names, analysis.CompetitorName)
+		}
+	}
+	return names
+}
+
+// writeEmergingPlayers renders the emerging players callout
+func writeEmergingPlayers(b *strings.Builder, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	b.WriteString("## Emerging Players\n\n")
+	b.WriteString("Small, fast-growing competitors with several recent strengths, worth watching whatever their threat level:\n\n")
+	for _, name := range names {
+		b.WriteString("- " + name + "\n")
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,98 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAnalyze_EmergingPlayer(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	stale := now.AddDate(-2, 0, 0)
+
+	tests := []struct {
+		name       string
+		opts       []Option
+		competitor CompetitorData
+		want       bool
+	}{
+		{
+			name:       "Low share, high growth, recent strengths",
+			competitor: CompetitorData{Name: "Upstart", MarketShare: 3, GrowthRate: 120, Strengths: []string{"Modern UX", "Fast shipping"}},
+			want:       true,
+		},
+		{
+			name:       "Slow growth",
+			competitor: CompetitorData{Name: "Upstart", MarketShare: 3, GrowthRate: 20, Strengths: []string{"Modern UX", "Fast shipping"}},
+		},
+		{
+			name:       "Too large",
+			competitor: CompetitorData{Name: "Upstart", MarketShare: 25, GrowthRate: 120, Strengths: []string{"Modern UX", "Fast shipping"}},
+		},
+		{
+			name:       "Single strength",
+			competitor: CompetitorData{Name: "Upstart", MarketShare: 3, GrowthRate: 120, Strengths: []string{"Modern UX"}},
+		},
+		{
+			name: "Stale strengths",
+			competitor: CompetitorData{
+				Name: "Upstart", MarketShare: 3, GrowthRate: 120,
+				Strengths:  []string{"Modern UX", "Fast shipping"},
+				ObservedAt: map[string]time.Time{"Modern UX": stale, "Fast shipping": stale},
+			},
+		},
+		{
+			name:       "Custom criteria",
+			opts:       []Option{WithEmergingGrowthRate(15), WithEmergingPlayerCriteria(30, 1)},
+			competitor: CompetitorData{Name: "Upstart", MarketShare: 25, GrowthRate: 20, Strengths: []string{"Modern UX"}},
+			want:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(tt.opts...)
+			analyses, err := agent.Analyze(context.WithValue(context.Background(), analysisTimeKey{}, now), []CompetitorData{tt.competitor})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			if analyses[0].EmergingPlayer != tt.want {
+				t.Errorf("Expected emerging player %v, got %v", tt.want, analyses[0].EmergingPlayer)
+			}
+		})
+	}
+}
+
+func TestGenerateReport_EmergingPlayersSurfaced(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithMinThreatLevel(ThreatHigh), WithEmergingGrowthRate(1000))
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "Leader", MarketShare: 40, Strengths: []string{"Brand"}},
+		{Name: "Upstart", MarketShare: 2, GrowthRate: 1500, Strengths: []string{"Modern UX", "Fast shipping"}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	// Filtered out by threat level, but still called out
+	for _, analysis := range report.Competitors {
+		if analysis.CompetitorName == "Upstart" {
+			t.Fatalf("Expected Upstart filtered below %s, got %+v", ThreatHigh, report.Competitors)
+		}
+	}
+	if len(report.EmergingPlayers) != 1 || report.EmergingPlayers[0] != "Upstart" {
+		t.Errorf("Expected Upstart listed as an emerging player, got %v", report.EmergingPlayers)
+	}
+	if out := report.ToMarkdown(FormatOptions{}); !strings.Contains(out, "## Emerging Players\n") || !strings.Contains(out, "- Upstart\n") {
+		t.Errorf("Expected an Emerging Players section, got:\n%s", out)
+	}
+
+	anonymized, mapping := report.Anonymized()
+	if pseudonym := anonymized.EmergingPlayers[0]; mapping[pseudonym] != "Upstart" {
+		t.Errorf("Expected the emerging player pseudonymized, got %q (%v)", pseudonym, mapping)
+	}
+}
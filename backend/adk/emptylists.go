@@ -0,0 +1,26 @@
+package adk
+
+// WithEmptyLists returns a copy of the report whose always-serialized list
+// fields are non-nil, so they marshal as [] instead of null. Fields tagged
+// omitempty are left alone since they are dropped either way.
+func (r *CompetitorReport) WithEmptyLists() *CompetitorReport {
+	out := *r
+	out.Recommendations = nonNil(r.Recommendations)
+
+	out.Competitors = make([]CompetitorAnalysis, len(r.Competitors))
+	for i, analysis := range r.Competitors {
+		analysis.KeyDifferentiators = nonNil(analysis.KeyDifferentiators)
+		analysis.Opportunities = nonNil(analysis.Opportunities)
+		analysis.Risks = nonNil(analysis.Risks)
+		out.Competitors[i] = analysis
+	}
+	return &out
+}
+
+// nonNil returns values, or an empty slice when values is nil
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
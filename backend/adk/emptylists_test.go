@@ -0,0 +1,64 @@
+package adk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExport_EmptyLists(t *testing.T) {
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors:   []CompetitorAnalysis{{CompetitorName: "Bare"}},
+	}
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "default keeps null",
+			contains: []string{`"recommendations": null`, `"risks": null`},
+		},
+		{
+			name:     "normalized to empty arrays",
+			opts:     []Option{WithEmptyLists()},
+			contains: []string{`"recommendations": []`, `"key_differentiators": []`, `"opportunities": []`, `"risks": []`},
+			excludes: []string{"null", `"leadership"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := NewCompetitorIntelligenceAgent(tt.opts...).Export(report, FormatJSON)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("Expected JSON to contain %s, got:\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(string(out), unwanted) {
+					t.Errorf("Expected JSON not to contain %s, got:\n%s", unwanted, out)
+				}
+			}
+		})
+	}
+
+	if report.Recommendations != nil {
+		t.Error("Expected the original report to be left untouched")
+	}
+}
+
+func TestWithEmptyLists_NilCompetitors(t *testing.T) {
+	out, err := NewCompetitorIntelligenceAgent(WithEmptyLists()).Export(&CompetitorReport{}, FormatJSON)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"competitors": []`) {
+		t.Errorf("Expected nil competitors to render as [], got:\n%s", out)
+	}
+}
@@ -0,0 +1,113 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Enricher decorates researched competitor data with optional extra signals.
+// Enrichers are best-effort: a failure must leave the data usable.
+type Enricher interface {
+	// Name identifies the enrichment step
+	Name() string
+	// Enrich updates data in place
+	Enrich(ctx context.Context, data []CompetitorData) error
+}
+
+// enrich runs every configured enricher, logging rather than propagating failures
+func (a *CompetitorIntelligenceAgent) enrich(ctx context.Context, data []CompetitorData) {
+	for _, enricher := range a.enrichers {
+		if err := enricher.Enrich(ctx, data); err != nil {
+			log.Printf("enrichment %s failed: %v", enricher.Name(), err)
+		}
+	}
+}
+
+// ScreenshotEnricher captures homepage thumbnails through an external
+// headless-render service. The service is called as GET <ServiceURL>?url=<website>
+// and must answer with JSON {"image_url": "..."}.
+type ScreenshotEnricher struct {
+	ServiceURL string
+	Timeout    time.Duration
+	Client     *http.Client
+}
+
+// NewScreenshotEnricher creates an enricher for the given render service
+func NewScreenshotEnricher(serviceURL string, timeout time.Duration) *ScreenshotEnricher {
+	return &ScreenshotEnricher{
+		ServiceURL: serviceURL,
+		Timeout:    timeout,
+		Client:     http.DefaultClient,
+	}
+}
+
+// Name identifies the enrichment step
+func (e *ScreenshotEnricher) Name() string {
+	return "screenshot"
+}
+
+// Enrich sets ScreenshotURL for each competitor with a valid website.
+// Individual capture failures are skipped; the last one is returned for logging.
+func (e *ScreenshotEnricher) Enrich(ctx context.Context, data []CompetitorData) error {
+	var lastErr error
+	for i := range data {
+		if !isHTTPURL(data[i].Website) {
+			continue
+		}
+		imageURL, err := e.capture(ctx, data[i].Website)
+		if err != nil {
+			lastErr = fmt.Errorf("%s: %w", data[i].Name, err)
+			continue
+		}
+		data[i].ScreenshotURL = imageURL
+	}
+	return lastErr
+}
+
+// capture asks the render service for a thumbnail of website
+func (e *ScreenshotEnricher) capture(ctx context.Context, website string) (string, error) {
+	if e.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.Timeout)
+		defer cancel()
+	}
+
+	endpoint, err := url.Parse(e.ServiceURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid render service URL: %w", err)
+	}
+	query := endpoint.Query()
+	query.Set("url", website)
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("render service returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		ImageURL string `json:"image_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("invalid render service response: %w", err)
+	}
+	if body.ImageURL == "" {
+		return "", fmt.Errorf("render service returned no image URL")
+	}
+	return body.ImageURL, nil
+}
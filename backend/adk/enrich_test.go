@@ -0,0 +1,85 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestScreenshotEnricher tests that thumbnails are attached from the render service
+func TestScreenshotEnricher(t *testing.T) {
+	render := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		site := r.URL.Query().Get("url")
+		if site == "https://broken.example" {
+			http.Error(w, "render failed", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"image_url": "https://cdn.example/thumb?site=" + site})
+	}))
+	defer render.Close()
+
+	data := []CompetitorData{
+		{Name: "Good", Website: "https://good.example"},
+		{Name: "Broken", Website: "https://broken.example"},
+		{Name: "No Site"},
+	}
+
+	enricher := NewScreenshotEnricher(render.URL, time.Second)
+	if err := enricher.Enrich(context.Background(), data); err == nil {
+		t.Error("Expected the broken capture to be reported")
+	}
+
+	if data[0].ScreenshotURL != "https://cdn.example/thumb?site=https://good.example" {
+		t.Errorf("Unexpected screenshot URL %q", data[0].ScreenshotURL)
+	}
+
+	if data[1].ScreenshotURL != "" || data[2].ScreenshotURL != "" {
+		t.Error("Expected failed and site-less competitors to have no screenshot")
+	}
+}
+
+// TestRun_ScreenshotEnrichmentNonFatal tests that Run succeeds even if the render service is slow
+func TestRun_ScreenshotEnrichmentNonFatal(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+
+	agent := NewCompetitorIntelligenceAgent(WithEnricher(NewScreenshotEnricher(slow.URL, 10*time.Millisecond)))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Expected Run to succeed despite capture timeouts, got %v", err)
+	}
+
+	if len(report.Competitors) != 3 {
+		t.Errorf("Expected 3 competitors, got %d", len(report.Competitors))
+	}
+}
+
+// TestRun_ScreenshotEnrichment tests that captured thumbnails reach the report
+func TestRun_ScreenshotEnrichment(t *testing.T) {
+	render := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"image_url": "https://cdn.example/thumb.png"})
+	}))
+	defer render.Close()
+
+	agent := NewCompetitorIntelligenceAgent(WithEnricher(NewScreenshotEnricher(render.URL, time.Second)))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, analysis := range report.Competitors {
+		if analysis.ScreenshotURL != "https://cdn.example/thumb.png" {
+			t.Errorf("%s: ScreenshotURL = %q", analysis.CompetitorName, analysis.ScreenshotURL)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export formats understood by Export
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+	FormatCSV      = "csv"
+	FormatRadar    = "radar"
+	FormatMatrix   = "matrix"
+	FormatSheets   = "sheets"
+	FormatSWOT     = "swot"
+
+	FormatRelationships    = "relationships"
+	FormatRelationshipsDOT = "relationships_dot"
+
+	// FormatKillSheet is a Markdown sales battlecard per competitor
+	FormatKillSheet = "killsheet"
+
+	// FormatEmail is an email-safe HTML digest of the report
+	FormatEmail = "email"
+
+	// FormatFeatures is the feature × competitor matrix as JSON
+	FormatFeatures = "features"
+
+	// FormatPricing is pricing bar chart data as JSON
+	FormatPricing = "pricing"
+
+	// FormatProtobuf is the competitor.v1.CompetitorReport wire encoding
+	FormatProtobuf = "protobuf"
+
+	// FormatYAML is the JSON report rendered as YAML
+	FormatYAML = "yaml"
+)
+
+// FormatOptions tunes how a report is rendered in a given format
+type FormatOptions struct {
+	// MaxFieldLength truncates long strings with an ellipsis; 0 disables it
+	MaxFieldLength int `json:"max_field_length,omitempty"`
+	// EmptyLists renders nil lists as [] instead of null (JSON only)
+	EmptyLists bool `json:"empty_lists,omitempty"`
+	// Payload drops (lean) or includes (full) every empty field (JSON only)
+	Payload PayloadMode `json:"payload,omitempty"`
+	// Citations footnotes each sourced field with its source (Markdown only)
+	Citations bool `json:"citations,omitempty"`
+}
+
+// FormatOptions returns the configured options for a format
+func (a *CompetitorIntelligenceAgent) FormatOptions(format string) FormatOptions {
+	return a.Config.Formats[format]
+}
+
+// Export renders the report in the given format using the agent's
+// per-format options and default output profile
+func (a *CompetitorIntelligenceAgent) Export(report *CompetitorReport, format string) ([]byte, error) {
+	return a.ExportProfile(report, format, a.Config.OutputProfile)
+}
+
+// export renders an already-redacted report in the given format
+func (a *CompetitorIntelligenceAgent) export(report *CompetitorReport, format string) ([]byte, error) {
+	opts := a.FormatOptions(format)
+	report = report.WithShareFormat(a.Config.ShareFormat)
+	switch format {
+	case FormatJSON:
+		report = report.Truncated(opts.MaxFieldLength)
+		if opts.EmptyLists {
+			report = report.WithEmptyLists()
+		}
+		return marshalPayload(report, opts.Payload)
+	case FormatYAML:
+		report = report.Truncated(opts.MaxFieldLength)
+		if opts.EmptyLists {
+			report = report.WithEmptyLists()
+		}
+		encoded, err := marshalPayload(report, opts.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return jsonToYAML(encoded)
+	case FormatMarkdown:
+		return []byte(report.ToMarkdown(opts)), nil
+	case FormatCSV:
+		return report.ToCSV(opts)
+	case FormatRadar:
+		return a.radarJSON(report)
+	case FormatMatrix:
+		return matrixJSON(report)
+	case FormatSheets:
+		return report.ToSheets(opts)
+	case FormatSWOT:
+		return swotJSON(report)
+	case FormatKillSheet:
+		return []byte(report.ToKillSheet(opts)), nil
+	case FormatEmail:
+		return report.ToEmailHTML(opts)
+	case FormatFeatures:
+		return featuresJSON(report)
+	case FormatPricing:
+		return pricingJSON(report)
+	case FormatRelationships:
+		return relationshipsJSON(report)
+	case FormatRelationshipsDOT:
+		return []byte(report.RelationshipGraph().ToDOT()), nil
+	case FormatProtobuf:
+		return report.Truncated(opts.MaxFieldLength).ToProtobuf()
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// Truncated returns a copy of the report with every display string cut to
+// maxLen runes. A non-positive maxLen returns the report unchanged.
+func (r *CompetitorReport) Truncated(maxLen int) *CompetitorReport {
+	if maxLen <= 0 {
+		return r
+	}
+
+	out := *r
+	out.MarketInsights = truncate(r.MarketInsights, maxLen)
+	out.ExecutiveSummary = truncate(r.ExecutiveSummary, maxLen)
+	out.Recommendations = truncateAll(r.Recommendations, maxLen)
+	out.RecommendationDetails = make([]Recommendation, len(r.RecommendationDetails))
+	for i, rec := range r.RecommendationDetails {
+		rec.Text = truncate(rec.Text, maxLen)
+		rec.Rationale = truncate(rec.Rationale, maxLen)
+		out.RecommendationDetails[i] = rec
+	}
+	out.RecommendationGroups = make([]RecommendationGroup, len(r.RecommendationGroups))
+	for i, group := range r.RecommendationGroups {
+		group.Recommendations = truncateAll(group.Recommendations, maxLen)
+		out.RecommendationGroups[i] = group
+	}
+
+	out.Competitors = make([]CompetitorAnalysis, len(r.Competitors))
+	for i, analysis := range r.Competitors {
+		analysis.Positioning = truncate(analysis.Positioning, maxLen)
+		analysis.KeyDifferentiators = truncateAll(analysis.KeyDifferentiators, maxLen)
+		analysis.Weaknesses = truncateAll(analysis.Weaknesses, maxLen)
+		analysis.Opportunities = truncateAll(analysis.Opportunities, maxLen)
+		if analysis.OpportunityPriorities != nil {
+			priorities := make([]PrioritizedOpportunity, len(analysis.OpportunityPriorities))
+			for j, opportunity := range analysis.OpportunityPriorities {
+				opportunity.Opportunity = truncate(opportunity.Opportunity, maxLen)
+				opportunity.Weakness = truncate(opportunity.Weakness, maxLen)
+				priorities[j] = opportunity
+			}
+			analysis.OpportunityPriorities = priorities
+		}
+		analysis.Risks = truncateAll(analysis.Risks, maxLen)
+		analysis.ActionPlan = truncateAll(analysis.ActionPlan, maxLen)
+		analysis.Notes = truncateAll(analysis.Notes, maxLen)
+		out.Competitors[i] = analysis
+	}
+	return &out
+}
+
+// ToMarkdown renders the report as a Markdown document, with sections
+// chosen and ordered for the report's persona
+func (r *CompetitorReport) ToMarkdown(opts FormatOptions) string {
+	r = r.Truncated(opts.MaxFieldLength)
+
+	var cites *citations
+	if opts.Citations {
+		cites = &citations{}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Competitive Intelligence Report: %s\n\n", r.TargetCompany)
+	fmt.Fprintf(&b, "_Generated %s_\n\n", r.GeneratedAt.Format(time.RFC3339))
+
+	persona := layoutPersona(r.Persona)
+	for _, section := range reportSections[persona] {
+		switch section {
+		case sectionSummary:
+			if r.ExecutiveSummary != "" {
+				b.WriteString("## Executive Summary\n\n")
+				b.WriteString(r.ExecutiveSummary)
+				b.WriteString("\n\n")
+			}
+			writeEmergingPlayers(&b, r.EmergingPlayers)
+		case sectionInsights:
+			b.WriteString("## Market Insights\n\n")
+			b.WriteString(r.MarketInsights)
+			b.WriteString("\n\n")
+			writeCategoryShares(&b, r.CategoryShares)
+		case sectionCompetitors:
+			b.WriteString("## Competitors\n\n")
+			for _, analysis := range r.Competitors {
+				if !analysis.Highlighted {
+					fmt.Fprintf(&b, "### %s\n\n", analysis.CompetitorName)
+					writeCompetitorSections(&b, analysis, competitorSections[persona], cites)
+					continue
+				}
+				fmt.Fprintf(&b, "### %s (focus)\n\n", analysis.CompetitorName)
+				if analysis.FocusSummary != "" {
+					fmt.Fprintf(&b, "%s\n\n", analysis.FocusSummary)
+				}
+				writeCompetitorSections(&b, analysis, highlightSections(competitorSections[persona]), cites)
+			}
+		case sectionRecommendations:
+			b.WriteString("## Recommendations\n\n")
+			if len(r.RecommendationGroups) == 0 {
+				for i, rec := range r.Recommendations {
+					fmt.Fprintf(&b, "%d. %s\n", i+1, rec)
+				}
+			}
+			for _, group := range r.RecommendationGroups {
+				fmt.Fprintf(&b, "**%s**\n\n", group.Category)
+				for i, rec := range group.Recommendations {
+					fmt.Fprintf(&b, "%d. %s\n", i+1, rec)
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+
+	cites.write(&b)
+	return b.String()
+}
+
+// writeCompetitorSections writes a competitor's facts as a bullet block
+// followed by its list sections, in the order given. Sourced fields carry
+// footnote markers when cites is set.
+func writeCompetitorSections(b *strings.Builder, analysis CompetitorAnalysis, sections []string, cites *citations) {
+	for _, section := range sections {
+		switch section {
+		case fieldThreat:
+			emerging := ""
+			if analysis.EmergingThreat {
+				emerging = ", emerging threat"
+			}
+			if analysis.EmergingPlayer {
+				emerging += ", emerging player"
+			}
+			fmt.Fprintf(b, "- **Threat:** %s (score %s%s)\n", analysis.ThreatLevel, formatFloat(analysis.ThreatScore), emerging)
+		case fieldRank:
+			if analysis.Rank > 0 {
+				fmt.Fprintf(b, "- **Rank:** %d\n", analysis.Rank)
+			}
+		case fieldPositioning:
+			if analysis.PositioningInferred {
+				fmt.Fprintf(b, "- **Positioning:** %s (inferred from market share)%s\n", analysis.Positioning, cites.cite(analysis, "market_share"))
+			} else {
+				fmt.Fprintf(b, "- **Positioning:** %s%s\n", analysis.Positioning, cites.cite(analysis, "positioning"))
+			}
+			if analysis.ValuePosition == ValueOverpriced || analysis.ValuePosition == ValueBargain {
+				fmt.Fprintf(b, "- **Value:** %s\n", analysis.ValuePosition)
+			}
+		case fieldMarketShare:
+			if analysis.ShareUncertainty > 0 {
+				fmt.Fprintf(b, "- **Market share:** %s%% (±%s)%s\n", formatFloat(analysis.MarketShare), formatFloat(analysis.ShareUncertainty), cites.cite(analysis, "market_share"))
+			} else {
+				fmt.Fprintf(b, "- **Market share:** %s%%%s\n", formatFloat(analysis.MarketShare), cites.cite(analysis, "market_share"))
+			}
+		case fieldFunding:
+			if analysis.Funding > 0 {
+				fmt.Fprintf(b, "- **Funding:** $%sM%s\n", formatFloat(analysis.Funding), cites.cite(analysis, "funding"))
+			}
+		case fieldCompleteness:
+			fmt.Fprintf(b, "- **Data completeness:** %s%%\n", formatFloat(math.Round(analysis.Completeness*100)))
+		}
+	}
+	b.WriteString("\n")
+
+	for _, section := range sections {
+		switch section {
+		case fieldDifferentiators:
+			writeMarkdownList(b, "Key differentiators", analysis.KeyDifferentiators, cites.cite(analysis, "key_differentiators"))
+		case fieldFeatureGaps:
+			writeMarkdownList(b, "Feature gaps", analysis.Weaknesses, cites.cite(analysis, "weaknesses"))
+		case fieldOpportunities:
+			writeMarkdownList(b, "Opportunities", analysis.Opportunities, cites.cite(analysis, "opportunities"))
+		case fieldRisks:
+			writeMarkdownList(b, "Risks", analysis.Risks, cites.cite(analysis, "risks"))
+		case fieldActionPlan:
+			writeMarkdownList(b, "Action plan", analysis.ActionPlan, "")
+		case fieldNotes:
+			writeMarkdownList(b, "Analyst notes", analysis.Notes, cites.cite(analysis, "notes"))
+		}
+	}
+}
+
+// csvHeader is the column layout of ToCSV
+var csvHeader = []string{
+	"competitor_name", "threat_level", "threat_score", "rank", "market_share",
+	"positioning", "key_differentiators", "opportunities", "risks",
+}
+
+// ToCSV renders one row per competitor; list fields are joined with "; "
+func (r *CompetitorReport) ToCSV(opts FormatOptions) ([]byte, error) {
+	r = r.Truncated(opts.MaxFieldLength)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for _, analysis := range r.Competitors {
+		row := []string{
+			analysis.CompetitorName,
+			analysis.ThreatLevel,
+			formatFloat(analysis.ThreatScore),
+			strconv.Itoa(analysis.Rank),
+			formatFloat(analysis.MarketShare),
+			analysis.Positioning,
+			strings.Join(analysis.KeyDifferentiators, "; "),
+			strings.Join(analysis.Opportunities, "; "),
+			strings.Join(analysis.Risks, "; "),
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// writeMarkdownList writes a bold heading, followed by any footnote marker,
+// and bullet list, skipping empty lists
+func writeMarkdownList(b *strings.Builder, heading string, items []string, marker string) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "**%s**%s\n\n", heading, marker)
+	for _, item := range items {
+		fmt.Fprintf(b, "- %s\n", item)
+	}
+	b.WriteString("\n")
+}
+
+// formatFloat renders a float without trailing zeros
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// truncate shortens s to maxLen runes, ending with an ellipsis when cut
+func truncate(s string, maxLen int) string {
+	runes := []rune(s)
+	if maxLen <= 0 || len(runes) <= maxLen {
+		return s
+	}
+	if maxLen == 1 {
+		return "…"
+	}
+	return string(runes[:maxLen-1]) + "…"
+}
+
+// truncateAll truncates every string in values into a new slice
+func truncateAll(values []string, maxLen int) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i, value := range values {
+		out[i] = truncate(value, maxLen)
+	}
+	return out
+}
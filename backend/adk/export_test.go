@@ -0,0 +1,117 @@
+package adk
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+// longReport builds a report with a very long strength
+func longReport(t *testing.T, agent *CompetitorIntelligenceAgent) *CompetitorReport {
+	t.Helper()
+	ctx := context.Background()
+
+	analyses, err := agent.Analyze(ctx, []CompetitorData{
+		{
+			Name:        "Verbose Corp",
+			Pricing:     "Premium",
+			MarketShare: 25.5,
+			Strengths:   []string{strings.Repeat("Exceptionally broad product coverage ", 10)},
+			Weaknesses:  []string{"Price"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	report, err := agent.GenerateReport(ctx, "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+	return report
+}
+
+// TestExport_TruncationPerFormat tests Markdown truncation with full JSON
+func TestExport_TruncationPerFormat(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithMaxFieldLength(FormatMarkdown, 40), WithMaxFieldLength(FormatCSV, 40))
+	report := longReport(t, agent)
+	long := report.Competitors[0].KeyDifferentiators[0]
+
+	markdown, err := agent.Export(report, FormatMarkdown)
+	if err != nil {
+		t.Fatalf("Export(markdown) error = %v", err)
+	}
+	if strings.Contains(string(markdown), long) {
+		t.Error("Expected Markdown to truncate the long strength")
+	}
+	if !strings.Contains(string(markdown), "- "+truncate(long, 40)+"\n") {
+		t.Error("Expected Markdown to contain the truncated strength with an ellipsis")
+	}
+
+	csvData, err := agent.Export(report, FormatCSV)
+	if err != nil {
+		t.Fatalf("Export(csv) error = %v", err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(string(csvData))).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+	if len([]rune(rows[1][6])) != 40 {
+		t.Errorf("Expected CSV differentiators truncated to 40 runes, got %d", len([]rune(rows[1][6])))
+	}
+
+	jsonData, err := agent.Export(report, FormatJSON)
+	if err != nil {
+		t.Fatalf("Export(json) error = %v", err)
+	}
+	if !strings.Contains(string(jsonData), long) {
+		t.Error("Expected JSON to keep the full strength by default")
+	}
+
+	// The report itself must be left intact
+	if report.Competitors[0].KeyDifferentiators[0] != long {
+		t.Error("Truncation mutated the report")
+	}
+}
+
+// TestCompetitorReport_ToMarkdown tests Markdown structure
+func TestCompetitorReport_ToMarkdown(t *testing.T) {
+	report := longReport(t, NewCompetitorIntelligenceAgent())
+
+	markdown := report.ToMarkdown(FormatOptions{})
+
+	for _, want := range []string{
+		"# Competitive Intelligence Report: TestCorp",
+		"## Market Insights",
+		"### Verbose Corp",
+		"- **Market share:** 25.5%",
+		"## Recommendations",
+		"1. Focus on differentiation",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("Expected Markdown to contain %q", want)
+		}
+	}
+}
+
+// TestTruncate tests rune-aware truncation
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{in: "short", max: 10, want: "short"},
+		{in: "exactly", max: 7, want: "exactly"},
+		{in: "truncated text", max: 6, want: "trunc…"},
+		{in: "héllo wörld", max: 4, want: "hél…"},
+		{in: "anything", max: 0, want: "anything"},
+	}
+
+	for _, tt := range tests {
+		if got := truncate(tt.in, tt.max); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package adk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FeatureSupport is how fully a competitor offers a feature
+type FeatureSupport string
+
+// Supported feature support levels
+const (
+	// FeatureYes marks a feature named by a product or strength
+	FeatureYes FeatureSupport = "yes"
+	// FeaturePartial marks a feature also, or only, named by a weakness
+	FeaturePartial FeatureSupport = "partial"
+	// FeatureNo marks a feature the competitor's data never mentions
+	FeatureNo FeatureSupport = "no"
+)
+
+// FeatureMatrix compares competitors feature by feature.
+// Support[i][j] is competitor j's support for feature i.
+type FeatureMatrix struct {
+	Features    []string           `json:"features"`
+	Competitors []string           `json:"competitors"`
+	Support     [][]FeatureSupport `json:"support"`
+	// Gaps lists features no competitor fully supports
+	Gaps []string `json:"gaps"`
+}
+
+// ParseFeatures splits a comma-separated feature list such as
+// "sso,analytics,api", dropping blanks and case-insensitive duplicates
+func ParseFeatures(spec string) ([]string, error) {
+	var features []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(spec, ",") {
+		feature := strings.TrimSpace(part)
+		if feature == "" || seen[strings.ToLower(feature)] {
+			continue
+		}
+		seen[strings.ToLower(feature)] = true
+		features = append(features, feature)
+	}
+	if len(features) == 0 {
+		return nil, fmt.Errorf("%w: feature list %q names no features", ErrInvalidInput, spec)
+	}
+	return features, nil
+}
+
+// featureSupport derives a competitor's support for each configured feature
+// from its products, strengths and weaknesses. A feature named by a
+// weakness is partial even when a product offers it, since the competitor
+// does it poorly. It returns nil when no features are configured.
+func (a *CompetitorIntelligenceAgent) featureSupport(competitor CompetitorData) map[string]FeatureSupport {
+	if len(a.Config.Features) == 0 {
+		return nil
+	}
+
+	offered := append(append([]string(nil), competitor.Products...), competitor.Strengths...)
+	support := make(map[string]FeatureSupport, len(a.Config.Features))
+	for _, feature := range a.Config.Features {
+		keyword := strings.ToLower(feature)
+		switch {
+		case mentions(competitor.Weaknesses, keyword):
+			support[feature] = FeaturePartial
+		case mentions(offered, keyword):
+			support[feature] = FeatureYes
+		default:
+			support[feature] = FeatureNo
+		}
+	}
+	return support
+}
+
+// featureMatrix tabulates each competitor's feature support in report
+// order. It returns nil when no features are configured.
+func (a *CompetitorIntelligenceAgent) featureMatrix(analyses []CompetitorAnalysis) *FeatureMatrix {
+	if len(a.Config.Features) == 0 {
+		return nil
+	}
+
+	matrix := &FeatureMatrix{
+		Features:    append([]string(nil), a.Config.Features...),
+		Competitors: make([]string, len(analyses)),
+		Support:     make([][]FeatureSupport, len(a.Config.Features)),
+		Gaps:        []string{},
+	}
+	for j, analysis := range analyses {
+		matrix.Competitors[j] = analysis.CompetitorName
+	}
+	for i, feature := range matrix.Features {
+		matrix.Support[i] = make([]FeatureSupport, len(analyses))
+		covered := false
+		for j, analysis := range analyses {
+			support, ok := analysis.FeatureSupport[feature]
+			if !ok {
+				support = FeatureNo
+			}
+			matrix.Support[i][j] = support
+			covered = covered || support == FeatureYes
+		}
+		if !covered {
+			matrix.Gaps = append(matrix.Gaps, feature)
+		}
+	}
+	return matrix
+}
+
+// featureGaps lists the matrix's gaps for insights, comma-separated
+func featureGaps(matrix *FeatureMatrix) string {
+	if matrix == nil {
+		return ""
+	}
+	return strings.Join(matrix.Gaps, ", ")
+}
+
+// featuresJSON renders the report's feature matrix
+func featuresJSON(report *CompetitorReport) ([]byte, error) {
+	if report.FeatureMatrix == nil {
+		return nil, fmt.Errorf("%w: no features are configured for a feature matrix", ErrInvalidInput)
+	}
+	return json.MarshalIndent(report.FeatureMatrix, "", "  ")
+}
@@ -0,0 +1,110 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseFeatures(t *testing.T) {
+	got, err := ParseFeatures(" SSO, analytics,,sso ,API")
+	if err != nil {
+		t.Fatalf("ParseFeatures() error = %v", err)
+	}
+	if want := "SSO,analytics,API"; strings.Join(got, ",") != want {
+		t.Errorf("Expected %s, got %v", want, got)
+	}
+	if _, err := ParseFeatures(" , "); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestGenerateReport_FeatureMatrix(t *testing.T) {
+	data := []CompetitorData{
+		{Name: "Acme", MarketShare: 30, Products: []string{"Acme SSO", "Analytics Suite"}, Weaknesses: []string{"Slow support"}},
+		{Name: "Beta", MarketShare: 20, Strengths: []string{"Open API"}, Weaknesses: []string{"Shallow analytics"}},
+		{Name: "Gamma", MarketShare: 10, Products: []string{"Dashboards"}},
+	}
+
+	agent := NewCompetitorIntelligenceAgent(WithFeatures("SSO", "analytics", "API", "offline mode"))
+	analyses, err := agent.Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	matrix := report.FeatureMatrix
+	if matrix == nil {
+		t.Fatal("Expected a feature matrix")
+	}
+	if got := strings.Join(matrix.Competitors, ","); got != "Acme,Beta,Gamma" {
+		t.Fatalf("Expected competitors in report order, got %s", got)
+	}
+
+	want := map[string][]FeatureSupport{
+		"SSO":          {FeatureYes, FeatureNo, FeatureNo},
+		"analytics":    {FeatureYes, FeaturePartial, FeatureNo},
+		"API":          {FeatureNo, FeatureYes, FeatureNo},
+		"offline mode": {FeatureNo, FeatureNo, FeatureNo},
+	}
+	for i, feature := range matrix.Features {
+		for j, support := range matrix.Support[i] {
+			if support != want[feature][j] {
+				t.Errorf("%s for %s: expected %s, got %s", feature, matrix.Competitors[j], want[feature][j], support)
+			}
+		}
+	}
+
+	if len(matrix.Gaps) != 1 || matrix.Gaps[0] != "offline mode" {
+		t.Errorf("Expected offline mode as the only gap, got %v", matrix.Gaps)
+	}
+	if !strings.Contains(report.MarketInsights, "No competitor fully covers: offline mode.") {
+		t.Errorf("Expected insights to summarize the gap, got %q", report.MarketInsights)
+	}
+}
+
+func TestFeatureMatrix_PartialIsAGap(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithFeatures("reporting"))
+	analyses := []CompetitorAnalysis{
+		{CompetitorName: "Acme", FeatureSupport: map[string]FeatureSupport{"reporting": FeaturePartial}},
+		{CompetitorName: "Beta"},
+	}
+
+	matrix := agent.featureMatrix(analyses)
+	if matrix.Support[0][1] != FeatureNo {
+		t.Errorf("Expected a competitor without ratings to lack the feature, got %s", matrix.Support[0][1])
+	}
+	if len(matrix.Gaps) != 1 {
+		t.Errorf("Expected partial support alone to leave a gap, got %v", matrix.Gaps)
+	}
+}
+
+func TestExport_Features(t *testing.T) {
+	report := &CompetitorReport{FeatureMatrix: &FeatureMatrix{
+		Features:    []string{"sso"},
+		Competitors: []string{"Acme"},
+		Support:     [][]FeatureSupport{{FeatureYes}},
+		Gaps:        []string{},
+	}}
+
+	body, err := NewCompetitorIntelligenceAgent().Export(report, FormatFeatures)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	var decoded FeatureMatrix
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("Failed to decode features export: %v", err)
+	}
+	if decoded.Support[0][0] != FeatureYes || decoded.Competitors[0] != "Acme" {
+		t.Errorf("Unexpected features export: %s", body)
+	}
+
+	if _, err := NewCompetitorIntelligenceAgent().Export(&CompetitorReport{}, FormatFeatures); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput without features, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package adk
+
+import "time"
+
+// ReportMeta carries provenance details about the data behind a report
+type ReportMeta struct {
+	// SourcesLastUpdated is the newest retrieval time seen per data source
+	SourcesLastUpdated map[string]time.Time `json:"sources_last_updated,omitempty"`
+}
+
+// SourceLastUpdated returns when each data source last supplied competitor
+// data for the report, keyed by source name
+func (r *CompetitorReport) SourceLastUpdated() map[string]time.Time {
+	if r.Meta == nil {
+		return map[string]time.Time{}
+	}
+	updated := make(map[string]time.Time, len(r.Meta.SourcesLastUpdated))
+	for source, at := range r.Meta.SourcesLastUpdated {
+		updated[source] = at
+	}
+	return updated
+}
+
+// sourceFreshness groups competitor data by source and keeps the latest
+// RetrievedAt per source; untagged or undated records are ignored
+func sourceFreshness(data []CompetitorData) map[string]time.Time {
+	freshness := make(map[string]time.Time)
+	for _, competitor := range data {
+		if competitor.Source == "" || competitor.RetrievedAt.IsZero() {
+			continue
+		}
+		if competitor.RetrievedAt.After(freshness[competitor.Source]) {
+			freshness[competitor.Source] = competitor.RetrievedAt
+		}
+	}
+	return freshness
+}
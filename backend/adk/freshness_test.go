@@ -0,0 +1,59 @@
+package adk
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// taggedSource returns records pre-tagged with their upstream sources
+type taggedSource struct {
+	records []CompetitorData
+}
+
+func (s taggedSource) Name() string { return "aggregate" }
+
+func (s taggedSource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	return append([]CompetitorData(nil), s.records...), nil
+}
+
+func TestSourceLastUpdated(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	crunchbase := now.Add(-48 * time.Hour)
+	news := now.Add(-time.Hour)
+
+	source := taggedSource{records: []CompetitorData{
+		{Name: "Competitor A", MarketShare: 20, Source: "crunchbase", RetrievedAt: crunchbase.Add(-24 * time.Hour)},
+		{Name: "Competitor B", MarketShare: 15, Source: "crunchbase", RetrievedAt: crunchbase},
+		{Name: "Competitor C", MarketShare: 10, Source: "news", RetrievedAt: news},
+		{Name: "Competitor D", MarketShare: 5, RetrievedAt: now},
+	}}
+	agent := NewCompetitorIntelligenceAgent(WithDataSource(source))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	updated := report.SourceLastUpdated()
+	want := map[string]time.Time{
+		"crunchbase": crunchbase,
+		"news":       news,
+		"aggregate":  now,
+	}
+	if len(updated) != len(want) {
+		t.Fatalf("Expected %d sources, got %v", len(want), updated)
+	}
+	for name, at := range want {
+		if !updated[name].Equal(at) {
+			t.Errorf("Expected %s last updated %s, got %s", name, at, updated[name])
+		}
+	}
+}
+
+func TestSourceLastUpdatedWithoutMeta(t *testing.T) {
+	report := &CompetitorReport{}
+	if updated := report.SourceLastUpdated(); len(updated) != 0 {
+		t.Errorf("Expected no sources, got %v", updated)
+	}
+}
@@ -0,0 +1,65 @@
+package adk
+
+import "fmt"
+
+// nameSimilarity scores two names from 0 (nothing shared) to 1 (identical
+// after case and whitespace folding) as one minus their Levenshtein distance
+// over the longer name's length
+func nameSimilarity(a, b string) float64 {
+	x, y := []rune(nameKey(a)), []rune(nameKey(b))
+	longest := max(len(x), len(y))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(x, y))/float64(longest)
+}
+
+// levenshtein counts the single-rune edits turning a into b
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// fuzzyMatch returns the index of the competitor whose name is most similar
+// to name, provided the similarity reaches the configured threshold. It
+// returns -1 when fuzzy matching is off or nothing is close enough.
+func (a *CompetitorIntelligenceAgent) fuzzyMatch(name string, competitors []CompetitorData) int {
+	threshold := a.Config.FuzzyMatchThreshold
+	if threshold <= 0 {
+		return -1
+	}
+
+	best, bestScore := -1, threshold
+	for i, competitor := range competitors {
+		if score := nameSimilarity(name, competitor.Name); score >= bestScore {
+			if best < 0 || score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+	}
+	return best
+}
+
+// ValidateFuzzyMatchThreshold checks a fuzzy dedup threshold lies in 0-1,
+// where 0 turns fuzzy matching off
+func ValidateFuzzyMatchThreshold(threshold float64) error {
+	if threshold < 0 || threshold > 1 {
+		return fmt.Errorf("%w: fuzzy match threshold must be between 0 and 1, got %g", ErrInvalidInput, threshold)
+	}
+	return nil
+}
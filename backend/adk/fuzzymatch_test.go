@@ -0,0 +1,78 @@
+package adk
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"Acme", "acme", 1},
+		{"Acme Corp", "Acme  Corporation", 1 - 7.0/16},
+		{"Acme", "Apex", 0.25},
+		{"", "", 1},
+	}
+
+	for _, tt := range tests {
+		if got := nameSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("nameSimilarity(%q, %q): expected %.4f, got %.4f", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
+
+func TestNormalizeCompetitors_FuzzyMatching(t *testing.T) {
+	data := []CompetitorData{
+		{Name: "Acme Corp", MarketShare: 20, Strengths: []string{"Brand"}},
+		{Name: "Acme Corporation", Strengths: []string{"Scale"}},
+		{Name: "Zenith Labs", MarketShare: 5},
+	}
+
+	tests := []struct {
+		name      string
+		threshold float64
+		want      int
+	}{
+		{"off by default", 0, 3},
+		{"strict threshold keeps them apart", 0.9, 3},
+		{"lenient threshold merges near-duplicates", 0.5, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(WithFuzzyMatching(tt.threshold))
+			normalized := agent.NormalizeCompetitors(data)
+			if len(normalized) != tt.want {
+				t.Fatalf("Expected %d competitors, got %d: %+v", tt.want, len(normalized), normalized)
+			}
+			if tt.want == 2 {
+				merged := normalized[0]
+				if merged.Name != "Acme Corp" || merged.MarketShare != 20 {
+					t.Errorf("Expected the first name and share to win, got %+v", merged)
+				}
+				if len(merged.Aliases) != 1 || merged.Aliases[0] != "Acme Corporation" {
+					t.Errorf("Expected the merged name kept as an alias, got %v", merged.Aliases)
+				}
+				if len(merged.Strengths) != 2 {
+					t.Errorf("Expected strengths unioned, got %v", merged.Strengths)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateFuzzyMatchThreshold(t *testing.T) {
+	for _, threshold := range []float64{0, 0.5, 1} {
+		if err := ValidateFuzzyMatchThreshold(threshold); err != nil {
+			t.Errorf("Expected %g to be valid, got %v", threshold, err)
+		}
+	}
+	for _, threshold := range []float64{-0.1, 1.5} {
+		if err := ValidateFuzzyMatchThreshold(threshold); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Expected %g to be rejected, got %v", threshold, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package adk
+
+// defaultEmergingGrowthRate is the annual growth, in percent, at or above
+// which a competitor is flagged as an emerging threat
+const defaultEmergingGrowthRate = 50.0
+
+// Growth contributes growthWeight points per percent of annual growth to
+// the threat score, capped at maxGrowthScore either way
+const (
+	growthWeight   = 0.2
+	maxGrowthScore = 20.0
+)
+
+// growthScore is the threat score contribution of an annual growth rate
+func growthScore(rate float64) float64 {
+	return max(-maxGrowthScore, min(rate*growthWeight, maxGrowthScore))
+}
+
+// emergingGrowthRate returns the configured emerging-threat threshold
+func (a *CompetitorIntelligenceAgent) emergingGrowthRate() float64 {
+	if a.Config.EmergingGrowthRate > 0 {
+		return a.Config.EmergingGrowthRate
+	}
+	return defaultEmergingGrowthRate
+}
+
+// isEmerging reports whether the competitor grows fast enough to be an
+// emerging threat regardless of its current share
+func (a *CompetitorIntelligenceAgent) isEmerging(competitor CompetitorData) bool {
+	return competitor.GrowthRate >= a.emergingGrowthRate()
+}
+
+// momentumFor derives momentum from the growth rate when one is known,
+// then from recent news sentiment, falling back to the wording heuristic
+func (a *CompetitorIntelligenceAgent) momentumFor(competitor CompetitorData) string {
+	switch {
+	case a.isEmerging(competitor):
+		return MomentumRising
+	case competitor.GrowthRate < 0:
+		return MomentumFalling
+	}
+	if momentum := newsMomentum(competitor.RecentNews); momentum != "" {
+		return momentum
+	}
+	return heuristicMomentum(competitor)
+}
@@ -0,0 +1,73 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze_GrowthRate(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []Option
+		growth       float64
+		wantLevel    string
+		wantMomentum string
+		wantEmerging bool
+	}{
+		{"no growth data", nil, 0, ThreatLow, MomentumStable, false},
+		{"modest growth", nil, 20, ThreatLow, MomentumStable, false},
+		{"high growth", nil, 120, ThreatMedium, MomentumRising, true},
+		{"shrinking", nil, -15, ThreatLow, MomentumFalling, false},
+		{"custom threshold", []Option{WithEmergingGrowthRate(15)}, 20, ThreatMedium, MomentumRising, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(tt.opts...)
+			analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+				{Name: "Upstart", MarketShare: 3, GrowthRate: tt.growth},
+			})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+
+			analysis := analyses[0]
+			if analysis.ThreatLevel != tt.wantLevel {
+				t.Errorf("Expected threat level %s, got %s", tt.wantLevel, analysis.ThreatLevel)
+			}
+			if analysis.Momentum != tt.wantMomentum {
+				t.Errorf("Expected momentum %s, got %s", tt.wantMomentum, analysis.Momentum)
+			}
+			if analysis.EmergingThreat != tt.wantEmerging {
+				t.Errorf("Expected emerging threat %v, got %v", tt.wantEmerging, analysis.EmergingThreat)
+			}
+		})
+	}
+}
+
+func TestAnalyze_GrowthRaisesThreatScore(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithScoreBreakdown(true))
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "Steady", MarketShare: 5},
+		{Name: "Rocket", MarketShare: 5, GrowthRate: 200},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if analyses[1].ThreatScore <= analyses[0].ThreatScore {
+		t.Errorf("Expected growth to raise the threat score, got %v vs %v", analyses[1].ThreatScore, analyses[0].ThreatScore)
+	}
+	if got := analyses[1].ScoreBreakdown[ScoreComponentGrowth]; got != maxGrowthScore {
+		t.Errorf("Expected growth contribution capped at %v, got %v", maxGrowthScore, got)
+	}
+	if _, ok := analyses[0].ScoreBreakdown[ScoreComponentGrowth]; ok {
+		t.Error("Expected no growth component without growth data")
+	}
+
+	report := &CompetitorReport{Competitors: analyses}
+	if out := report.ToMarkdown(FormatOptions{}); !strings.Contains(out, "emerging threat") {
+		t.Errorf("Expected Markdown to flag the emerging threat, got:\n%s", out)
+	}
+}
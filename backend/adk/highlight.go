@@ -0,0 +1,91 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// allCompetitorSections lists every per-competitor section; highlighted
+// competitors show any their persona leaves out after the persona's own
+var allCompetitorSections = []string{
+	fieldThreat, fieldRank, fieldPositioning, fieldMarketShare, fieldFunding, fieldCompleteness,
+	fieldDifferentiators, fieldFeatureGaps, fieldOpportunities, fieldRisks, fieldActionPlan, fieldNotes,
+}
+
+// highlightKey is the context key carrying per-run highlighted competitors
+type highlightKey struct{}
+
+// WithHighlights returns a context that makes Run flag the named
+// competitors as the report's focus, overriding the configured Highlights
+func WithHighlights(ctx context.Context, names ...string) context.Context {
+	return context.WithValue(ctx, highlightKey{}, names)
+}
+
+// highlightsFor returns the competitors to highlight in a run, preferring
+// a context override
+func (a *CompetitorIntelligenceAgent) highlightsFor(ctx context.Context) []string {
+	if names, ok := ctx.Value(highlightKey{}).([]string); ok && len(names) > 0 {
+		return names
+	}
+	return a.Config.Highlights
+}
+
+// markHighlights flags analyses named in names and adds their focus summary
+func markHighlights(analyses []CompetitorAnalysis, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	for i := range analyses {
+		for _, name := range names {
+			if nameKey(name) == nameKey(analyses[i].CompetitorName) {
+				analyses[i].Highlighted = true
+			}
+		}
+	}
+}
+
+// pinHighlights moves highlighted analyses to the front, keeping the
+// chosen order within both groups, and summarizes each one
+func pinHighlights(analyses []CompetitorAnalysis) {
+	slices.SortStableFunc(analyses, func(x, y CompetitorAnalysis) int {
+		switch {
+		case x.Highlighted == y.Highlighted:
+			return 0
+		case x.Highlighted:
+			return -1
+		default:
+			return 1
+		}
+	})
+	for i := range analyses {
+		if analyses[i].Highlighted {
+			analyses[i].FocusSummary = focusSummary(analyses[i])
+		}
+	}
+}
+
+// focusSummary condenses a highlighted competitor into one paragraph
+func focusSummary(analysis CompetitorAnalysis) string {
+	summary := fmt.Sprintf("%s is a %s threat (score %s, rank %d) with %s%% market share and %s positioning.",
+		analysis.CompetitorName, analysis.ThreatLevel, formatFloat(analysis.ThreatScore), analysis.Rank,
+		formatFloat(analysis.MarketShare), analysis.Positioning)
+	if len(analysis.KeyDifferentiators) > 0 {
+		summary += " Its edge: " + analysis.KeyDifferentiators[0] + "."
+	}
+	if len(analysis.Opportunities) > 0 {
+		summary += " Best opening: " + analysis.Opportunities[0] + "."
+	}
+	return summary
+}
+
+// highlightSections extends a persona's sections with every other section
+func highlightSections(sections []string) []string {
+	full := append([]string(nil), sections...)
+	for _, section := range allCompetitorSections {
+		if !slices.Contains(full, section) {
+			full = append(full, section)
+		}
+	}
+	return full
+}
@@ -0,0 +1,64 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestGenerateReport_Highlights(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithMinThreatLevel(ThreatMedium))
+	ctx := WithHighlights(WithOrder(context.Background(), OrderName), "gamma", "Delta")
+
+	analyses, err := agent.Analyze(ctx, []CompetitorData{
+		{Name: "Alpha", MarketShare: 30, Strengths: []string{"Brand"}},
+		{Name: "Beta", MarketShare: 15},
+		{Name: "Gamma", MarketShare: 12, Weaknesses: []string{"Slow support"}},
+		{Name: "Delta", MarketShare: 2},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	report, err := agent.GenerateReport(ctx, "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	var names []string
+	for _, analysis := range report.Competitors {
+		names = append(names, analysis.CompetitorName)
+	}
+	// Highlighted competitors lead in the chosen order, and Delta survives
+	// the threat filter
+	if got, want := strings.Join(names, ","), "Delta,Gamma,Alpha,Beta"; got != want {
+		t.Errorf("Expected order %s, got %s", want, got)
+	}
+
+	for _, analysis := range report.Competitors {
+		wantHighlight := analysis.CompetitorName == "Gamma" || analysis.CompetitorName == "Delta"
+		if analysis.Highlighted != wantHighlight {
+			t.Errorf("%s: expected Highlighted %v", analysis.CompetitorName, wantHighlight)
+		}
+		if (analysis.FocusSummary != "") != wantHighlight {
+			t.Errorf("%s: unexpected focus summary %q", analysis.CompetitorName, analysis.FocusSummary)
+		}
+	}
+	if analyses[2].Highlighted {
+		t.Error("Expected GenerateReport not to modify the caller's analyses")
+	}
+
+	md := report.ToMarkdown(FormatOptions{})
+	if !strings.Contains(md, "### Gamma (focus)") || !strings.Contains(md, "### Alpha\n") {
+		t.Errorf("Expected highlighted competitors marked in Markdown, got:\n%s", md)
+	}
+}
+
+func TestHighlightSections(t *testing.T) {
+	sections := highlightSections(competitorSections[PersonaSales])
+	if len(sections) != len(allCompetitorSections) {
+		t.Errorf("Expected every section for highlighted competitors, got %v", sections)
+	}
+	if sections[0] != fieldThreat || sections[len(competitorSections[PersonaSales])] == fieldThreat {
+		t.Errorf("Expected the persona's sections first, got %v", sections)
+	}
+}
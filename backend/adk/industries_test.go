@@ -0,0 +1,50 @@
+package adk
+
+import (
+	"context"
+	"testing"
+)
+
+// TestRunIndustries tests that competitors merge across industries with tags
+func TestRunIndustries(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	report, err := agent.RunIndustries(context.Background(), "TestCorp", []string{"SaaS", "Fintech"})
+	if err != nil {
+		t.Fatalf("RunIndustries() error = %v", err)
+	}
+
+	// The stub source returns the same three competitors for every industry
+	if len(report.Competitors) != 3 {
+		t.Fatalf("Expected 3 merged competitors, got %d", len(report.Competitors))
+	}
+
+	for _, analysis := range report.Competitors {
+		if len(analysis.Industries) != 2 || analysis.Industries[0] != "SaaS" || analysis.Industries[1] != "Fintech" {
+			t.Errorf("%s: Industries = %v, want [SaaS Fintech]", analysis.CompetitorName, analysis.Industries)
+		}
+	}
+}
+
+// TestNormalizeCompetitors_IndustryTags tests tagging of distinct competitors per industry
+func TestNormalizeCompetitors_IndustryTags(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	normalized := agent.NormalizeCompetitors([]CompetitorData{
+		{Name: "PayCo", Industry: "Fintech"},
+		{Name: "CloudCo", Industry: "SaaS"},
+		{Name: "PayCo", Industry: "Retail"},
+	})
+
+	if len(normalized) != 2 {
+		t.Fatalf("Expected 2 competitors, got %d", len(normalized))
+	}
+
+	if got := normalized[0].Industries; len(got) != 2 || got[0] != "Fintech" || got[1] != "Retail" {
+		t.Errorf("PayCo industries = %v, want [Fintech Retail]", got)
+	}
+
+	if got := normalized[1].Industries; len(got) != 1 || got[0] != "SaaS" {
+		t.Errorf("CloudCo industries = %v, want [SaaS]", got)
+	}
+}
@@ -0,0 +1,115 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// IndustrySource records where a report's industries came from
+type IndustrySource string
+
+// Industry sources; reports researched without any industry carry neither
+const (
+	IndustryProvided IndustrySource = "provided"
+	IndustryInferred IndustrySource = "inferred"
+)
+
+// IndustryInferrer guesses a company's industry from its name, returning ""
+// when it does not know the company
+type IndustryInferrer interface {
+	InferIndustry(ctx context.Context, companyName string) (string, error)
+}
+
+// IndustryLookup infers industries from a fixed company-to-industry table,
+// matching company names case-insensitively
+type IndustryLookup map[string]string
+
+// InferIndustry looks the company up
+func (l IndustryLookup) InferIndustry(ctx context.Context, companyName string) (string, error) {
+	key := nameKey(companyName)
+	for company, industry := range l {
+		if nameKey(company) == key {
+			return industry, nil
+		}
+	}
+	return "", nil
+}
+
+// ParseIndustryLookup parses "company=industry;..." entries such as
+// "Stripe=Fintech;Shopify=E-commerce"
+func ParseIndustryLookup(spec string) (IndustryLookup, error) {
+	lookup := make(IndustryLookup)
+	for _, entry := range strings.Split(spec, ";") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		company, industry, ok := strings.Cut(entry, "=")
+		company, industry = strings.TrimSpace(company), strings.TrimSpace(industry)
+		if !ok || company == "" || industry == "" {
+			return nil, fmt.Errorf("%w: industry lookup %q must be company=industry", ErrInvalidInput, entry)
+		}
+		lookup[company] = industry
+	}
+	return lookup, nil
+}
+
+// llmIndustryPrompt asks the model for a single industry name
+const llmIndustryPrompt = `Which industry is the company %q in? Reply with the
+industry name only, or "unknown" if you do not know the company.`
+
+// maxInferredIndustryLength bounds replies accepted as an industry name, so
+// rambling answers are ignored
+const maxInferredIndustryLength = 60
+
+// LLMIndustryInferrer asks a language model for a company's industry
+type LLMIndustryInferrer struct {
+	Completer Completer
+}
+
+// InferIndustry prompts the model, ignoring replies that are not a short
+// industry name
+func (i LLMIndustryInferrer) InferIndustry(ctx context.Context, companyName string) (string, error) {
+	reply, err := i.Completer.Complete(ctx, fmt.Sprintf(llmIndustryPrompt, companyName))
+	if err != nil {
+		return "", err
+	}
+	industry, _, _ := strings.Cut(strings.TrimSpace(reply), "\n")
+	industry = strings.Trim(strings.TrimSpace(industry), `"'.`)
+	if industry == "" || strings.EqualFold(industry, "unknown") || len(industry) > maxInferredIndustryLength {
+		return "", nil
+	}
+	return industry, nil
+}
+
+// resolveIndustries returns the industries to research and where they came
+// from. Explicit industries are authoritative; when none are given, the
+// lookup table and then any configured inferrer are consulted. Inference
+// failures are logged and fall back to researching without an industry.
+func (a *CompetitorIntelligenceAgent) resolveIndustries(ctx context.Context, companyName string, industries []string) ([]string, IndustrySource) {
+	for _, industry := range industries {
+		if strings.TrimSpace(industry) != "" {
+			return industries, IndustryProvided
+		}
+	}
+
+	var inferrers []IndustryInferrer
+	if len(a.Config.IndustryLookup) > 0 {
+		inferrers = append(inferrers, a.Config.IndustryLookup)
+	}
+	if a.industryInferrer != nil {
+		inferrers = append(inferrers, a.industryInferrer)
+	}
+	for _, inferrer := range inferrers {
+		industry, err := inferrer.InferIndustry(ctx, companyName)
+		if err != nil {
+			log.Printf("industry inference for %q failed: %v", companyName, err)
+			continue
+		}
+		if industry != "" {
+			return []string{industry}, IndustryInferred
+		}
+	}
+	return industries, ""
+}
@@ -0,0 +1,107 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseIndustryLookup(t *testing.T) {
+	lookup, err := ParseIndustryLookup("Stripe=Fintech; Shopify = E-commerce;")
+	if err != nil {
+		t.Fatalf("ParseIndustryLookup() error = %v", err)
+	}
+	if lookup["Stripe"] != "Fintech" || lookup["Shopify"] != "E-commerce" || len(lookup) != 2 {
+		t.Errorf("Unexpected lookup %v", lookup)
+	}
+	if _, err := ParseIndustryLookup("Stripe"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestRunIndustries_InferredIndustry(t *testing.T) {
+	lookup := IndustryLookup{"Stripe": "Fintech"}
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		company      string
+		industries   []string
+		wantIndustry string
+		wantSource   IndustrySource
+	}{
+		{
+			name:         "Known company",
+			opts:         []Option{WithIndustryLookup(lookup)},
+			company:      "stripe",
+			industries:   []string{""},
+			wantIndustry: "Fintech",
+			wantSource:   IndustryInferred,
+		},
+		{
+			name:         "Explicit industry is authoritative",
+			opts:         []Option{WithIndustryLookup(lookup)},
+			company:      "Stripe",
+			industries:   []string{"Payments"},
+			wantIndustry: "Payments",
+			wantSource:   IndustryProvided,
+		},
+		{
+			name:       "Unknown company",
+			opts:       []Option{WithIndustryLookup(lookup)},
+			company:    "Nobody Inc",
+			industries: []string{""},
+		},
+		{
+			name:         "Model inference",
+			opts:         []Option{WithIndustryInferrer(LLMIndustryInferrer{Completer: &scriptedCompleter{replies: []string{"Cloud Computing.\n"}}})},
+			company:      "Acme Cloud",
+			industries:   []string{""},
+			wantIndustry: "Cloud Computing",
+			wantSource:   IndustryInferred,
+		},
+		{
+			name:       "Model failure falls back",
+			opts:       []Option{WithIndustryInferrer(LLMIndustryInferrer{Completer: &scriptedCompleter{}})},
+			company:    "Acme Cloud",
+			industries: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := NewCompetitorIntelligenceAgent(tt.opts...).RunIndustries(context.Background(), tt.company, tt.industries)
+			if err != nil {
+				t.Fatalf("RunIndustries() error = %v", err)
+			}
+
+			if report.IndustrySource != tt.wantSource {
+				t.Errorf("Expected industry source %q, got %q", tt.wantSource, report.IndustrySource)
+			}
+			if tt.wantIndustry == "" {
+				if report.Industries != nil {
+					t.Errorf("Expected no recorded industries, got %v", report.Industries)
+				}
+				return
+			}
+			if len(report.Industries) != 1 || report.Industries[0] != tt.wantIndustry {
+				t.Errorf("Expected industries [%s], got %v", tt.wantIndustry, report.Industries)
+			}
+			for _, analysis := range report.Competitors {
+				if len(analysis.Industries) != 1 || analysis.Industries[0] != tt.wantIndustry {
+					t.Errorf("Expected %s researched in %s, got %v", analysis.CompetitorName, tt.wantIndustry, analysis.Industries)
+				}
+			}
+		})
+	}
+}
+
+func TestLLMIndustryInferrer_IgnoresRambling(t *testing.T) {
+	for _, reply := range []string{"unknown", "", "I am not sure which industry this company belongs to, but it could be several"} {
+		inferrer := LLMIndustryInferrer{Completer: &scriptedCompleter{replies: []string{reply}}}
+		industry, err := inferrer.InferIndustry(context.Background(), "Acme")
+		if err != nil || industry != "" {
+			t.Errorf("Expected no industry for %q, got %q (%v)", reply, industry, err)
+		}
+	}
+}
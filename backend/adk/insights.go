@@ -0,0 +1,131 @@
+package adk
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// DefaultInsightsTemplate is the built-in market insights wording
+const DefaultInsightsTemplate = "The competitive landscape shows {{.CompetitorCount}} major players. " +
+	"High-threat competitors control significant market share. " +
+	"Opportunities exist in underserved segments." +
+	"{{if .TechOverlap}} Technology overlap: every competitor uses {{.TechOverlap}}, so these are table stakes.{{end}}" +
+	"{{if .FeatureGaps}} No competitor fully covers: {{.FeatureGaps}}.{{end}}" +
+	"{{if .OmittedCount}} {{.OmittedCount}} lower-threat competitors were omitted.{{end}}" +
+	"{{if .CappedCount}} {{.CappedCount}} competitors beyond the per-tier limits were omitted.{{end}}" +
+	"{{if .ProductExcludedCount}} {{.ProductExcludedCount}} competitors without {{printf \"%q\" .ProductFilter}} products were excluded.{{end}}"
+
+// InsightStats are the computed values available to insights templates
+type InsightStats struct {
+	TargetCompany    string
+	CompetitorCount  int
+	HighThreatCount  int
+	TotalMarketShare float64
+	// HHI is the Herfindahl-Hirschman index over competitor shares (0-10000)
+	HHI            float64
+	TopThreat      string
+	TopThreatScore float64
+	// OmittedCount is how many competitors fell below the reporting threshold
+	OmittedCount int
+	// CappedCount is how many competitors the per-tier caps left out
+	CappedCount int
+	// ProductFilter is the products keyword competitors were filtered by, and
+	// ProductExcludedCount how many it excluded
+	ProductFilter        string
+	ProductExcludedCount int
+	// TechOverlap lists technologies every competitor uses, comma-separated
+	TechOverlap string
+	// FeatureGaps lists compared features no competitor fully supports
+	FeatureGaps string
+}
+
+// InsightsTemplate is a validated text/template for MarketInsights
+type InsightsTemplate struct {
+	source string
+	tmpl   *template.Template
+}
+
+// ParseInsightsTemplate parses and validates an insights template by
+// rendering it against sample stats, so bad field references fail at load
+func ParseInsightsTemplate(text string) (*InsightsTemplate, error) {
+	tmpl, err := template.New("insights").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid insights template: %w", err)
+	}
+
+	sample := InsightStats{TargetCompany: "Sample", CompetitorCount: 1, TopThreat: "Sample Competitor"}
+	if err := tmpl.Execute(&bytes.Buffer{}, sample); err != nil {
+		return nil, fmt.Errorf("invalid insights template: %w", err)
+	}
+
+	return &InsightsTemplate{source: text, tmpl: tmpl}, nil
+}
+
+// LoadInsightsTemplate reads and validates an insights template file
+func LoadInsightsTemplate(path string) (*InsightsTemplate, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read insights template: %w", err)
+	}
+	return ParseInsightsTemplate(string(text))
+}
+
+// Source returns the template text
+func (t *InsightsTemplate) Source() string {
+	return t.source
+}
+
+// Render executes the template against stats
+func (t *InsightsTemplate) Render(stats InsightStats) (string, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, stats); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// defaultInsightsTemplate is parsed once; the built-in template is known good
+var defaultInsightsTemplate = func() *InsightsTemplate {
+	t, err := ParseInsightsTemplate(DefaultInsightsTemplate)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}()
+
+// computeInsightStats derives the template inputs from the analyses
+func computeInsightStats(targetCompany string, analyses []CompetitorAnalysis) InsightStats {
+	stats := InsightStats{
+		TargetCompany:   targetCompany,
+		CompetitorCount: len(analyses),
+	}
+
+	for _, analysis := range analyses {
+		if analysis.ThreatLevel == ThreatHigh {
+			stats.HighThreatCount++
+		}
+		stats.TotalMarketShare += analysis.MarketShare
+		stats.HHI += analysis.MarketShare * analysis.MarketShare
+		if stats.TopThreat == "" || analysis.ThreatScore > stats.TopThreatScore {
+			stats.TopThreat = analysis.CompetitorName
+			stats.TopThreatScore = analysis.ThreatScore
+		}
+	}
+
+	stats.TotalMarketShare = round2(stats.TotalMarketShare)
+	stats.HHI = round2(stats.HHI)
+	stats.TechOverlap = strings.Join(sharedTech(analyses), ", ")
+	return stats
+}
+
+// renderInsights applies the configured (or default) insights template
+func (a *CompetitorIntelligenceAgent) renderInsights(stats InsightStats) (string, error) {
+	tmpl := a.insightsTemplate
+	if tmpl == nil {
+		tmpl = defaultInsightsTemplate
+	}
+	return tmpl.Render(stats)
+}
@@ -0,0 +1,98 @@
+package adk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerateReport_CustomInsightsTemplate tests rendering a custom template
+func TestGenerateReport_CustomInsightsTemplate(t *testing.T) {
+	tmpl, err := ParseInsightsTemplate("{{.TargetCompany}} faces {{.CompetitorCount}} rivals (HHI {{.HHI}}); watch {{.TopThreat}}.")
+	if err != nil {
+		t.Fatalf("ParseInsightsTemplate() error = %v", err)
+	}
+
+	agent := NewCompetitorIntelligenceAgent(WithInsightsTemplate(tmpl))
+	ctx := context.Background()
+
+	analyses, err := agent.Analyze(ctx, []CompetitorData{
+		{Name: "Alpha", MarketShare: 30, Strengths: []string{"Brand"}},
+		{Name: "Beta", MarketShare: 10},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	report, err := agent.GenerateReport(ctx, "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	want := "TestCorp faces 2 rivals (HHI 1000); watch Alpha."
+	if report.MarketInsights != want {
+		t.Errorf("MarketInsights = %q, want %q", report.MarketInsights, want)
+	}
+}
+
+// TestParseInsightsTemplate_Invalid tests that bad templates fail at load
+func TestParseInsightsTemplate_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"Syntax error":  "{{.CompetitorCount",
+		"Unknown field": "{{.NoSuchField}}",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseInsightsTemplate(text); err == nil {
+				t.Error("Expected template validation error")
+			}
+		})
+	}
+}
+
+// TestLoadInsightsTemplate tests loading a template from a file
+func TestLoadInsightsTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "insights.tmpl")
+	if err := os.WriteFile(path, []byte("{{.HighThreatCount}} high threats"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := LoadInsightsTemplate(path)
+	if err != nil {
+		t.Fatalf("LoadInsightsTemplate() error = %v", err)
+	}
+
+	got, err := tmpl.Render(InsightStats{HighThreatCount: 2})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got != "2 high threats" {
+		t.Errorf("Render() = %q", got)
+	}
+
+	if _, err := LoadInsightsTemplate(filepath.Join(t.TempDir(), "missing.tmpl")); err == nil {
+		t.Error("Expected error for missing template file")
+	}
+}
+
+// TestGenerateReport_DefaultInsights tests the built-in wording is unchanged
+func TestGenerateReport_DefaultInsights(t *testing.T) {
+	want := "The competitive landscape shows 2 major players. High-threat competitors control significant market share. Opportunities exist in underserved segments."
+
+	// A nil template keeps the default wording
+	for name, agent := range map[string]*CompetitorIntelligenceAgent{
+		"Unset": NewCompetitorIntelligenceAgent(),
+		"Nil":   NewCompetitorIntelligenceAgent(WithInsightsTemplate(nil)),
+	} {
+		report, err := agent.GenerateReport(context.Background(), "TestCorp", []CompetitorAnalysis{{CompetitorName: "A"}, {CompetitorName: "B"}})
+		if err != nil {
+			t.Fatalf("%s: GenerateReport() error = %v", name, err)
+		}
+
+		if report.MarketInsights != want {
+			t.Errorf("%s: MarketInsights = %q, want %q", name, report.MarketInsights, want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToKillSheet renders a Markdown battlecard per High and Medium threat
+// competitor for sales reps: the competitor's weaknesses as attack points
+// and its strengths as objections to prepare for, each with a suggested play
+func (r *CompetitorReport) ToKillSheet(opts FormatOptions) string {
+	r = r.Truncated(opts.MaxFieldLength)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Sales Kill Sheets: %s\n\n", r.TargetCompany)
+
+	cards := 0
+	for _, analysis := range r.Competitors {
+		if analysis.ThreatLevel != ThreatHigh && analysis.ThreatLevel != ThreatMedium {
+			continue
+		}
+		writeKillSheet(&b, analysis)
+		cards++
+	}
+	if cards == 0 {
+		b.WriteString("_No High or Medium threat competitors to prepare for._\n")
+	}
+	return b.String()
+}
+
+// writeKillSheet renders one competitor's battlecard
+func writeKillSheet(b *strings.Builder, analysis CompetitorAnalysis) {
+	fmt.Fprintf(b, "## %s\n\n", analysis.CompetitorName)
+	fmt.Fprintf(b, "%s threat · %s%% market share", analysis.ThreatLevel, formatFloat(analysis.MarketShare))
+	if analysis.Positioning != "" {
+		fmt.Fprintf(b, " · %s positioning", analysis.Positioning)
+	}
+	if analysis.Momentum != "" {
+		fmt.Fprintf(b, " · %s momentum", strings.ToLower(analysis.Momentum))
+	}
+	b.WriteString("\n\n")
+
+	b.WriteString("### Attack points\n\n")
+	if len(analysis.Weaknesses) == 0 {
+		b.WriteString("- No known weaknesses on record; lead with your own differentiators\n")
+	}
+	for _, weakness := range analysis.Weaknesses {
+		fmt.Fprintf(b, "- **%s**: win by %s\n", weakness,
+			matchTactic(weakness, opportunityTactics, "positioning your offering directly against this gap"))
+	}
+	b.WriteString("\n")
+
+	b.WriteString("### Objection handling\n\n")
+	if len(analysis.KeyDifferentiators) == 0 {
+		b.WriteString("- No known strengths on record\n")
+	}
+	for _, strength := range analysis.KeyDifferentiators {
+		fmt.Fprintf(b, "- **\"They have %s.\"** Respond by %s\n", strings.ToLower(strength),
+			matchTactic(strength, riskTactics, "reframing around the outcomes your product delivers"))
+	}
+	b.WriteString("\n")
+}
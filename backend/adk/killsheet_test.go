@@ -0,0 +1,71 @@
+package adk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToKillSheet(t *testing.T) {
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{
+			{
+				CompetitorName:     "Acme",
+				ThreatLevel:        ThreatHigh,
+				MarketShare:        32.5,
+				Positioning:        "Premium",
+				Momentum:           MomentumRising,
+				KeyDifferentiators: []string{"Strong brand", "Unknown edge"},
+				Weaknesses:         []string{"Slow support"},
+			},
+			{CompetitorName: "Tiny", ThreatLevel: ThreatLow, Weaknesses: []string{"Small team"}},
+		},
+	}
+
+	sheet := report.ToKillSheet(FormatOptions{})
+
+	for _, want := range []string{
+		"# Sales Kill Sheets: TestCorp",
+		"## Acme",
+		"High threat · 32.5% market share · Premium positioning · rising momentum",
+		"### Attack points",
+		"- **Slow support**: win by advertising your SLA guarantees and response times",
+		"### Objection handling",
+		`- **"They have strong brand."** Respond by investing in thought leadership and customer advocacy`,
+		`- **"They have unknown edge."** Respond by reframing around the outcomes your product delivers`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("Expected kill sheet to contain %q, got:\n%s", want, sheet)
+		}
+	}
+	if strings.Contains(sheet, "Tiny") {
+		t.Errorf("Expected Low threat competitors to be left out, got:\n%s", sheet)
+	}
+}
+
+func TestToKillSheet_NoThreats(t *testing.T) {
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors:   []CompetitorAnalysis{{CompetitorName: "Tiny", ThreatLevel: ThreatLow}},
+	}
+
+	if sheet := report.ToKillSheet(FormatOptions{}); !strings.Contains(sheet, "No High or Medium threat competitors") {
+		t.Errorf("Expected an empty-state note, got:\n%s", sheet)
+	}
+}
+
+func TestExport_KillSheet(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors:   []CompetitorAnalysis{{CompetitorName: "Acme", ThreatLevel: ThreatMedium, Weaknesses: []string{"High price"}}},
+	}
+
+	body, err := agent.Export(report, FormatKillSheet)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if !strings.Contains(string(body), "win by leading with transparent, lower-cost pricing") {
+		t.Errorf("Expected the pricing attack point, got:\n%s", body)
+	}
+}
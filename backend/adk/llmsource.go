@@ -0,0 +1,129 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Completer sends a prompt to a language model and returns its reply
+type Completer interface {
+	Complete(ctx context.Context, prompt string) (string, error)
+}
+
+// llmResearchPrompt asks the model for competitor data in our JSON shape
+const llmResearchPrompt = `List the main competitors of %q in the %q industry.
+Reply with a JSON array of objects with the fields name, website, industry,
+products, pricing, market_share, strengths and weaknesses.`
+
+// llmStrictInstruction is appended when retrying after an unusable reply
+const llmStrictInstruction = `
+
+Your previous reply was not valid JSON. Reply with the JSON array only: no
+prose, no Markdown code fences, no comments.`
+
+// LLMDataSource researches competitors by prompting a language model. Models
+// sometimes wrap JSON in prose or return it malformed: the JSON is extracted
+// from the reply when possible, the prompt is retried once with a stricter
+// instruction, and after that Fallback answers with a logged warning.
+type LLMDataSource struct {
+	Completer Completer
+
+	// Fallback supplies data when the model never returns usable JSON
+	// (StubDataSource when nil)
+	Fallback DataSource
+}
+
+// NewLLMDataSource creates a model-backed source falling back to the stub
+func NewLLMDataSource(completer Completer) *LLMDataSource {
+	return &LLMDataSource{Completer: completer}
+}
+
+// Name identifies the source
+func (s *LLMDataSource) Name() string {
+	return "llm"
+}
+
+// FetchCompetitors prompts the model, retrying once and then falling back
+// when its reply holds no valid competitor JSON. Errors from the model
+// itself are returned as-is.
+func (s *LLMDataSource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	prompt := fmt.Sprintf(llmResearchPrompt, companyName, industry)
+
+	var parseErr error
+	for _, attempt := range []string{prompt, prompt + llmStrictInstruction} {
+		reply, err := s.Completer.Complete(ctx, attempt)
+		if err != nil {
+			return nil, err
+		}
+		competitors, err := parseLLMCompetitors(reply)
+		if err == nil {
+			retrievedAt := time.Now()
+			for i := range competitors {
+				competitors[i].Source = s.Name()
+				if competitors[i].RetrievedAt.IsZero() {
+					competitors[i].RetrievedAt = retrievedAt
+				}
+			}
+			return competitors, nil
+		}
+		parseErr = err
+		log.Printf("data source %s returned unusable JSON: %v", s.Name(), err)
+	}
+
+	fallback := s.Fallback
+	if fallback == nil {
+		fallback = StubDataSource{}
+	}
+	log.Printf("warning: data source %s falling back to %s: %v", s.Name(), fallback.Name(), parseErr)
+	return fallback.FetchCompetitors(ctx, companyName, industry)
+}
+
+// parseLLMCompetitors decodes competitors from a model reply, accepting a
+// bare array or an object with a competitors field anywhere in the text
+func parseLLMCompetitors(reply string) ([]CompetitorData, error) {
+	raw, ok := extractJSON(reply)
+	if !ok {
+		return nil, errors.New("no JSON found in reply")
+	}
+
+	var competitors []CompetitorData
+	if strings.HasPrefix(raw, "{") {
+		var wrapped struct {
+			Competitors []CompetitorData `json:"competitors"`
+		}
+		if err := json.Unmarshal([]byte(raw), &wrapped); err != nil {
+			return nil, err
+		}
+		competitors = wrapped.Competitors
+	} else if err := json.Unmarshal([]byte(raw), &competitors); err != nil {
+		return nil, err
+	}
+
+	if len(competitors) == 0 {
+		return nil, errors.New("reply listed no competitors")
+	}
+	if err := ValidateCompetitors(competitors); err != nil {
+		return nil, err
+	}
+	return competitors, nil
+}
+
+// extractJSON returns the first complete JSON array or object in text,
+// skipping surrounding prose and code fences
+func extractJSON(text string) (string, bool) {
+	for i := 0; i < len(text); i++ {
+		if text[i] != '[' && text[i] != '{' {
+			continue
+		}
+		var raw json.RawMessage
+		if err := json.NewDecoder(strings.NewReader(text[i:])).Decode(&raw); err == nil {
+			return string(raw), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,91 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// scriptedCompleter replies with canned responses in order
+type scriptedCompleter struct {
+	replies []string
+	prompts []string
+}
+
+func (c *scriptedCompleter) Complete(ctx context.Context, prompt string) (string, error) {
+	c.prompts = append(c.prompts, prompt)
+	if len(c.prompts) > len(c.replies) {
+		return "", errors.New("unexpected prompt")
+	}
+	return c.replies[len(c.prompts)-1], nil
+}
+
+func TestLLMDataSource(t *testing.T) {
+	valid := `[{"name": "Acme", "market_share": 20, "strengths": ["Brand"]}]`
+
+	tests := []struct {
+		name        string
+		replies     []string
+		wantPrompts int
+		wantName    string
+		wantSource  string
+	}{
+		{
+			name:        "Valid",
+			replies:     []string{valid},
+			wantPrompts: 1,
+			wantName:    "Acme",
+			wantSource:  "llm",
+		},
+		{
+			name:        "Wrapped in prose",
+			replies:     []string{"Sure! Here are the competitors:\n```json\n{\"competitors\": " + valid + "}\n```\nLet me know [if] you need more."},
+			wantPrompts: 1,
+			wantName:    "Acme",
+			wantSource:  "llm",
+		},
+		{
+			name:        "Malformed then valid",
+			replies:     []string{`[{"name": "Acme", "market_share": 20,}]`, valid},
+			wantPrompts: 2,
+			wantName:    "Acme",
+			wantSource:  "llm",
+		},
+		{
+			name:        "Persistently bad",
+			replies:     []string{"I cannot help with that.", `[{"market_share": 250}]`},
+			wantPrompts: 2,
+			wantName:    "Competitor A",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completer := &scriptedCompleter{replies: tt.replies}
+			competitors, err := NewLLMDataSource(completer).FetchCompetitors(context.Background(), "TestCorp", "SaaS")
+			if err != nil {
+				t.Fatalf("FetchCompetitors() error = %v", err)
+			}
+			if len(completer.prompts) != tt.wantPrompts {
+				t.Errorf("Expected %d prompts, got %d", tt.wantPrompts, len(completer.prompts))
+			}
+			if tt.wantPrompts == 2 && !strings.Contains(completer.prompts[1], "JSON array only") {
+				t.Error("Expected the retry to use a stricter instruction")
+			}
+			if len(competitors) == 0 || competitors[0].Name != tt.wantName {
+				t.Fatalf("Expected %s first, got %+v", tt.wantName, competitors)
+			}
+			if competitors[0].Source != tt.wantSource {
+				t.Errorf("Expected source %q, got %q", tt.wantSource, competitors[0].Source)
+			}
+		})
+	}
+}
+
+func TestLLMDataSource_CompleterError(t *testing.T) {
+	source := NewLLMDataSource(&scriptedCompleter{})
+	if _, err := source.FetchCompetitors(context.Background(), "TestCorp", "SaaS"); err == nil {
+		t.Error("Expected model errors to be returned rather than falling back")
+	}
+}
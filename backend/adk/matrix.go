@@ -0,0 +1,89 @@
+package adk
+
+import "encoding/json"
+
+// DefaultMatrixDimensions are the dimensions compared by HeadToHead when none
+// are given
+var DefaultMatrixDimensions = []string{AxisMarketShare, AxisBreadth, AxisThreatScore}
+
+// HeadToHeadMatrix scores every competitor against every other.
+// Scores[i][j] is the fraction of dimensions on which competitor i beats
+// competitor j, with ties counting half, so Scores[i][j]+Scores[j][i] is
+// always 1. The diagonal is 0.5 and is excluded from win rates.
+type HeadToHeadMatrix struct {
+	Dimensions  []string    `json:"dimensions"`
+	Competitors []string    `json:"competitors"`
+	Scores      [][]float64 `json:"scores"`
+	WinRates    []float64   `json:"win_rates"`
+}
+
+// HeadToHead builds the pairwise win/loss matrix for the report's
+// competitors. Dimensions reuse the radar axes; an empty list selects
+// DefaultMatrixDimensions.
+func (r *CompetitorReport) HeadToHead(dimensions []string) (*HeadToHeadMatrix, error) {
+	if len(dimensions) == 0 {
+		dimensions = DefaultMatrixDimensions
+	}
+	if err := validateRadarAxes(dimensions); err != nil {
+		return nil, err
+	}
+
+	n := len(r.Competitors)
+	matrix := &HeadToHeadMatrix{
+		Dimensions:  append([]string(nil), dimensions...),
+		Competitors: make([]string, n),
+		Scores:      make([][]float64, n),
+		WinRates:    make([]float64, n),
+	}
+
+	values := make([][]float64, n)
+	for i, analysis := range r.Competitors {
+		matrix.Competitors[i] = analysis.CompetitorName
+		matrix.Scores[i] = make([]float64, n)
+		values[i] = make([]float64, len(dimensions))
+		for d, dimension := range dimensions {
+			values[i][d] = radarAxes[dimension](analysis)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		matrix.Scores[i][i] = 0.5
+		for j := i + 1; j < n; j++ {
+			var wins float64
+			for d := range dimensions {
+				switch {
+				case values[i][d] > values[j][d]:
+					wins++
+				case values[i][d] == values[j][d]:
+					wins += 0.5
+				}
+			}
+			score := wins / float64(len(dimensions))
+			matrix.Scores[i][j] = round2(score)
+			matrix.Scores[j][i] = round2(1 - score)
+		}
+	}
+
+	if n > 1 {
+		for i := range matrix.Scores {
+			var total float64
+			for j, score := range matrix.Scores[i] {
+				if i != j {
+					total += score
+				}
+			}
+			matrix.WinRates[i] = round2(total / float64(n-1))
+		}
+	}
+
+	return matrix, nil
+}
+
+// matrixJSON renders the head-to-head matrix on the default dimensions
+func matrixJSON(report *CompetitorReport) ([]byte, error) {
+	matrix, err := report.HeadToHead(nil)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(matrix, "", "  ")
+}
@@ -0,0 +1,96 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestHeadToHead(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{CompetitorName: "A", MarketShare: 10, ThreatScore: 40, KeyDifferentiators: []string{"x"}},
+		{CompetitorName: "B", MarketShare: 30, ThreatScore: 80, KeyDifferentiators: []string{"x", "y"}},
+		{CompetitorName: "C", MarketShare: 20, ThreatScore: 60, KeyDifferentiators: []string{"x"}},
+	}}
+
+	matrix, err := report.HeadToHead(nil)
+	if err != nil {
+		t.Fatalf("HeadToHead() error = %v", err)
+	}
+
+	for i := range matrix.Scores {
+		if matrix.Scores[i][i] != 0.5 {
+			t.Errorf("Expected diagonal 0.5 for %s, got %v", matrix.Competitors[i], matrix.Scores[i][i])
+		}
+		for j := range matrix.Scores {
+			if sum := matrix.Scores[i][j] + matrix.Scores[j][i]; sum != 1 {
+				t.Errorf("Expected %s/%s scores to sum to 1, got %v", matrix.Competitors[i], matrix.Competitors[j], sum)
+			}
+		}
+	}
+
+	if matrix.Scores[2][0] != 0.83 {
+		t.Errorf("Expected C to beat A on two dimensions and tie one (0.83), got %v", matrix.Scores[2][0])
+	}
+
+	top := 0
+	for i, rate := range matrix.WinRates {
+		if rate > matrix.WinRates[top] {
+			top = i
+		}
+	}
+	if matrix.Competitors[top] != "B" || matrix.WinRates[top] != 1 {
+		t.Errorf("Expected highest-share B to have the top win rate 1, got %s at %v", matrix.Competitors[top], matrix.WinRates[top])
+	}
+}
+
+func TestHeadToHead_SingleCompetitor(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "A", MarketShare: 10}}}
+
+	matrix, err := report.HeadToHead([]string{AxisMarketShare})
+	if err != nil {
+		t.Fatalf("HeadToHead() error = %v", err)
+	}
+	if matrix.Scores[0][0] != 0.5 || matrix.WinRates[0] != 0 {
+		t.Errorf("Expected a lone competitor to have only the diagonal and no wins, got %v, %v", matrix.Scores, matrix.WinRates)
+	}
+}
+
+func TestHeadToHead_UnknownDimension(t *testing.T) {
+	report := &CompetitorReport{}
+	if _, err := report.HeadToHead([]string{"pricing"}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestExport_Matrix(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	out, err := agent.Export(report, FormatMatrix)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	var matrix HeadToHeadMatrix
+	if err := json.Unmarshal(out, &matrix); err != nil {
+		t.Fatalf("Expected matrix JSON, got %v", err)
+	}
+
+	top, leader := 0, 0
+	for i, analysis := range report.Competitors {
+		if analysis.MarketShare > report.Competitors[leader].MarketShare {
+			leader = i
+		}
+		if matrix.WinRates[i] > matrix.WinRates[top] {
+			top = i
+		}
+	}
+	if top != leader {
+		t.Errorf("Expected highest-share %s to have the top win rate, got %s", report.Competitors[leader].CompetitorName, matrix.Competitors[top])
+	}
+}
@@ -0,0 +1,147 @@
+package adk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported message languages
+const (
+	LanguageEnglish = "en"
+	LanguageSpanish = "es"
+)
+
+// DefaultLanguage is used when no supported language is requested
+const DefaultLanguage = LanguageEnglish
+
+// Message codes are stable, machine-readable identifiers for user-facing text
+const (
+	MsgInvalidRequestBody     = "invalid_request_body"
+	MsgUnsupportedMediaType   = "unsupported_media_type"
+	MsgUnsupportedFormat      = "unsupported_format"
+	MsgInvalidInput           = "invalid_input"
+	MsgAnalysisFailed         = "analysis_failed"
+	MsgReportGenerationFailed = "report_generation_failed"
+	MsgTooManyAnalyses        = "too_many_analyses"
+	MsgUnknownFailureStage    = "unknown_failure_stage"
+	MsgBatchSize              = "batch_size"
+	MsgStorageNotConfigured   = "storage_not_configured"
+	MsgInvalidThreatLevel     = "invalid_threat_level"
+	MsgCompanyRequired        = "company_required"
+	MsgDiffParamsRequired     = "diff_params_required"
+	MsgCompareParamsRequired  = "compare_params_required"
+	MsgReportNotFound         = "report_not_found"
+	MsgReportNotReplayable    = "report_not_replayable"
+	MsgInternalError          = "internal_error"
+	MsgAdminDisabled          = "admin_disabled"
+	MsgInvalidAPIKey          = "invalid_api_key"
+	MsgProfileForbidden       = "profile_forbidden"
+)
+
+// messageCatalog holds fmt templates per language, keyed by message code
+var messageCatalog = map[string]map[string]string{
+	LanguageEnglish: {
+		MsgInvalidRequestBody:     "Invalid request body",
+		MsgUnsupportedMediaType:   "Content-Type must be application/json",
+		MsgUnsupportedFormat:      "Unsupported format %q",
+		MsgInvalidInput:           "Invalid input: %s",
+		MsgAnalysisFailed:         "The analysis could not be completed: %s",
+		MsgReportGenerationFailed: "Failed to generate report",
+		MsgTooManyAnalyses:        "Too many concurrent analyses, retry shortly",
+		MsgUnknownFailureStage:    "Unknown failure injection stage %q",
+		MsgBatchSize:              "A batch must contain between 1 and %d items",
+		MsgStorageNotConfigured:   "Report storage is not configured",
+		MsgInvalidThreatLevel:     "threat_level must be one of High, Medium, Low",
+		MsgCompanyRequired:        "company query parameter is required",
+		MsgDiffParamsRequired:     "from and to query parameters are required",
+		MsgCompareParamsRequired:  "a and b query parameters are required",
+		MsgReportNotFound:         "Report not found",
+		MsgReportNotReplayable:    "Report has no stored inputs to replay",
+		MsgInternalError:          "Internal error: %s",
+		MsgAdminDisabled:          "Admin endpoints are disabled; set API_KEY to enable them",
+		MsgInvalidAPIKey:          "Invalid or missing API key",
+		MsgProfileForbidden:       "Output profile %q reveals more than the default and requires an API key",
+	},
+	LanguageSpanish: {
+		MsgInvalidRequestBody:     "Cuerpo de la solicitud no válido",
+		MsgUnsupportedMediaType:   "Content-Type debe ser application/json",
+		MsgUnsupportedFormat:      "Formato no admitido %q",
+		MsgInvalidInput:           "Entrada no válida: %s",
+		MsgAnalysisFailed:         "No se pudo completar el análisis: %s",
+		MsgReportGenerationFailed: "No se pudo generar el informe",
+		MsgTooManyAnalyses:        "Demasiados análisis simultáneos, inténtelo de nuevo en breve",
+		MsgUnknownFailureStage:    "Etapa de inyección de fallos desconocida %q",
+		MsgBatchSize:              "Un lote debe contener entre 1 y %d elementos",
+		MsgStorageNotConfigured:   "El almacenamiento de informes no está configurado",
+		MsgInvalidThreatLevel:     "threat_level debe ser High, Medium o Low",
+		MsgCompanyRequired:        "el parámetro company es obligatorio",
+		MsgDiffParamsRequired:     "los parámetros from y to son obligatorios",
+		MsgCompareParamsRequired:  "los parámetros a y b son obligatorios",
+		MsgReportNotFound:         "Informe no encontrado",
+		MsgReportNotReplayable:    "El informe no tiene entradas guardadas para reproducir",
+		MsgInternalError:          "Error interno: %s",
+		MsgAdminDisabled:          "Los endpoints de administración están desactivados; defina API_KEY para activarlos",
+		MsgInvalidAPIKey:          "Clave de API no válida o ausente",
+		MsgProfileForbidden:       "El perfil de salida %q revela más que el predeterminado y requiere una clave de API",
+	},
+}
+
+// Message renders the message for code in lang, falling back to English and
+// then to the code itself
+func Message(lang, code string, args ...any) string {
+	template, ok := messageCatalog[lang][code]
+	if !ok {
+		if template, ok = messageCatalog[DefaultLanguage][code]; !ok {
+			return code
+		}
+	}
+	if len(args) == 0 {
+		return template
+	}
+	return fmt.Sprintf(template, args...)
+}
+
+// NegotiateLanguage picks the supported language best matching an explicit
+// choice or an Accept-Language header, honoring quality values. Region
+// subtags match their base language ("es-MX" selects "es").
+func NegotiateLanguage(explicit, acceptLanguage string) string {
+	if lang := baseLanguage(explicit); messageCatalog[lang] != nil {
+		return lang
+	}
+
+	type candidate struct {
+		lang    string
+		quality float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if lang := baseLanguage(tag); messageCatalog[lang] != nil && quality > 0 {
+			candidates = append(candidates, candidate{lang: lang, quality: quality})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	if len(candidates) > 0 {
+		return candidates[0].lang
+	}
+	return DefaultLanguage
+}
+
+// baseLanguage lowercases a language tag and strips its region
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	return strings.ToLower(base)
+}
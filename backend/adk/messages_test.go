@@ -0,0 +1,63 @@
+package adk
+
+import "testing"
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		lang string
+		code string
+		args []any
+		want string
+	}{
+		{name: "English", lang: LanguageEnglish, code: MsgInvalidRequestBody, want: "Invalid request body"},
+		{name: "Spanish", lang: LanguageSpanish, code: MsgInvalidRequestBody, want: "Cuerpo de la solicitud no válido"},
+		{name: "Arguments", lang: LanguageSpanish, code: MsgBatchSize, args: []any{100}, want: "Un lote debe contener entre 1 y 100 elementos"},
+		{name: "Unknown language falls back to English", lang: "fr", code: MsgReportNotFound, want: "Report not found"},
+		{name: "Unknown code", lang: LanguageEnglish, code: "no_such_code", want: "no_such_code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Message(tt.lang, tt.code, tt.args...); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMessageCatalog_Complete(t *testing.T) {
+	for lang, messages := range messageCatalog {
+		for code := range messageCatalog[DefaultLanguage] {
+			if _, ok := messages[code]; !ok {
+				t.Errorf("Expected %s to translate %s", lang, code)
+			}
+		}
+	}
+}
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		explicit string
+		header   string
+		want     string
+	}{
+		{name: "Default", want: LanguageEnglish},
+		{name: "Header", header: "es", want: LanguageSpanish},
+		{name: "Region subtag", header: "es-MX,en;q=0.5", want: LanguageSpanish},
+		{name: "Quality order", header: "es;q=0.4, en;q=0.9", want: LanguageEnglish},
+		{name: "Unsupported skipped", header: "fr-FR, es;q=0.8", want: LanguageSpanish},
+		{name: "Explicit wins", explicit: "ES", header: "en", want: LanguageSpanish},
+		{name: "Unsupported explicit ignored", explicit: "de", header: "es", want: LanguageSpanish},
+		{name: "Zero quality excluded", header: "es;q=0", want: LanguageEnglish},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NegotiateLanguage(tt.explicit, tt.header); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package adk
+
+import (
+	"context"
+	"strings"
+)
+
+// Momentum values reported on CompetitorAnalysis
+const (
+	MomentumRising  = "Rising"
+	MomentumFalling = "Falling"
+	MomentumStable  = "Stable"
+)
+
+// heuristicMomentum guesses momentum from strength/weakness wording when no
+// history is available
+func heuristicMomentum(competitor CompetitorData) string {
+	for _, strength := range competitor.Strengths {
+		if strings.Contains(strings.ToLower(strength), "growth") {
+			return MomentumRising
+		}
+	}
+	for _, weakness := range competitor.Weaknesses {
+		lower := strings.ToLower(weakness)
+		if strings.Contains(lower, "declin") || strings.Contains(lower, "shrink") {
+			return MomentumFalling
+		}
+	}
+	return MomentumStable
+}
+
+// MomentumFromHistory compares each competitor's market share across the
+// latest two reports in history and returns its trend. Competitors absent
+// from either report are omitted.
+func MomentumFromHistory(history []*CompetitorReport) map[string]string {
+	if len(history) < 2 {
+		return nil
+	}
+
+	ordered := append([]*CompetitorReport(nil), history...)
+	sortReports(ordered)
+	previous, latest := ordered[len(ordered)-2], ordered[len(ordered)-1]
+
+	prior := make(map[string]float64, len(previous.Competitors))
+	for _, analysis := range previous.Competitors {
+		prior[nameKey(analysis.CompetitorName)] = analysis.MarketShare
+	}
+
+	momentum := make(map[string]string, len(latest.Competitors))
+	for _, analysis := range latest.Competitors {
+		share, ok := prior[nameKey(analysis.CompetitorName)]
+		if !ok {
+			continue
+		}
+		switch {
+		case analysis.MarketShare > share:
+			momentum[analysis.CompetitorName] = MomentumRising
+		case analysis.MarketShare < share:
+			momentum[analysis.CompetitorName] = MomentumFalling
+		default:
+			momentum[analysis.CompetitorName] = MomentumStable
+		}
+	}
+	return momentum
+}
+
+// skipSaveKey is the context key marking a run that must not be stored
+type skipSaveKey struct{}
+
+// WithoutSaving returns a context that makes Run read stored history for
+// momentum but not save the new report, so it gets no report ID
+func WithoutSaving(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipSaveKey{}, true)
+}
+
+// recordHistory sets momentum from the previous stored run for the same
+// company, keeping heuristics for unmatched competitors, then saves the report
+// along with the inputs it was generated from unless the run opted out
+func (a *CompetitorIntelligenceAgent) recordHistory(ctx context.Context, report *CompetitorReport, inputs *ReportInputs) error {
+	history, err := a.store.List(ctx, report.TargetCompany)
+	if err != nil {
+		return err
+	}
+
+	trends := MomentumFromHistory(append(history, report))
+	applyMomentum(report, trends)
+	if skip, _ := ctx.Value(skipSaveKey{}).(bool); skip {
+		return nil
+	}
+
+	stored := *report
+	if inputs != nil {
+		recorded := *inputs
+		recorded.Momentum = trends
+		stored.Inputs = &recorded
+	}
+	if err := a.store.Save(ctx, &stored); err != nil {
+		return err
+	}
+	report.ID = stored.ID
+	return nil
+}
+
+// applyMomentum overrides analyses' momentum with the given trends
+func applyMomentum(report *CompetitorReport, trends map[string]string) {
+	for i := range report.Competitors {
+		if trend, ok := trends[report.Competitors[i].CompetitorName]; ok {
+			report.Competitors[i].Momentum = trend
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package adk
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestMomentumFromHistory tests that a share increase between runs yields Rising
+func TestMomentumFromHistory(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []*CompetitorReport{
+		{
+			ID:            "later",
+			GeneratedAt:   base.Add(24 * time.Hour),
+			TargetCompany: "TestCorp",
+			Competitors: []CompetitorAnalysis{
+				{CompetitorName: "Competitor A", MarketShare: 22},
+				{CompetitorName: "Competitor B", MarketShare: 15},
+				{CompetitorName: "Competitor C", MarketShare: 12},
+				{CompetitorName: "Newcomer", MarketShare: 3},
+			},
+		},
+		{
+			ID:            "earlier",
+			GeneratedAt:   base,
+			TargetCompany: "TestCorp",
+			Competitors: []CompetitorAnalysis{
+				{CompetitorName: "Competitor A", MarketShare: 18},
+				{CompetitorName: "Competitor B", MarketShare: 17},
+				{CompetitorName: "Competitor C", MarketShare: 12},
+			},
+		},
+	}
+
+	momentum := MomentumFromHistory(history)
+
+	want := map[string]string{
+		"Competitor A": MomentumRising,
+		"Competitor B": MomentumFalling,
+		"Competitor C": MomentumStable,
+	}
+	for name, trend := range want {
+		if momentum[name] != trend {
+			t.Errorf("%s momentum = %s, want %s", name, momentum[name], trend)
+		}
+	}
+
+	if _, ok := momentum["Newcomer"]; ok {
+		t.Error("Expected no historical momentum for a competitor without prior data")
+	}
+
+	if MomentumFromHistory(history[:1]) != nil {
+		t.Error("Expected nil momentum with a single report")
+	}
+}
+
+// TestRun_MomentumFromStoredReports tests that Run uses stored history over heuristics
+func TestRun_MomentumFromStoredReports(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryReportStore()
+
+	// A prior run where Competitor A held less share than the stub data reports
+	prior := &CompetitorReport{
+		GeneratedAt:   time.Now().Add(-24 * time.Hour),
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{
+			{CompetitorName: "Competitor A", MarketShare: 20},
+		},
+	}
+	if err := store.Save(ctx, prior); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(store))
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, analysis := range report.Competitors {
+		switch analysis.CompetitorName {
+		case "Competitor A":
+			if analysis.Momentum != MomentumRising {
+				t.Errorf("Competitor A momentum = %s, want Rising", analysis.Momentum)
+			}
+		case "Competitor B":
+			// No history, so the "Fast growth" heuristic applies
+			if analysis.Momentum != MomentumRising {
+				t.Errorf("Competitor B heuristic momentum = %s, want Rising", analysis.Momentum)
+			}
+		case "Competitor C":
+			if analysis.Momentum != MomentumStable {
+				t.Errorf("Competitor C heuristic momentum = %s, want Stable", analysis.Momentum)
+			}
+		}
+	}
+
+	if report.ID == "" {
+		t.Error("Expected Run to persist the report and assign an ID")
+	}
+
+	stored, err := store.List(ctx, "TestCorp")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(stored) != 2 {
+		t.Errorf("Expected 2 stored reports, got %d", len(stored))
+	}
+}
@@ -0,0 +1,185 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// MultiDataSource queries several sources and merges their results. Sources
+// are listed in priority order: the first source to supply a scalar field
+// wins any conflict, while list fields are unioned across all sources.
+type MultiDataSource struct {
+	Sources []DataSource
+
+	// ShareWeights, when set, merges market share as the weighted mean of
+	// every source's value instead of taking the first. Weights are keyed by
+	// source name; unlisted sources weigh 1 and non-positive weights exclude
+	// a source.
+	ShareWeights map[string]float64
+}
+
+// NewMultiDataSource creates a merged source from sources in priority order
+func NewMultiDataSource(sources ...DataSource) *MultiDataSource {
+	return &MultiDataSource{Sources: sources}
+}
+
+// Name identifies the source by its members
+func (m *MultiDataSource) Name() string {
+	names := make([]string, len(m.Sources))
+	for i, source := range m.Sources {
+		names[i] = source.Name()
+	}
+	return "multi(" + strings.Join(names, ",") + ")"
+}
+
+// FetchCompetitors queries every source and merges competitors by name.
+// A failing source is skipped; an error is returned only if all fail.
+func (m *MultiDataSource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	var (
+		merged  []CompetitorData
+		index   = make(map[string]int)
+		samples = make(map[int][]shareSample)
+		errs    []error
+	)
+
+	for _, source := range m.Sources {
+		found, err := source.FetchCompetitors(ctx, companyName, industry)
+		if err != nil {
+			log.Printf("data source %s failed: %v", source.Name(), err)
+			errs = append(errs, fmt.Errorf("%s: %w", source.Name(), err))
+			continue
+		}
+
+		for _, competitor := range found {
+			name := source.Name()
+			if competitor.Source != "" {
+				name = competitor.Source
+			}
+
+			key := nameKey(competitor.Name)
+			i, seen := index[key]
+			if !seen {
+				index[key] = len(merged)
+				merged = append(merged, CompetitorData{Name: competitor.Name, Source: name, FieldSources: map[string]string{}})
+				i = index[key]
+			}
+			mergeCompetitor(&merged[i], competitor, name)
+			if m.ShareWeights != nil && competitor.MarketShare != 0 {
+				samples[i] = append(samples[i], shareSample{source: name, share: competitor.MarketShare, weight: m.shareWeight(name)})
+			}
+		}
+	}
+
+	for i, shares := range samples {
+		weightedShare(&merged[i], shares)
+	}
+
+	if len(errs) > 0 && len(errs) == len(m.Sources) {
+		return nil, errors.Join(errs...)
+	}
+	return merged, nil
+}
+
+// mergeCompetitor folds from into into, recording source for every field
+// that from supplies first
+func mergeCompetitor(into *CompetitorData, from CompetitorData, source string) {
+	setString := func(field string, dst *string, value string) {
+		if *dst == "" && value != "" {
+			*dst = value
+			into.FieldSources[field] = source
+		}
+	}
+	setFloat := func(field string, dst *float64, value float64) {
+		if *dst == 0 && value != 0 {
+			*dst = value
+			into.FieldSources[field] = source
+		}
+	}
+	setInt := func(field string, dst *int, value int) {
+		if *dst == 0 && value != 0 {
+			*dst = value
+			into.FieldSources[field] = source
+		}
+	}
+	setTime := func(field string, dst *time.Time, value time.Time) {
+		if dst.IsZero() && !value.IsZero() {
+			*dst = value
+			into.FieldSources[field] = source
+		}
+	}
+	union := func(field string, dst *[]string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		*dst = unionStrings(*dst, values)
+		into.FieldSources[field] = joinSources(into.FieldSources[field], source)
+	}
+
+	setString("website", &into.Website, from.Website)
+	setString("industry", &into.Industry, from.Industry)
+	setString("pricing", &into.Pricing, from.Pricing)
+	setString("screenshot_url", &into.ScreenshotURL, from.ScreenshotURL)
+	setString("category", &into.Category, from.Category)
+	setFloat("market_share", &into.MarketShare, from.MarketShare)
+	setFloat("funding", &into.Funding, from.Funding)
+	setFloat("price", &into.Price, from.Price)
+	setFloat("price_max", &into.PriceMax, from.PriceMax)
+	setFloat("growth_rate", &into.GrowthRate, from.GrowthRate)
+	setFloat("confidence", &into.Confidence, from.Confidence)
+	setInt("customers", &into.Customers, from.Customers)
+	setTime("retrieved_at", &into.RetrievedAt, from.RetrievedAt)
+
+	union("products", &into.Products, from.Products)
+	union("strengths", &into.Strengths, from.Strengths)
+	union("weaknesses", &into.Weaknesses, from.Weaknesses)
+	union("tech_stack", &into.TechStack, from.TechStack)
+	union("notes", &into.Notes, from.Notes)
+	union("aliases", &into.Aliases, from.Aliases)
+	union("industries", &into.Industries, from.Industries)
+
+	if len(from.ObservedAt) > 0 {
+		into.ObservedAt = mergeObservedAt(into.ObservedAt, from.ObservedAt)
+		into.FieldSources["observed_at"] = joinSources(into.FieldSources["observed_at"], source)
+	}
+
+	if len(from.Relationships) > 0 {
+		into.Relationships = mergeRelationships(into.Relationships, from.Relationships)
+		into.FieldSources["relationships"] = joinSources(into.FieldSources["relationships"], source)
+	}
+
+	if len(from.KeyPeople) > 0 {
+		for _, person := range from.KeyPeople {
+			if !hasPerson(into.KeyPeople, person.Name) {
+				into.KeyPeople = append(into.KeyPeople, person)
+			}
+		}
+		into.FieldSources["key_people"] = joinSources(into.FieldSources["key_people"], source)
+	}
+}
+
+// hasPerson reports whether people already includes name
+func hasPerson(people []Person, name string) bool {
+	for _, person := range people {
+		if nameKey(person.Name) == nameKey(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinSources appends source to a comma-separated source list
+func joinSources(sources, source string) string {
+	if sources == "" {
+		return source
+	}
+	for _, existing := range strings.Split(sources, ",") {
+		if existing == source {
+			return sources
+		}
+	}
+	return sources + "," + source
+}
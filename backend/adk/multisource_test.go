@@ -0,0 +1,80 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// staticSource returns fixed records or a fixed error
+type staticSource struct {
+	name    string
+	records []CompetitorData
+	err     error
+}
+
+func (s staticSource) Name() string { return s.name }
+
+func (s staticSource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	return s.records, s.err
+}
+
+func TestMultiDataSource_Precedence(t *testing.T) {
+	primary := staticSource{name: "analyst", records: []CompetitorData{
+		{Name: "Acme", MarketShare: 22, Strengths: []string{"Brand"}},
+	}}
+	secondary := staticSource{name: "crawler", records: []CompetitorData{
+		{Name: "acme", MarketShare: 35, Website: "https://acme.example", Strengths: []string{"Brand", "Price"}},
+		{Name: "Globex", MarketShare: 8},
+	}}
+
+	merged, err := NewMultiDataSource(primary, secondary).FetchCompetitors(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("FetchCompetitors() error = %v", err)
+	}
+	if len(merged) != 2 {
+		t.Fatalf("Expected 2 merged competitors, got %d", len(merged))
+	}
+
+	acme := merged[0]
+	if acme.MarketShare != 22 {
+		t.Errorf("Expected higher-priority market share 22, got %v", acme.MarketShare)
+	}
+	if acme.Website != "https://acme.example" {
+		t.Errorf("Expected website filled from lower-priority source, got %q", acme.Website)
+	}
+	if len(acme.Strengths) != 2 {
+		t.Errorf("Expected unioned strengths, got %v", acme.Strengths)
+	}
+
+	wantSources := map[string]string{
+		"market_share": "analyst",
+		"website":      "crawler",
+		"strengths":    "analyst,crawler",
+	}
+	for field, source := range wantSources {
+		if acme.FieldSources[field] != source {
+			t.Errorf("Expected %s from %q, got %q", field, source, acme.FieldSources[field])
+		}
+	}
+	if merged[1].Source != "crawler" {
+		t.Errorf("Expected Globex sourced from crawler, got %q", merged[1].Source)
+	}
+}
+
+func TestMultiDataSource_Failures(t *testing.T) {
+	down := staticSource{name: "down", err: errors.New("timeout")}
+	up := staticSource{name: "up", records: []CompetitorData{{Name: "Acme", MarketShare: 10}}}
+
+	merged, err := NewMultiDataSource(down, up).FetchCompetitors(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Expected a failing source to be skipped, got %v", err)
+	}
+	if len(merged) != 1 || merged[0].FieldSources["market_share"] != "up" {
+		t.Errorf("Expected data from the healthy source, got %+v", merged)
+	}
+
+	if _, err := NewMultiDataSource(down, down).FetchCompetitors(context.Background(), "TestCorp", "SaaS"); err == nil {
+		t.Error("Expected an error when every source fails")
+	}
+}
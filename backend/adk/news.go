@@ -0,0 +1,195 @@
+package adk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultNewsLimit is how many headlines are kept per competitor
+const defaultNewsLimit = 3
+
+// News sentiment tags
+const (
+	SentimentPositive = "positive"
+	SentimentNegative = "negative"
+	SentimentNeutral  = "neutral"
+)
+
+// Headline keywords suggesting good or bad news for a competitor
+var (
+	positiveNewsWords = []string{"raises", "funding", "launch", "growth", "expands", "record", "partnership", "acquires", "wins", "surge"}
+	negativeNewsWords = []string{"layoff", "lawsuit", "breach", "outage", "decline", "cuts", "loses", "recall", "investigation", "shuts"}
+)
+
+// NewsItem is a recent headline about a competitor
+type NewsItem struct {
+	Title       string    `json:"title"`
+	Link        string    `json:"link,omitempty"`
+	PublishedAt time.Time `json:"published_at,omitempty"`
+	Sentiment   string    `json:"sentiment"`
+}
+
+// NewsEnricher attaches recent headlines from an RSS feed. The feed is
+// called as GET <FeedURL>?q=<competitor name> and must answer with RSS 2.0.
+type NewsEnricher struct {
+	FeedURL string
+	Timeout time.Duration
+	// Limit caps headlines per competitor (defaultNewsLimit when zero)
+	Limit  int
+	Client *http.Client
+}
+
+// NewNewsEnricher creates an enricher for the given feed endpoint
+func NewNewsEnricher(feedURL string, timeout time.Duration, limit int) *NewsEnricher {
+	return &NewsEnricher{
+		FeedURL: feedURL,
+		Timeout: timeout,
+		Limit:   limit,
+		Client:  http.DefaultClient,
+	}
+}
+
+// Name identifies the enrichment step
+func (e *NewsEnricher) Name() string {
+	return "news"
+}
+
+// Enrich sets RecentNews for each competitor, newest first. Individual feed
+// failures are skipped; the last one is returned for logging.
+func (e *NewsEnricher) Enrich(ctx context.Context, data []CompetitorData) error {
+	limit := e.Limit
+	if limit <= 0 {
+		limit = defaultNewsLimit
+	}
+
+	var lastErr error
+	for i := range data {
+		items, err := e.fetch(ctx, data[i].Name)
+		if err != nil {
+			lastErr = fmt.Errorf("%s: %w", data[i].Name, err)
+			continue
+		}
+		sort.SliceStable(items, func(x, y int) bool {
+			return items[x].PublishedAt.After(items[y].PublishedAt)
+		})
+		if len(items) > limit {
+			items = items[:limit]
+		}
+		data[i].RecentNews = items
+	}
+	return lastErr
+}
+
+// rssFeed is the subset of RSS 2.0 the enricher reads
+type rssFeed struct {
+	Items []struct {
+		Title   string `xml:"title"`
+		Link    string `xml:"link"`
+		PubDate string `xml:"pubDate"`
+	} `xml:"channel>item"`
+}
+
+// fetch requests and parses the feed for one competitor
+func (e *NewsEnricher) fetch(ctx context.Context, name string) ([]NewsItem, error) {
+	if e.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.Timeout)
+		defer cancel()
+	}
+
+	endpoint, err := url.Parse(e.FeedURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid news feed URL: %w", err)
+	}
+	query := endpoint.Query()
+	query.Set("q", name)
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("news feed returned status %d", resp.StatusCode)
+	}
+
+	var feed rssFeed
+	if err := xml.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("invalid news feed: %w", err)
+	}
+
+	items := make([]NewsItem, 0, len(feed.Items))
+	for _, entry := range feed.Items {
+		title := strings.TrimSpace(entry.Title)
+		if title == "" {
+			continue
+		}
+		item := NewsItem{Title: title, Link: strings.TrimSpace(entry.Link), Sentiment: headlineSentiment(title)}
+		if published, err := time.Parse(time.RFC1123Z, strings.TrimSpace(entry.PubDate)); err == nil {
+			item.PublishedAt = published
+		} else if published, err := time.Parse(time.RFC1123, strings.TrimSpace(entry.PubDate)); err == nil {
+			item.PublishedAt = published
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// headlineSentiment tags a headline by its positive and negative keywords
+func headlineSentiment(title string) string {
+	lower := strings.ToLower(title)
+	score := 0
+	for _, word := range positiveNewsWords {
+		if strings.Contains(lower, word) {
+			score++
+		}
+	}
+	for _, word := range negativeNewsWords {
+		if strings.Contains(lower, word) {
+			score--
+		}
+	}
+	switch {
+	case score > 0:
+		return SentimentPositive
+	case score < 0:
+		return SentimentNegative
+	default:
+		return SentimentNeutral
+	}
+}
+
+// newsMomentum reads momentum from the balance of headline sentiment, or
+// returns "" when the news is absent or evenly balanced
+func newsMomentum(news []NewsItem) string {
+	balance := 0
+	for _, item := range news {
+		switch item.Sentiment {
+		case SentimentPositive:
+			balance++
+		case SentimentNegative:
+			balance--
+		}
+	}
+	switch {
+	case balance > 0:
+		return MomentumRising
+	case balance < 0:
+		return MomentumFalling
+	default:
+		return ""
+	}
+}
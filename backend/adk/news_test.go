@@ -0,0 +1,126 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newsFeed serves RSS headlines per competitor name from the q parameter
+func newsFeed(t *testing.T, headlines map[string][]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		titles, ok := headlines[r.URL.Query().Get("q")]
+		if !ok {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprint(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>News</title>`)
+		published := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		for i, title := range titles {
+			fmt.Fprintf(w, `<item><title>%s</title><link>https://news.example/%d</link><pubDate>%s</pubDate></item>`,
+				title, i, published.AddDate(0, 0, i).Format(time.RFC1123Z))
+		}
+		fmt.Fprint(w, `</channel></rss>`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestNewsEnricher tests that headlines populate newest first, capped and tagged
+func TestNewsEnricher(t *testing.T) {
+	feed := newsFeed(t, map[string][]string{
+		"Acme": {"Acme faces lawsuit", "Acme announces quarterly results", "Acme raises Series C funding", "Acme expands to Europe"},
+	})
+
+	data := []CompetitorData{{Name: "Acme"}, {Name: "Offline"}}
+	err := NewNewsEnricher(feed.URL, time.Second, 3).Enrich(context.Background(), data)
+	if err == nil {
+		t.Errorf("Expected the failing feed to be reported for logging")
+	}
+
+	news := data[0].RecentNews
+	if len(news) != 3 {
+		t.Fatalf("Expected 3 headlines, got %+v", news)
+	}
+	wantTitles := []string{"Acme expands to Europe", "Acme raises Series C funding", "Acme announces quarterly results"}
+	wantSentiments := []string{SentimentPositive, SentimentPositive, SentimentNeutral}
+	for i, item := range news {
+		if item.Title != wantTitles[i] || item.Sentiment != wantSentiments[i] {
+			t.Errorf("Item %d: expected %q (%s), got %q (%s)", i, wantTitles[i], wantSentiments[i], item.Title, item.Sentiment)
+		}
+		if item.PublishedAt.IsZero() || item.Link == "" {
+			t.Errorf("Item %d: expected link and publish date, got %+v", i, item)
+		}
+	}
+
+	if data[1].RecentNews != nil {
+		t.Errorf("Expected no news for a failed feed, got %+v", data[1].RecentNews)
+	}
+}
+
+// TestNewsEnricher_Momentum tests that headline sentiment drives momentum
+func TestNewsEnricher_Momentum(t *testing.T) {
+	feed := newsFeed(t, map[string][]string{
+		"Riser":   {"Riser raises $50M", "Riser launches new platform"},
+		"Faller":  {"Faller announces layoffs", "Faller hit by data breach"},
+		"Grower":  {"Grower hit by outage"},
+		"Neutral": {"Neutral names new CFO"},
+	})
+
+	agent := NewCompetitorIntelligenceAgent(WithEnricher(NewNewsEnricher(feed.URL, time.Second, 0)))
+	data := []CompetitorData{
+		{Name: "Riser", MarketShare: 10},
+		{Name: "Faller", MarketShare: 10},
+		{Name: "Grower", MarketShare: 10, GrowthRate: 80},
+		{Name: "Neutral", MarketShare: 10},
+	}
+	agent.enrich(context.Background(), data)
+
+	analyses, err := agent.Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	want := map[string]string{
+		"Riser":   MomentumRising,
+		"Faller":  MomentumFalling,
+		"Grower":  MomentumRising, // growth rate outranks news
+		"Neutral": MomentumStable,
+	}
+	for _, analysis := range analyses {
+		if analysis.Momentum != want[analysis.CompetitorName] {
+			t.Errorf("%s: expected momentum %s, got %s", analysis.CompetitorName, want[analysis.CompetitorName], analysis.Momentum)
+		}
+		if len(analysis.RecentNews) == 0 {
+			t.Errorf("%s: expected recent news on the analysis", analysis.CompetitorName)
+		}
+	}
+}
+
+// TestNewsEnricher_Timeout tests that a slow feed is abandoned
+func TestNewsEnricher_Timeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer slow.Close()
+
+	data := []CompetitorData{{Name: "Acme"}}
+	start := time.Now()
+	if err := NewNewsEnricher(slow.URL, 50*time.Millisecond, 0).Enrich(context.Background(), data); err == nil {
+		t.Errorf("Expected a timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the feed to be abandoned after its timeout, took %v", elapsed)
+	}
+	if data[0].RecentNews != nil {
+		t.Errorf("Expected no news after a timeout, got %+v", data[0].RecentNews)
+	}
+}
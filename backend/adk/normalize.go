@@ -0,0 +1,101 @@
+package adk
+
+import "strings"
+
+// NormalizeCompetitors resolves aliases to canonical names and merges
+// duplicate entries. The first occurrence of a competitor wins for scalar
+// fields, list fields are unioned, every non-canonical name observed is
+// kept in Aliases and every industry it was found in is kept in Industries.
+// With fuzzy matching on, a name also merges into the most similar earlier
+// competitor at or above the similarity threshold.
+func (a *CompetitorIntelligenceAgent) NormalizeCompetitors(data []CompetitorData) []CompetitorData {
+	var normalized []CompetitorData
+	index := make(map[string]int)
+
+	for _, competitor := range data {
+		observed := competitor.Name
+		competitor.Name = a.canonicalName(observed)
+		key := nameKey(competitor.Name)
+
+		i, seen := index[key]
+		if !seen {
+			if i = a.fuzzyMatch(competitor.Name, normalized); i >= 0 {
+				seen = true
+				index[key] = i
+			}
+		}
+		if !seen {
+			competitor.Aliases = append([]string(nil), competitor.Aliases...)
+			if observed != competitor.Name {
+				competitor.Aliases = appendUnique(competitor.Aliases, observed)
+			}
+			competitor.Industries = append([]string(nil), competitor.Industries...)
+			competitor.ObservedAt = mergeObservedAt(nil, competitor.ObservedAt)
+			if competitor.Industry != "" {
+				competitor.Industries = appendUnique(competitor.Industries, competitor.Industry)
+			}
+			index[key] = len(normalized)
+			normalized = append(normalized, competitor)
+			continue
+		}
+
+		merged := &normalized[i]
+		if observed != merged.Name {
+			merged.Aliases = appendUnique(merged.Aliases, observed)
+		}
+		for _, alias := range competitor.Aliases {
+			merged.Aliases = appendUnique(merged.Aliases, alias)
+		}
+		merged.Industries = unionStrings(merged.Industries, competitor.Industries)
+		if competitor.Industry != "" {
+			merged.Industries = appendUnique(merged.Industries, competitor.Industry)
+		}
+		merged.Products = unionStrings(merged.Products, competitor.Products)
+		merged.Strengths = unionStrings(merged.Strengths, competitor.Strengths)
+		merged.Weaknesses = unionStrings(merged.Weaknesses, competitor.Weaknesses)
+		merged.TechStack = unionStrings(merged.TechStack, competitor.TechStack)
+		merged.Notes = unionStrings(merged.Notes, competitor.Notes)
+		merged.Relationships = mergeRelationships(merged.Relationships, competitor.Relationships)
+		merged.ObservedAt = mergeObservedAt(merged.ObservedAt, competitor.ObservedAt)
+		if merged.Category == "" {
+			merged.Category = competitor.Category
+		}
+	}
+
+	return normalized
+}
+
+// canonicalName maps a competitor name through the configured aliases
+func (a *CompetitorIntelligenceAgent) canonicalName(name string) string {
+	key := nameKey(name)
+	for alias, canonical := range a.Config.Aliases {
+		if nameKey(alias) == key {
+			return canonical
+		}
+	}
+	return strings.TrimSpace(name)
+}
+
+// nameKey is the case- and whitespace-insensitive form used to compare names
+func nameKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// appendUnique appends value unless an equal (case-insensitive) entry exists
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if strings.EqualFold(existing, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// unionStrings merges two lists preserving first-seen order
+func unionStrings(a, b []string) []string {
+	out := append([]string(nil), a...)
+	for _, value := range b {
+		out = appendUnique(out, value)
+	}
+	return out
+}
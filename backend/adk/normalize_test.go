@@ -0,0 +1,67 @@
+package adk
+
+import "testing"
+
+// TestNormalizeCompetitors_Aliases tests that aliases merge under a canonical name
+func TestNormalizeCompetitors_Aliases(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithAliases(map[string]string{
+		"Facebook":       "Meta",
+		"Meta Platforms": "Meta",
+	}))
+
+	data := []CompetitorData{
+		{Name: "Facebook", MarketShare: 30, Strengths: []string{"Reach"}, Products: []string{"Feed"}},
+		{Name: "Google", MarketShare: 28, Strengths: []string{"Search"}},
+		{Name: "meta", MarketShare: 31, Strengths: []string{"Reach", "VR"}, Products: []string{"Quest"}},
+		{Name: "Meta Platforms", Weaknesses: []string{"Privacy"}},
+	}
+
+	normalized := agent.NormalizeCompetitors(data)
+
+	if len(normalized) != 2 {
+		t.Fatalf("Expected 2 competitors after normalization, got %d", len(normalized))
+	}
+
+	meta := normalized[0]
+	if meta.Name != "Meta" {
+		t.Errorf("Expected canonical name 'Meta', got '%s'", meta.Name)
+	}
+
+	if meta.MarketShare != 30 {
+		t.Errorf("Expected first-seen market share 30, got %v", meta.MarketShare)
+	}
+
+	wantAliases := []string{"Facebook", "meta", "Meta Platforms"}
+	if len(meta.Aliases) != len(wantAliases) {
+		t.Fatalf("Expected aliases %v, got %v", wantAliases, meta.Aliases)
+	}
+	for i, alias := range wantAliases {
+		if meta.Aliases[i] != alias {
+			t.Errorf("Alias %d = %s, want %s", i, meta.Aliases[i], alias)
+		}
+	}
+
+	if len(meta.Strengths) != 2 || len(meta.Products) != 2 || len(meta.Weaknesses) != 1 {
+		t.Errorf("Expected unioned lists, got strengths=%v products=%v weaknesses=%v", meta.Strengths, meta.Products, meta.Weaknesses)
+	}
+
+	if normalized[1].Name != "Google" || len(normalized[1].Aliases) != 0 {
+		t.Errorf("Expected Google untouched, got %+v", normalized[1])
+	}
+}
+
+// TestNormalizeCompetitors_NoAliases tests that unaliased data passes through
+func TestNormalizeCompetitors_NoAliases(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	data := []CompetitorData{
+		{Name: "Facebook"},
+		{Name: "Meta"},
+	}
+
+	normalized := agent.NormalizeCompetitors(data)
+
+	if len(normalized) != 2 {
+		t.Fatalf("Expected 2 competitors without aliases, got %d", len(normalized))
+	}
+}
@@ -0,0 +1,53 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNotes_RoundTrip(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	ctx := WithCompetitorData(context.Background(), []CompetitorData{
+		{Name: "Noted Corp", MarketShare: 22, Notes: []string{"Met their CEO at SaaStr", "Rumored layoffs in Q3"}},
+		{Name: "Quiet Corp", MarketShare: 8},
+	})
+
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	out, err := report.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	var decoded CompetitorReport
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	notes := map[string][]string{}
+	for _, analysis := range decoded.Competitors {
+		notes[analysis.CompetitorName] = analysis.Notes
+	}
+	if got := strings.Join(notes["Noted Corp"], "|"); got != "Met their CEO at SaaStr|Rumored layoffs in Q3" {
+		t.Errorf("Expected notes to round-trip, got %q", got)
+	}
+	if len(notes["Quiet Corp"]) != 0 {
+		t.Errorf("Expected no notes for Quiet Corp, got %v", notes["Quiet Corp"])
+	}
+
+	markdown := decoded.ToMarkdown(FormatOptions{})
+	if !strings.Contains(markdown, "**Analyst notes**\n\n- Met their CEO at SaaStr\n- Rumored layoffs in Q3\n") {
+		t.Errorf("Expected notes in Markdown, got:\n%s", markdown)
+	}
+}
+
+func TestValidateCompetitors_EmptyNote(t *testing.T) {
+	err := ValidateCompetitors([]CompetitorData{{Name: "A", Notes: []string{"ok", " "}}})
+	if err == nil || !strings.Contains(err.Error(), "[0].notes[1]") {
+		t.Errorf("Expected an error locating the empty note, got %v", err)
+	}
+}
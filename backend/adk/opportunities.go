@@ -0,0 +1,121 @@
+package adk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// anyIndustry keys opportunity weights that apply in every industry
+const anyIndustry = "*"
+
+// PrioritizedOpportunity scores an opportunity by how many competitors share
+// the weakness behind it and how strategically relevant that weakness is
+type PrioritizedOpportunity struct {
+	Opportunity string  `json:"opportunity"`
+	Weakness    string  `json:"weakness"`
+	Priority    float64 `json:"priority"`
+}
+
+// ParseOpportunityWeights parses "industry=keyword:weight,...;..." entries
+// such as "SaaS=support:3,onboarding:2;*=security:1.5", where "*" applies
+// to every industry. Keywords match weaknesses case-insensitively.
+func ParseOpportunityWeights(spec string) (map[string]map[string]float64, error) {
+	weights := make(map[string]map[string]float64)
+	for _, entry := range strings.Split(spec, ";") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		industry, values, ok := strings.Cut(entry, "=")
+		industry = strings.TrimSpace(industry)
+		if !ok || industry == "" {
+			return nil, fmt.Errorf("%w: opportunity weights %q must be industry=keyword:weight,...", ErrInvalidInput, entry)
+		}
+
+		keywords := make(map[string]float64)
+		for _, pair := range strings.Split(values, ",") {
+			keyword, weight, ok := strings.Cut(pair, ":")
+			keyword = strings.ToLower(strings.TrimSpace(keyword))
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(weight), 64)
+			if !ok || keyword == "" || err != nil || parsed <= 0 {
+				return nil, fmt.Errorf("%w: opportunity weight %q must be keyword:weight with a positive weight", ErrInvalidInput, strings.TrimSpace(pair))
+			}
+			keywords[keyword] = parsed
+		}
+		weights[industry] = keywords
+	}
+	return weights, nil
+}
+
+// weaknessFrequency counts how many competitors list each weakness, keyed
+// case-insensitively
+func weaknessFrequency(data []CompetitorData) map[string]int {
+	frequency := make(map[string]int)
+	for _, competitor := range data {
+		seen := make(map[string]bool)
+		for _, weakness := range competitor.Weaknesses {
+			key := strings.ToLower(strings.TrimSpace(weakness))
+			if key != "" && !seen[key] {
+				seen[key] = true
+				frequency[key]++
+			}
+		}
+	}
+	return frequency
+}
+
+// opportunityWeight is the largest configured weight among keywords in
+// weakness for the competitor's industries, or 1 when none match
+func (a *CompetitorIntelligenceAgent) opportunityWeight(competitor CompetitorData, weakness string) float64 {
+	lower := strings.ToLower(weakness)
+	weight := 0.0
+	for industry, keywords := range a.Config.OpportunityWeights {
+		if !appliesToIndustry(industry, competitor) {
+			continue
+		}
+		for keyword, w := range keywords {
+			if strings.Contains(lower, keyword) && w > weight {
+				weight = w
+			}
+		}
+	}
+	if weight == 0 {
+		return 1
+	}
+	return weight
+}
+
+// appliesToIndustry reports whether weights keyed by industry cover any of
+// the competitor's industries
+func appliesToIndustry(industry string, competitor CompetitorData) bool {
+	industry = strings.TrimSpace(industry)
+	if industry == anyIndustry {
+		return true
+	}
+	for _, candidate := range append([]string{competitor.Industry}, competitor.Industries...) {
+		if candidate != "" && strings.EqualFold(industry, strings.TrimSpace(candidate)) {
+			return true
+		}
+	}
+	return false
+}
+
+// prioritizedOpportunities turns a competitor's weaknesses into
+// opportunities ordered by priority: the number of competitors sharing the
+// weakness times its strategic weight. Ties keep the weaknesses' order.
+func (a *CompetitorIntelligenceAgent) prioritizedOpportunities(competitor CompetitorData, frequency map[string]int) []PrioritizedOpportunity {
+	var opportunities []PrioritizedOpportunity
+	for _, weakness := range competitor.Weaknesses {
+		count := max(frequency[strings.ToLower(strings.TrimSpace(weakness))], 1)
+		opportunities = append(opportunities, PrioritizedOpportunity{
+			Opportunity: a.opportunityText(weakness),
+			Weakness:    weakness,
+			Priority:    round2(float64(count) * a.opportunityWeight(competitor, weakness)),
+		})
+	}
+	sort.SliceStable(opportunities, func(i, j int) bool {
+		return opportunities[i].Priority > opportunities[j].Priority
+	})
+	return opportunities
+}
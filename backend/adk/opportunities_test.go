@@ -0,0 +1,89 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseOpportunityWeights(t *testing.T) {
+	weights, err := ParseOpportunityWeights("SaaS=support:3, onboarding:2 ; *=Security:1.5")
+	if err != nil {
+		t.Fatalf("ParseOpportunityWeights() error = %v", err)
+	}
+	if weights["SaaS"]["support"] != 3 || weights["SaaS"]["onboarding"] != 2 {
+		t.Errorf("Expected SaaS weights, got %v", weights["SaaS"])
+	}
+	if weights[anyIndustry]["security"] != 1.5 {
+		t.Errorf("Expected lowercased keyword for every industry, got %v", weights[anyIndustry])
+	}
+
+	for _, spec := range []string{"support:3", "SaaS=support", "SaaS=support:0", "SaaS=:2", "=support:2"} {
+		if _, err := ParseOpportunityWeights(spec); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("Expected ErrInvalidInput for %q, got %v", spec, err)
+		}
+	}
+}
+
+func TestAnalyze_OpportunityPriorities(t *testing.T) {
+	data := []CompetitorData{
+		{Name: "Acme", Industry: "SaaS", Weaknesses: []string{"Limited integrations", "Slow support", "Legacy UI"}},
+		{Name: "Beta", Industry: "SaaS", Weaknesses: []string{"legacy ui"}},
+	}
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantOrder []string
+		wantTop   float64
+	}{
+		{
+			name:      "Frequency only",
+			wantOrder: []string{"Legacy UI", "Limited integrations", "Slow support"},
+			wantTop:   2,
+		},
+		{
+			name:      "Weighted keyword",
+			opts:      []Option{WithOpportunityWeights(map[string]map[string]float64{"SaaS": {"support": 3}})},
+			wantOrder: []string{"Slow support", "Legacy UI", "Limited integrations"},
+			wantTop:   3,
+		},
+		{
+			name:      "Other industry",
+			opts:      []Option{WithOpportunityWeights(map[string]map[string]float64{"Retail": {"support": 3}})},
+			wantOrder: []string{"Legacy UI", "Limited integrations", "Slow support"},
+			wantTop:   2,
+		},
+		{
+			name:      "Every industry",
+			opts:      []Option{WithOpportunityWeights(map[string]map[string]float64{anyIndustry: {"integration": 4}})},
+			wantOrder: []string{"Limited integrations", "Legacy UI", "Slow support"},
+			wantTop:   4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyses, err := NewCompetitorIntelligenceAgent(tt.opts...).Analyze(context.Background(), data)
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			priorities := analyses[0].OpportunityPriorities
+
+			if len(priorities) != len(tt.wantOrder) {
+				t.Fatalf("Expected %d opportunities, got %+v", len(tt.wantOrder), priorities)
+			}
+			for i, weakness := range tt.wantOrder {
+				if priorities[i].Weakness != weakness {
+					t.Errorf("Position %d: expected %q, got %q", i, weakness, priorities[i].Weakness)
+				}
+				if analyses[0].Opportunities[i] != priorities[i].Opportunity {
+					t.Errorf("Position %d: expected opportunities in priority order, got %q", i, analyses[0].Opportunities[i])
+				}
+			}
+			if priorities[0].Priority != tt.wantTop {
+				t.Errorf("Expected top priority %v, got %v", tt.wantTop, priorities[0].Priority)
+			}
+		})
+	}
+}
@@ -0,0 +1,671 @@
+package adk
+
+import (
+	"strings"
+	"time"
+)
+
+// Config holds the tunable settings used across the analysis pipeline
+type Config struct {
+	// IncludeScoreBreakdown attaches per-component threat score contributions
+	IncludeScoreBreakdown bool `json:"include_score_breakdown"`
+
+	// Aliases maps alternate competitor names to their canonical name
+	Aliases map[string]string `json:"aliases,omitempty"`
+
+	// PositioningRules is the decision table for composite positioning;
+	// when empty, positioning is derived from pricing alone
+	PositioningRules []PositioningRule `json:"positioning_rules,omitempty"`
+
+	// InferPositioning derives a provisional positioning from market share
+	// when pricing is unknown, instead of "Undifferentiated"
+	InferPositioning bool `json:"infer_positioning"`
+
+	// ValueThresholds tunes the overpriced/bargain value positioning
+	ValueThresholds ValueThresholds `json:"value_thresholds"`
+
+	// Verbosity controls how much detail opportunity/risk text carries
+	Verbosity Verbosity `json:"verbosity"`
+
+	// InsightsTemplate is the custom MarketInsights template source, if any
+	InsightsTemplate string `json:"insights_template,omitempty"`
+
+	// Formats holds per-format export options keyed by format name
+	Formats map[string]FormatOptions `json:"formats,omitempty"`
+
+	// RunRetries is how many times a failed run is re-executed in full
+	RunRetries int `json:"run_retries,omitempty"`
+
+	// RunRetryBackoff is the delay before the first retry; it doubles each attempt
+	RunRetryBackoff time.Duration `json:"run_retry_backoff,omitempty"`
+
+	// PartialResults returns completed stages' output when a later stage fails
+	PartialResults bool `json:"partial_results"`
+
+	// OrderBy sets how competitors are listed in reports (threat by default)
+	OrderBy Order `json:"order_by,omitempty"`
+
+	// HighThreatShare and MediumThreatShare are the market shares above which
+	// competitors are High and Medium threats (20 and 10 when zero)
+	HighThreatShare   float64 `json:"high_threat_share,omitempty"`
+	MediumThreatShare float64 `json:"medium_threat_share,omitempty"`
+
+	// ViabilityHHI and ViabilityTopShare are the concentration and top
+	// threat share above which reports advise reconsidering market entry
+	// (2500 and 50 when zero)
+	ViabilityHHI      float64 `json:"viability_hhi,omitempty"`
+	ViabilityTopShare float64 `json:"viability_top_share,omitempty"`
+
+	// MinThreatLevel drops competitors below this threat level from reports
+	MinThreatLevel string `json:"min_threat_level,omitempty"`
+
+	// TierCaps lists at most this many competitors per threat level, keeping
+	// the highest scored
+	TierCaps map[string]int `json:"tier_caps,omitempty"`
+
+	// RadarAxes selects the dimensions of radar chart exports
+	RadarAxes []string `json:"radar_axes,omitempty"`
+
+	// Features are compared across competitors in a feature matrix
+	Features []string `json:"features,omitempty"`
+
+	// MinRecommendations pads reports with generic advice up to this many
+	// generated recommendations (5 when zero)
+	MinRecommendations int `json:"min_recommendations,omitempty"`
+
+	// DefaultRecommendations are house recommendations appended to every report
+	DefaultRecommendations []string `json:"default_recommendations,omitempty"`
+
+	// WithRationale explains each recommendation with the analysis behind it
+	WithRationale bool `json:"with_rationale,omitempty"`
+
+	// PriceBands classifies numeric prices into pricing tiers, cheapest first
+	PriceBands []PriceBand `json:"price_bands,omitempty"`
+
+	// Persona tailors summaries and exports to a reader (general when empty)
+	Persona Persona `json:"persona,omitempty"`
+
+	// Tone phrases generated recommendations (neutral when empty)
+	Tone Tone `json:"tone,omitempty"`
+
+	// NormalizeShares rescales market shares to sum to 100 with others
+	NormalizeShares bool `json:"normalize_shares"`
+
+	// ShareFormat renders market shares as floats (default) or integers in exports
+	ShareFormat ShareFormat `json:"share_format,omitempty"`
+
+	// ScoreNormalization adds threat scores rescaled within each report
+	// (zscore or minmax) next to the raw scores
+	ScoreNormalization ScoreNormalization `json:"score_normalization,omitempty"`
+
+	// EmergingGrowthRate is the annual growth percent that flags an emerging
+	// threat (50 when zero)
+	EmergingGrowthRate float64 `json:"emerging_growth_rate,omitempty"`
+
+	// EmergingMaxShare is the market share ceiling for emerging players (10
+	// when zero); EmergingMinStrengths is how many recent strengths they
+	// need (2 when zero)
+	EmergingMaxShare     float64 `json:"emerging_max_share,omitempty"`
+	EmergingMinStrengths int     `json:"emerging_min_strengths,omitempty"`
+
+	// TimeBudget bounds a run; optional enrichment is skipped once it is spent
+	TimeBudget time.Duration `json:"time_budget,omitempty"`
+
+	// AnalysisCacheSize is how many Analyze results are cached by input hash
+	AnalysisCacheSize int `json:"analysis_cache_size,omitempty"`
+
+	// MinShareChange is how many share points a competitor must move for the
+	// report diff and change feed to surface it; threat level changes always
+	// surface (every change when zero)
+	MinShareChange float64 `json:"min_share_change,omitempty"`
+
+	// RedactionRules lists the fields each output profile blanks or masks
+	// (DefaultRedactionRules when nil)
+	RedactionRules map[string][]string `json:"redaction_rules,omitempty"`
+
+	// OutputProfile is the profile exports are redacted for when none is
+	// requested (no redaction when empty)
+	OutputProfile string `json:"output_profile,omitempty"`
+
+	// Relationships adds partner/parent/acquisition links to competitors,
+	// keyed by competitor name
+	Relationships map[string][]Relationship `json:"relationships,omitempty"`
+
+	// LargeCustomerBase is the customer count credited as a large installed
+	// base (10,000 when zero)
+	LargeCustomerBase int `json:"large_customer_base,omitempty"`
+
+	// ObservationHalfLife is how long until a dated strength or weakness
+	// counts half as much (180 days when zero)
+	ObservationHalfLife time.Duration `json:"observation_half_life,omitempty"`
+
+	// ThreatDecayAfter is the data age past which threat scores decay,
+	// halving every ThreatDecayHalfLife (180 days when zero); zero disables
+	// the decay
+	ThreatDecayAfter    time.Duration `json:"threat_decay_after,omitempty"`
+	ThreatDecayHalfLife time.Duration `json:"threat_decay_half_life,omitempty"`
+
+	// Highlights names competitors of interest, pinned to the top of reports
+	Highlights []string `json:"highlights,omitempty"`
+
+	// SampleSize caps how many competitors are analyzed (0 analyzes all);
+	// SampleMethod picks which are kept and SampleSeed seeds random methods
+	// (a fresh seed per run when zero)
+	SampleSize   int          `json:"sample_size,omitempty"`
+	SampleMethod SampleMethod `json:"sample_method,omitempty"`
+	SampleSeed   int64        `json:"sample_seed,omitempty"`
+
+	// FuzzyMatchThreshold merges competitors whose names are at least this
+	// similar (0-1, normalized Levenshtein); 0 turns fuzzy matching off.
+	// Higher values merge only closer names, trading recall for precision.
+	FuzzyMatchThreshold float64 `json:"fuzzy_match_threshold,omitempty"`
+
+	// TieBreaks settles equal threat scores when ranking, in order
+	// (DefaultTieBreaks when empty)
+	TieBreaks []TieBreak `json:"tie_breaks,omitempty"`
+
+	// IndustryBenchmarks holds typical pricing tier and share leader
+	// threshold per industry, keyed by industry name
+	IndustryBenchmarks map[string]IndustryBenchmark `json:"industry_benchmarks,omitempty"`
+
+	// IndustryLookup infers the industry of known companies when a request
+	// gives none
+	IndustryLookup IndustryLookup `json:"industry_lookup,omitempty"`
+
+	// OpportunityWeights ranks opportunities by keyword in the weakness
+	// behind them, keyed by industry ("*" for all) and then keyword
+	OpportunityWeights map[string]map[string]float64 `json:"opportunity_weights,omitempty"`
+
+	// CompetitorCategories classes competitors (direct, indirect, ...) by
+	// name when the data does not
+	CompetitorCategories map[string]string `json:"competitor_categories,omitempty"`
+
+	// Timezone is the IANA zone report timestamps are rendered in (UTC when empty)
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Option configures a CompetitorIntelligenceAgent
+type Option func(*CompetitorIntelligenceAgent)
+
+// WithScoreBreakdown toggles the ScoreBreakdown field on each analysis
+func WithScoreBreakdown(enabled bool) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.IncludeScoreBreakdown = enabled
+	}
+}
+
+// WithAliases sets the alias → canonical name mapping used during normalization
+func WithAliases(aliases map[string]string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.Aliases = aliases
+	}
+}
+
+// WithPositioningRules overrides the composite positioning decision table
+func WithPositioningRules(rules []PositioningRule) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.PositioningRules = rules
+	}
+}
+
+// WithCompositePositioning enables the built-in composite positioning rules
+func WithCompositePositioning() Option {
+	return WithPositioningRules(DefaultPositioningRules)
+}
+
+// WithInferredPositioning infers positioning from market share for
+// competitors with unknown pricing, flagging it as inferred
+func WithInferredPositioning() Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.InferPositioning = true
+	}
+}
+
+// WithValueThresholds tunes how pricing tier and strength count map onto
+// overpriced and bargain value positions
+func WithValueThresholds(thresholds ValueThresholds) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.ValueThresholds = thresholds
+	}
+}
+
+// Store returns the configured report store, or nil when reports are not persisted
+func (a *CompetitorIntelligenceAgent) Store() ReportStore {
+	return a.store
+}
+
+// WithReportStore persists every report generated by Run and enables
+// history-derived signals such as momentum
+func WithReportStore(store ReportStore) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.store = store
+	}
+}
+
+// WithVerbosity sets the opportunity/risk explanation verbosity
+func WithVerbosity(level Verbosity) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.Verbosity = level
+	}
+}
+
+// WithEnricher adds an optional enrichment step run after market research
+func WithEnricher(enricher Enricher) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.enrichers = append(a.enrichers, enricher)
+	}
+}
+
+// WithInsightsTemplate renders MarketInsights from a custom template; a nil
+// template keeps the default wording
+func WithInsightsTemplate(tmpl *InsightsTemplate) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.insightsTemplate = tmpl
+		a.Config.InsightsTemplate = ""
+		if tmpl != nil {
+			a.Config.InsightsTemplate = tmpl.Source()
+		}
+	}
+}
+
+// WithMaxFieldLength truncates long strings in the given export format
+func WithMaxFieldLength(format string, maxLen int) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if a.Config.Formats == nil {
+			a.Config.Formats = make(map[string]FormatOptions)
+		}
+		opts := a.Config.Formats[format]
+		opts.MaxFieldLength = maxLen
+		a.Config.Formats[format] = opts
+	}
+}
+
+// WithPayload sets whether JSON exports omit every empty field (lean),
+// include every field (full) or follow the field tags (default)
+func WithPayload(mode PayloadMode) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if a.Config.Formats == nil {
+			a.Config.Formats = make(map[string]FormatOptions)
+		}
+		opts := a.Config.Formats[FormatJSON]
+		opts.Payload = mode
+		a.Config.Formats[FormatJSON] = opts
+	}
+}
+
+// WithEmptyLists makes JSON exports render nil lists as [] rather than null
+// for clients that reject null arrays
+func WithEmptyLists() Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if a.Config.Formats == nil {
+			a.Config.Formats = make(map[string]FormatOptions)
+		}
+		opts := a.Config.Formats[FormatJSON]
+		opts.EmptyLists = true
+		a.Config.Formats[FormatJSON] = opts
+	}
+}
+
+// WithCitations footnotes each sourced field in Markdown exports with the
+// data source it came from
+func WithCitations() Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if a.Config.Formats == nil {
+			a.Config.Formats = make(map[string]FormatOptions)
+		}
+		opts := a.Config.Formats[FormatMarkdown]
+		opts.Citations = true
+		a.Config.Formats[FormatMarkdown] = opts
+	}
+}
+
+// WithRadarAxes sets the dimensions compared in radar chart exports
+func WithRadarAxes(axes ...string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.RadarAxes = axes
+	}
+}
+
+// WithDefaultRecommendations appends house recommendations after the
+// generated ones in every report
+func WithDefaultRecommendations(recommendations ...string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.DefaultRecommendations = recommendations
+	}
+}
+
+// WithPriceBands sets the bands used to classify numeric prices into tiers
+func WithPriceBands(bands ...PriceBand) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.PriceBands = bands
+	}
+}
+
+// WithDefaultPersona tailors reports to persona unless a run overrides it;
+// unknown personas are ignored
+func WithDefaultPersona(persona Persona) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if canonical, err := ParsePersona(string(persona)); err == nil {
+			a.Config.Persona = canonical
+		}
+	}
+}
+
+// WithTone sets how forcefully generated recommendations are phrased;
+// configured default recommendations are kept verbatim
+func WithTone(tone Tone) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.Tone = tone
+	}
+}
+
+// WithShareNormalization records market shares rescaled to sum to exactly
+// 100 (including an "others" remainder) alongside the original values
+func WithShareNormalization() Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.NormalizeShares = true
+	}
+}
+
+// WithTimezone renders report timestamps in loc instead of UTC; a nil loc
+// means UTC, as with time.Time.In
+func WithTimezone(loc *time.Location) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if loc == nil {
+			loc = time.UTC
+		}
+		a.timezone = loc
+		a.Config.Timezone = loc.String()
+	}
+}
+
+// WithShareFormat sets how market shares are rendered in exports
+func WithShareFormat(format ShareFormat) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.ShareFormat = format
+	}
+}
+
+// WithScoreNormalization reports each threat score rescaled against the
+// report's other competitors alongside the raw score
+func WithScoreNormalization(mode ScoreNormalization) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.ScoreNormalization = mode
+	}
+}
+
+// WithRecommendationRationale explains each recommendation with the
+// analysis behind it unless a run overrides it
+func WithRecommendationRationale() Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.WithRationale = true
+	}
+}
+
+// WithMinRecommendations pads reports with prioritized generic advice until
+// they carry at least n generated recommendations
+func WithMinRecommendations(n int) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.MinRecommendations = max(n, 0)
+	}
+}
+
+// WithChangeThreshold limits report diffs and the change feed to material
+// changes: share moves above minShareChange points or threat level changes
+func WithChangeThreshold(minShareChange float64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.MinShareChange = max(minShareChange, 0)
+	}
+}
+
+// WithRedactionRules replaces the per-profile redaction rules
+func WithRedactionRules(rules map[string][]string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.RedactionRules = rules
+	}
+}
+
+// WithOutputProfile redacts exports for profile unless another is requested
+func WithOutputProfile(profile string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.OutputProfile = strings.ToLower(profile)
+	}
+}
+
+// WithRelationships configures relationships between competitors, keyed by
+// competitor name, on top of any supplied with the data
+func WithRelationships(relationships map[string][]Relationship) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.Relationships = relationships
+	}
+}
+
+// WithLargeCustomerBase sets the customer count at which competitors are
+// credited with a large installed base
+func WithLargeCustomerBase(customers int) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.LargeCustomerBase = customers
+	}
+}
+
+// WithObservationHalfLife sets how quickly dated strengths and weaknesses
+// lose weight as they age
+func WithObservationHalfLife(halfLife time.Duration) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.ObservationHalfLife = halfLife
+	}
+}
+
+// WithThreatDecay discounts the threat scores of competitors whose data is
+// older than after, halving them for every halfLife beyond it
+func WithThreatDecay(after, halfLife time.Duration) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.ThreatDecayAfter = after
+		a.Config.ThreatDecayHalfLife = halfLife
+	}
+}
+
+// WithDefaultHighlights flags the named competitors as the focus of every
+// report unless a run highlights others
+func WithDefaultHighlights(names ...string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.Highlights = names
+	}
+}
+
+// WithSampling analyzes at most size competitors, chosen by method; seed
+// makes random and stratified sampling reproducible
+func WithSampling(method SampleMethod, size int, seed int64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.SampleMethod = method
+		a.Config.SampleSize = size
+		a.Config.SampleSeed = seed
+	}
+}
+
+// WithFuzzyMatching merges near-duplicate competitor names whose similarity
+// reaches threshold (0-1)
+func WithFuzzyMatching(threshold float64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.FuzzyMatchThreshold = threshold
+	}
+}
+
+// WithTieBreaks sets the chain of rules that orders competitors with equal
+// threat scores
+func WithTieBreaks(chain ...TieBreak) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.TieBreaks = chain
+	}
+}
+
+// WithIndustryBenchmarks sets the per-industry norms competitors are
+// compared against
+func WithIndustryBenchmarks(benchmarks map[string]IndustryBenchmark) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.IndustryBenchmarks = benchmarks
+	}
+}
+
+// WithOpportunityWeights sets keyword weights that rank opportunities by
+// strategic relevance, keyed by industry ("*" for all) and then keyword
+func WithOpportunityWeights(weights map[string]map[string]float64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.OpportunityWeights = weights
+	}
+}
+
+// WithCompetitorCategories classes competitors by name for category share
+// rollups; categories in the data take precedence
+func WithCompetitorCategories(categories map[string]string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.CompetitorCategories = categories
+	}
+}
+
+// WithThreatShares sets the market shares above which competitors are High
+// and Medium threats; zero keeps a level's default
+func WithThreatShares(high, medium float64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.HighThreatShare = high
+		a.Config.MediumThreatShare = medium
+	}
+}
+
+// WithViabilityThresholds sets the HHI and top threat share above which
+// reports advise reconsidering market entry; zero keeps a default
+func WithViabilityThresholds(hhi, topShare float64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.ViabilityHHI = hhi
+		a.Config.ViabilityTopShare = topShare
+	}
+}
+
+// WithEmergingGrowthRate flags competitors growing at least rate percent a
+// year as emerging threats
+func WithEmergingGrowthRate(rate float64) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.EmergingGrowthRate = rate
+	}
+}
+
+// WithEmergingPlayerCriteria flags competitors with at most maxShare market
+// share and at least minStrengths recent strengths as emerging players when
+// they also grow at the emerging growth rate
+func WithEmergingPlayerCriteria(maxShare float64, minStrengths int) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.EmergingMaxShare = maxShare
+		a.Config.EmergingMinStrengths = minStrengths
+	}
+}
+
+// WithTimeBudget skips optional enrichment rather than let a run exceed
+// budget; core analysis always completes
+func WithTimeBudget(budget time.Duration) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.TimeBudget = budget
+	}
+}
+
+// WithAnalysisCache caches up to size Analyze results keyed on a hash of
+// the competitor data, so identical inputs skip recomputation
+func WithAnalysisCache(size int) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if size > 0 {
+			a.analysisCache = newAnalysisCache(size)
+			a.Config.AnalysisCacheSize = size
+		}
+	}
+}
+
+// WithDataSource sets where market research fetches competitor data from
+func WithDataSource(source DataSource) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.source = source
+	}
+}
+
+// WithIndustryLookup infers the industry of the companies in lookup when
+// no industry is requested
+func WithIndustryLookup(lookup IndustryLookup) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.IndustryLookup = lookup
+	}
+}
+
+// WithIndustryInferrer asks inferrer, after any lookup table, for the
+// industry of companies analyzed without one
+func WithIndustryInferrer(inferrer IndustryInferrer) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.industryInferrer = inferrer
+	}
+}
+
+// WithRunRetry re-executes the whole pipeline up to retries more times on
+// retryable errors, waiting backoff before the first retry and doubling after
+func WithRunRetry(retries int, backoff time.Duration) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.RunRetries = max(retries, 0)
+		a.Config.RunRetryBackoff = backoff
+	}
+}
+
+// WithPartialResults makes Run return the analyses produced so far, marked
+// partial, alongside the error when report generation or persistence fails
+func WithPartialResults() Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.PartialResults = true
+	}
+}
+
+// WithOrderBy sets the default competitor ordering in generated reports
+func WithOrderBy(order Order) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.OrderBy = order
+	}
+}
+
+// WithMinThreatLevel reports only competitors at or above level (Low,
+// Medium or High); unknown levels are ignored
+func WithMinThreatLevel(level string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		if canonical, err := ParseThreatLevel(level); err == nil {
+			a.Config.MinThreatLevel = canonical
+		}
+	}
+}
+
+// WithTierCaps lists at most caps[level] competitors per threat level in
+// reports, such as {"High": 3, "Medium": 2}
+func WithTierCaps(caps map[string]int) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.TierCaps = caps
+	}
+}
+
+// WithFeatures compares competitors' support for each feature in reports
+func WithFeatures(features ...string) Option {
+	return func(a *CompetitorIntelligenceAgent) {
+		a.Config.Features = features
+	}
+}
+
+// WithOverrides returns a detached copy of the agent with opts applied, for
+// what-if runs. The copy shares the data source and enrichers but neither
+// persists reports nor caches analyses, so the original is unaffected.
+func (a *CompetitorIntelligenceAgent) WithOverrides(opts ...Option) *CompetitorIntelligenceAgent {
+	clone := *a
+	clone.store = nil
+	clone.analysisCache = nil
+	clone.Config.AnalysisCacheSize = 0
+	if a.Config.Formats != nil {
+		clone.Config.Formats = make(map[string]FormatOptions, len(a.Config.Formats))
+		for format, options := range a.Config.Formats {
+			clone.Config.Formats[format] = options
+		}
+	}
+	for _, opt := range opts {
+		opt(&clone)
+	}
+	return &clone
+}
@@ -0,0 +1,89 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Order selects how competitors are listed in a report
+type Order string
+
+// Supported competitor orderings
+const (
+	// OrderThreat lists the greatest threat first (the default)
+	OrderThreat Order = "threat"
+	// OrderShare lists the largest market share first
+	OrderShare Order = "share"
+	// OrderName lists competitors alphabetically
+	OrderName Order = "name"
+	// OrderInput keeps the order research returned them in
+	OrderInput Order = "input"
+)
+
+// orders is the set of valid orderings
+var orders = []Order{OrderThreat, OrderShare, OrderName, OrderInput}
+
+// ParseOrder validates an ordering name; empty selects OrderThreat
+func ParseOrder(name string) (Order, error) {
+	if name == "" {
+		return OrderThreat, nil
+	}
+	for _, order := range orders {
+		if string(order) == name {
+			return order, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown order %q (want one of %s)", ErrInvalidInput, name, orderNames())
+}
+
+// orderNames lists valid orderings for error messages
+func orderNames() string {
+	names := make([]string, len(orders))
+	for i, order := range orders {
+		names[i] = string(order)
+	}
+	return strings.Join(names, ", ")
+}
+
+// orderKey is the context key carrying a per-run ordering
+type orderKey struct{}
+
+// WithOrder returns a context that makes Run list competitors in order,
+// overriding the agent's configured OrderBy for that run
+func WithOrder(ctx context.Context, order Order) context.Context {
+	return context.WithValue(ctx, orderKey{}, order)
+}
+
+// orderFor returns the ordering for a run, preferring a context override
+func (a *CompetitorIntelligenceAgent) orderFor(ctx context.Context) Order {
+	if order, ok := ctx.Value(orderKey{}).(Order); ok && order != "" {
+		return order
+	}
+	if a.Config.OrderBy != "" {
+		return a.Config.OrderBy
+	}
+	return OrderThreat
+}
+
+// sortAnalyses orders analyses in place. Threat order settles equal scores
+// with chain; other ties keep their input order.
+func sortAnalyses(analyses []CompetitorAnalysis, order Order, chain []TieBreak) {
+	var less func(x, y CompetitorAnalysis) bool
+	switch order {
+	case OrderShare:
+		less = func(x, y CompetitorAnalysis) bool { return x.MarketShare > y.MarketShare }
+	case OrderName:
+		less = func(x, y CompetitorAnalysis) bool {
+			return strings.ToLower(x.CompetitorName) < strings.ToLower(y.CompetitorName)
+		}
+	case OrderInput:
+		return
+	default:
+		less = func(x, y CompetitorAnalysis) bool { return threatLess(x, y, chain) }
+	}
+	sort.SliceStable(analyses, func(i, j int) bool {
+		return less(analyses[i], analyses[j])
+	})
+}
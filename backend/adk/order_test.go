@@ -0,0 +1,77 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSortAnalyses(t *testing.T) {
+	sample := []CompetitorAnalysis{
+		{CompetitorName: "Beta", ThreatScore: 40, MarketShare: 30},
+		{CompetitorName: "alpha", ThreatScore: 70, MarketShare: 10},
+		{CompetitorName: "Gamma", ThreatScore: 55, MarketShare: 20},
+	}
+
+	tests := []struct {
+		order Order
+		want  string
+	}{
+		{OrderThreat, "alpha,Gamma,Beta"},
+		{OrderShare, "Beta,Gamma,alpha"},
+		{OrderName, "alpha,Beta,Gamma"},
+		{OrderInput, "Beta,alpha,Gamma"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.order), func(t *testing.T) {
+			analyses := append([]CompetitorAnalysis(nil), sample...)
+			sortAnalyses(analyses, tt.order, DefaultTieBreaks)
+
+			names := make([]string, len(analyses))
+			for i, analysis := range analyses {
+				names[i] = analysis.CompetitorName
+			}
+			if got := strings.Join(names, ","); got != tt.want {
+				t.Errorf("Expected order %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseOrder(t *testing.T) {
+	if order, err := ParseOrder(""); err != nil || order != OrderThreat {
+		t.Errorf("Expected empty order to default to threat, got %q, %v", order, err)
+	}
+	if order, err := ParseOrder("share"); err != nil || order != OrderShare {
+		t.Errorf("Expected share order, got %q, %v", order, err)
+	}
+	if _, err := ParseOrder("random"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for unknown order, got %v", err)
+	}
+}
+
+func TestRunOrderOverride(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithOrderBy(OrderShare))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.Competitors[0].CompetitorName != "Competitor A" {
+		t.Errorf("Expected largest share first, got %s", report.Competitors[0].CompetitorName)
+	}
+
+	report, err = agent.Run(WithOrder(context.Background(), OrderName), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	last := report.Competitors[len(report.Competitors)-1]
+	if last.CompetitorName != "Competitor C" {
+		t.Errorf("Expected context order to win, got %s last", last.CompetitorName)
+	}
+	if last.Rank == 0 {
+		t.Error("Expected ranks to stay assigned regardless of order")
+	}
+}
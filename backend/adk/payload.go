@@ -0,0 +1,204 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// PayloadMode controls which empty fields JSON exports serialize
+type PayloadMode string
+
+// Supported payload modes
+const (
+	// PayloadDefault follows each field's omitempty tag
+	PayloadDefault PayloadMode = ""
+	// PayloadLean omits every empty field: null, "", 0, false, zero times
+	// and empty lists and maps
+	PayloadLean PayloadMode = "lean"
+	// PayloadFull includes every field, rendering nil lists as [] and nil
+	// maps as {}
+	PayloadFull PayloadMode = "full"
+)
+
+// ParsePayloadMode validates a payload mode name; empty selects PayloadDefault
+func ParsePayloadMode(name string) (PayloadMode, error) {
+	switch mode := PayloadMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case PayloadDefault, PayloadLean, PayloadFull:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: unknown payload mode %q (want lean or full)", ErrInvalidInput, name)
+	}
+}
+
+// marshalPayload renders v as indented JSON in the given mode. The lean and
+// full modes walk v themselves so they apply uniformly to every struct,
+// including competitor data and analyses, whatever their tags say.
+func marshalPayload(v any, mode PayloadMode) ([]byte, error) {
+	if mode == PayloadDefault {
+		return json.MarshalIndent(v, "", "  ")
+	}
+
+	var compact bytes.Buffer
+	if err := encodePayload(&compact, reflect.ValueOf(v), mode); err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, compact.Bytes(), "", "  "); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// jsonMarshalerType detects values, such as time.Time, that encode themselves
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// encodePayload writes v as compact JSON
+func encodePayload(buf *bytes.Buffer, v reflect.Value, mode PayloadMode) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	if v.Type().Implements(jsonMarshalerType) && (v.Kind() != reflect.Pointer || !v.IsNil()) {
+		return writeJSON(buf, v.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return encodePayload(buf, v.Elem(), mode)
+
+	case reflect.Struct:
+		buf.WriteByte('{')
+		first := true
+		if err := encodeFields(buf, v, mode, &first); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			writeNil(buf, "[]", mode)
+			return nil
+		}
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodePayload(buf, v.Index(i), mode); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	case reflect.Map:
+		if v.IsNil() {
+			writeNil(buf, "{}", mode)
+			return nil
+		}
+		// Map entries are data rather than optional fields, so even empty
+		// values are kept; keys are sorted like encoding/json
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, fmt.Sprint(key)); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := encodePayload(buf, v.MapIndex(key), mode); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+
+	default:
+		return writeJSON(buf, v.Interface())
+	}
+}
+
+// encodeFields writes a struct's exported fields, flattening embedded
+// structs as encoding/json does and dropping empty ones in lean mode
+func encodeFields(buf *bytes.Buffer, v reflect.Value, mode PayloadMode, first *bool) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		value := v.Field(i)
+		if field.Anonymous && name == "" && value.Kind() == reflect.Struct {
+			if err := encodeFields(buf, value, mode, first); err != nil {
+				return err
+			}
+			continue
+		}
+		if mode == PayloadLean && isEmptyValue(value) {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if !*first {
+			buf.WriteByte(',')
+		}
+		*first = false
+		if err := writeJSON(buf, name); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		if err := encodePayload(buf, value, mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEmptyValue reports whether v is a zero value or an empty list or map
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// writeNil writes a nil list or map as empty in full mode, null otherwise
+func writeNil(buf *bytes.Buffer, empty string, mode PayloadMode) {
+	if mode == PayloadFull {
+		buf.WriteString(empty)
+		return
+	}
+	buf.WriteString("null")
+}
+
+// writeJSON appends the standard encoding of v
+func writeJSON(buf *bytes.Buffer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	return nil
+}
@@ -0,0 +1,103 @@
+package adk
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePayloadMode(t *testing.T) {
+	for name, want := range map[string]PayloadMode{"": PayloadDefault, "Lean": PayloadLean, "full": PayloadFull} {
+		if got, err := ParsePayloadMode(name); err != nil || got != want {
+			t.Errorf("Expected %q for %q, got %q (%v)", want, name, got, err)
+		}
+	}
+	if _, err := ParsePayloadMode("compact"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestExport_Payload(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	report := &CompetitorReport{
+		GeneratedAt:   at,
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{{
+			CompetitorName: "Bare",
+			ThreatLevel:    ThreatLow,
+			MarketShare:    5,
+		}},
+		Inputs: &ReportInputs{
+			Competitors: []CompetitorData{{Name: "Bare", MarketShare: 5, RetrievedAt: at}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mode     PayloadMode
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "default follows tags",
+			mode:     PayloadDefault,
+			contains: []string{`"risks": null`, `"website": ""`, `"completeness": 0`},
+			excludes: []string{`"funding"`, `"tech_stack"`},
+		},
+		{
+			name:     "lean omits empties",
+			mode:     PayloadLean,
+			contains: []string{`"competitor_name": "Bare"`, `"market_share": 5`, `"retrieved_at": "2024-03-01T12:00:00Z"`},
+			excludes: []string{"null", `""`, `"risks"`, `"website"`, `"completeness"`, `"funding"`, `"tech_stack"`, `"products"`, `"threat_score"`},
+		},
+		{
+			name: "full includes everything",
+			mode: PayloadFull,
+			contains: []string{`"risks": []`, `"funding": 0`, `"tech_stack": []`, `"website": ""`,
+				`"score_breakdown": {}`, `"website_status": null`, `"emerging_threat": false`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := NewCompetitorIntelligenceAgent(WithPayload(tt.mode)).Export(report, FormatJSON)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("Expected JSON to contain %s, got:\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(string(out), unwanted) {
+					t.Errorf("Expected JSON not to contain %s, got:\n%s", unwanted, out)
+				}
+			}
+
+			// Every mode decodes back to the same report
+			var decoded CompetitorReport
+			if err := json.Unmarshal(out, &decoded); err != nil {
+				t.Fatalf("Failed to decode export: %v", err)
+			}
+			if decoded.Competitors[0].CompetitorName != "Bare" || !decoded.GeneratedAt.Equal(at) ||
+				decoded.Inputs.Competitors[0].MarketShare != 5 {
+				t.Errorf("Expected export to round trip, got %+v", decoded)
+			}
+		})
+	}
+}
+
+func TestMarshalPayload_FieldOrder(t *testing.T) {
+	out, err := marshalPayload(CompetitorAnalysis{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 70}, PayloadLean)
+	if err != nil {
+		t.Fatalf("marshalPayload() error = %v", err)
+	}
+
+	want := "{\n  \"competitor_name\": \"Acme\",\n  \"threat_level\": \"High\",\n  \"threat_score\": 70\n}"
+	if string(out) != want {
+		t.Errorf("Expected fields in declaration order:\n%s\ngot:\n%s", want, out)
+	}
+}
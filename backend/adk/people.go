@@ -0,0 +1,90 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Person is a named individual at a competitor
+type Person struct {
+	Name            string `json:"name"`
+	Role            string `json:"role"`
+	YearsExperience int    `json:"years_experience,omitempty"`
+}
+
+// Leadership thresholds: a team is strong with several senior leaders or a
+// single senior leader with a long track record
+const (
+	strongLeadershipCount    = 2
+	experiencedLeaderYears   = 15
+	leadershipSummaryMaxSize = 3
+)
+
+// seniorRoleMarkers identify leadership roles
+var seniorRoleMarkers = []string{"ceo", "cto", "cfo", "coo", "cmo", "chief", "founder", "president", "vp", "vice president"}
+
+// isSenior reports whether a person holds a leadership role
+func isSenior(p Person) bool {
+	role := strings.ToLower(p.Role)
+	for _, marker := range seniorRoleMarkers {
+		if strings.Contains(role, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// notableLeaders filters key people down to senior leadership
+func notableLeaders(people []Person) []Person {
+	var leaders []Person
+	for _, p := range people {
+		if isSenior(p) {
+			leaders = append(leaders, p)
+		}
+	}
+	return leaders
+}
+
+// strongLeadership reports whether leaders amount to a strong team
+func strongLeadership(leaders []Person) bool {
+	if len(leaders) >= strongLeadershipCount {
+		return true
+	}
+	for _, p := range leaders {
+		if p.YearsExperience >= experiencedLeaderYears {
+			return true
+		}
+	}
+	return false
+}
+
+// leadershipTeam starts the differentiator for a strong leadership team
+const leadershipTeam = "Experienced leadership team"
+
+// leadershipDifferentiator describes a strong leadership team
+func leadershipDifferentiator(leaders []Person) string {
+	var names []string
+	for i, p := range leaders {
+		if i == leadershipSummaryMaxSize {
+			break
+		}
+		names = append(names, fmt.Sprintf("%s %s", p.Role, p.Name))
+	}
+	return fmt.Sprintf("%s (%s)", leadershipTeam, strings.Join(names, ", "))
+}
+
+// withoutLeaderNames drops the leaders named in a leadership differentiator,
+// keeping the rest of the differentiators unchanged
+func withoutLeaderNames(differentiators []string) []string {
+	if differentiators == nil {
+		return nil
+	}
+	out := make([]string, len(differentiators))
+	for i, differentiator := range differentiators {
+		if strings.HasPrefix(differentiator, leadershipTeam+" (") {
+			differentiator = leadershipTeam
+		}
+		out[i] = differentiator
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestAnalyze_KeyPeople tests that leadership flows into the analysis
+func TestAnalyze_KeyPeople(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{
+			Name:      "Led Corp",
+			Strengths: []string{"Brand"},
+			KeyPeople: []Person{
+				{Name: "Ada", Role: "CEO", YearsExperience: 20},
+				{Name: "Grace", Role: "Chief Technology Officer"},
+				{Name: "Linus", Role: "Staff Engineer"},
+			},
+		},
+		{
+			Name:      "Thin Corp",
+			Strengths: []string{"Price"},
+			KeyPeople: []Person{{Name: "Bob", Role: "Founder", YearsExperience: 2}},
+		},
+		{
+			Name:      "Anon Corp",
+			Strengths: []string{"Speed"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	led := analyses[0]
+	if len(led.Leadership) != 2 {
+		t.Errorf("Expected 2 notable leaders, got %v", led.Leadership)
+	}
+	if len(led.KeyDifferentiators) != 2 || !strings.HasPrefix(led.KeyDifferentiators[1], "Experienced leadership team (CEO Ada, Chief Technology Officer Grace)") {
+		t.Errorf("Expected leadership differentiator, got %v", led.KeyDifferentiators)
+	}
+
+	thin := analyses[1]
+	if len(thin.Leadership) != 1 || len(thin.KeyDifferentiators) != 1 {
+		t.Errorf("Expected a lone junior founder not to count as strong leadership, got %v", thin.KeyDifferentiators)
+	}
+
+	if analyses[2].Leadership != nil {
+		t.Error("Expected no leadership without key people")
+	}
+}
+
+// TestRun_KeyPeopleInReport tests that stub executives reach the report
+func TestRun_KeyPeopleInReport(t *testing.T) {
+	report, err := NewCompetitorIntelligenceAgent().Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, analysis := range report.Competitors {
+		if analysis.CompetitorName == "Competitor A" {
+			if len(analysis.Leadership) == 0 {
+				t.Fatal("Expected Competitor A leadership in report")
+			}
+			if !mentions(analysis.KeyDifferentiators, "leadership") {
+				t.Errorf("Expected leadership differentiator, got %v", analysis.KeyDifferentiators)
+			}
+		}
+	}
+}
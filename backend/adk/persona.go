@@ -0,0 +1,178 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Persona tailors report presentation to a reader's priorities. It changes
+// section order, section selection and summary emphasis, never the data.
+type Persona string
+
+// Supported personas; the empty persona is the general-purpose layout
+const (
+	PersonaInvestor Persona = "investor"
+	PersonaProduct  Persona = "product"
+	PersonaSales    Persona = "sales"
+)
+
+// ParsePersona validates a persona name; empty selects the general layout
+func ParsePersona(name string) (Persona, error) {
+	switch persona := Persona(strings.ToLower(name)); persona {
+	case "", PersonaInvestor, PersonaProduct, PersonaSales:
+		return persona, nil
+	default:
+		return "", fmt.Errorf("%w: unknown persona %q (want investor, product or sales)", ErrInvalidInput, name)
+	}
+}
+
+// personaKey is the context key carrying a per-run persona
+type personaKey struct{}
+
+// WithPersona returns a context that makes Run tailor the report to
+// persona, overriding the agent's configured persona for that run
+func WithPersona(ctx context.Context, persona Persona) context.Context {
+	return context.WithValue(ctx, personaKey{}, persona)
+}
+
+// personaFor returns the persona for a run, preferring a context override
+func (a *CompetitorIntelligenceAgent) personaFor(ctx context.Context) Persona {
+	if persona, ok := ctx.Value(personaKey{}).(Persona); ok {
+		return persona
+	}
+	return a.Config.Persona
+}
+
+// Report-level Markdown sections
+const (
+	sectionSummary         = "summary"
+	sectionInsights        = "insights"
+	sectionCompetitors     = "competitors"
+	sectionRecommendations = "recommendations"
+)
+
+// layoutPersona returns the persona whose section layout renders a report,
+// falling back to the general layout for unknown personas that stored or
+// replayed reports may carry
+func layoutPersona(persona Persona) Persona {
+	if parsed, err := ParsePersona(string(persona)); err == nil {
+		return parsed
+	}
+	return ""
+}
+
+// reportSections lists report-level sections in display order per persona
+var reportSections = map[Persona][]string{
+	"":              {sectionSummary, sectionInsights, sectionCompetitors, sectionRecommendations},
+	PersonaInvestor: {sectionSummary, sectionInsights, sectionCompetitors},
+	PersonaProduct:  {sectionSummary, sectionCompetitors, sectionRecommendations},
+	PersonaSales:    {sectionSummary, sectionCompetitors, sectionRecommendations},
+}
+
+// Per-competitor Markdown sections
+const (
+	fieldThreat          = "threat"
+	fieldRank            = "rank"
+	fieldPositioning     = "positioning"
+	fieldMarketShare     = "market_share"
+	fieldFunding         = "funding"
+	fieldCompleteness    = "completeness"
+	fieldDifferentiators = "differentiators"
+	fieldFeatureGaps     = "feature_gaps"
+	fieldOpportunities   = "opportunities"
+	fieldRisks           = "risks"
+	fieldActionPlan      = "action_plan"
+	fieldNotes           = "notes"
+)
+
+// competitorSections lists per-competitor sections in display order per persona
+var competitorSections = map[Persona][]string{
+	"": {fieldThreat, fieldRank, fieldPositioning, fieldMarketShare, fieldCompleteness,
+		fieldDifferentiators, fieldOpportunities, fieldRisks, fieldActionPlan, fieldNotes},
+	PersonaInvestor: {fieldMarketShare, fieldFunding, fieldThreat, fieldRank, fieldPositioning, fieldCompleteness, fieldRisks, fieldNotes},
+	PersonaProduct:  {fieldFeatureGaps, fieldDifferentiators, fieldOpportunities, fieldPositioning, fieldActionPlan, fieldNotes},
+	PersonaSales:    {fieldThreat, fieldPositioning, fieldOpportunities, fieldRisks, fieldActionPlan, fieldNotes},
+}
+
+// executiveSummary opens the report with the facts persona cares most about
+func executiveSummary(persona Persona, stats InsightStats, analyses []CompetitorAnalysis) string {
+	if len(analyses) == 0 {
+		return fmt.Sprintf("No competitors of %s were found.", stats.TargetCompany)
+	}
+
+	switch persona {
+	case PersonaInvestor:
+		return investorSummary(stats, analyses)
+	case PersonaProduct:
+		return productSummary(analyses)
+	case PersonaSales:
+		return salesSummary(analyses)
+	default:
+		return fmt.Sprintf("%s faces %d competitors, %d of them high-threat. %s is the top threat (score %s).",
+			stats.TargetCompany, stats.CompetitorCount, stats.HighThreatCount, stats.TopThreat, formatFloat(stats.TopThreatScore))
+	}
+}
+
+// investorSummary emphasizes market share concentration and funding
+func investorSummary(stats InsightStats, analyses []CompetitorAnalysis) string {
+	leader, funded := analyses[0], analyses[0]
+	var totalFunding float64
+	for _, analysis := range analyses {
+		if analysis.MarketShare > leader.MarketShare {
+			leader = analysis
+		}
+		if analysis.Funding > funded.Funding {
+			funded = analysis
+		}
+		totalFunding += analysis.Funding
+	}
+
+	summary := fmt.Sprintf("%s leads on market share with %s%%; tracked competitors hold %s%% combined (HHI %s).",
+		leader.CompetitorName, formatFloat(leader.MarketShare), formatFloat(stats.TotalMarketShare), formatFloat(stats.HHI))
+	if totalFunding > 0 {
+		summary += fmt.Sprintf(" %s is best funded at $%sM of $%sM raised across competitors.",
+			funded.CompetitorName, formatFloat(funded.Funding), formatFloat(round2(totalFunding)))
+	}
+	return summary
+}
+
+// fundingSentence matches the funding sentence investorSummary appends
+// after the concentration sentence
+var fundingSentence = regexp.MustCompile(`(\(HHI [0-9.]+\)\.) .+ is best funded at \$[0-9.]+M of \$[0-9.]+M raised across competitors\.`)
+
+// withoutFundingSummary drops the funding sentence from an executive summary
+func withoutFundingSummary(summary string) string {
+	return fundingSentence.ReplaceAllString(summary, "$1")
+}
+
+// productSummary emphasizes feature gaps and weaknesses to build against
+func productSummary(analyses []CompetitorAnalysis) string {
+	var gaps []string
+	for _, analysis := range analyses {
+		if len(analysis.Weaknesses) > 0 {
+			gaps = append(gaps, fmt.Sprintf("%s (%s)", analysis.CompetitorName, strings.ToLower(strings.Join(analysis.Weaknesses, ", "))))
+		}
+	}
+	if len(gaps) == 0 {
+		return "No competitor feature gaps were identified."
+	}
+	return "Feature gaps to build against: " + strings.Join(gaps, "; ") + "."
+}
+
+// salesSummary emphasizes which competitors to sell against first
+func salesSummary(analyses []CompetitorAnalysis) string {
+	ordered := append([]CompetitorAnalysis(nil), analyses...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].ThreatScore > ordered[j].ThreatScore
+	})
+
+	top := ordered[0]
+	summary := fmt.Sprintf("Prioritize deals against %s (%s threat, %s).", top.CompetitorName, top.ThreatLevel, strings.ToLower(top.Positioning))
+	if len(top.Opportunities) > 0 {
+		summary += " Lead with: " + top.Opportunities[0] + "."
+	}
+	return summary
+}
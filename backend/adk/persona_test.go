@@ -0,0 +1,123 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPersonaReports(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	tests := []struct {
+		persona  Persona
+		summary  []string
+		markdown []string
+		omitted  []string
+	}{
+		{
+			persona:  PersonaInvestor,
+			summary:  []string{"leads on market share with 25.5%", "Competitor C is best funded at $400M"},
+			markdown: []string{"- **Market share:** 25.5%\n- **Funding:** $250M", "## Market Insights"},
+			omitted:  []string{"**Opportunities**", "## Recommendations"},
+		},
+		{
+			persona:  PersonaProduct,
+			summary:  []string{"Feature gaps to build against", "Competitor A (high prices, slow support, limited features)"},
+			markdown: []string{"**Feature gaps**\n\n- High prices", "**Key differentiators**"},
+			omitted:  []string{"**Funding:**", "## Market Insights"},
+		},
+		{
+			persona:  PersonaSales,
+			summary:  []string{"Prioritize deals against"},
+			markdown: []string{"**Opportunities**", "## Recommendations"},
+			omitted:  []string{"**Funding:**", "**Feature gaps**"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.persona), func(t *testing.T) {
+			report, err := agent.Run(WithPersona(context.Background(), tt.persona), "TestCorp", "SaaS")
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if report.Persona != tt.persona {
+				t.Errorf("Expected persona %s, got %s", tt.persona, report.Persona)
+			}
+			for _, want := range tt.summary {
+				if !strings.Contains(report.ExecutiveSummary, want) {
+					t.Errorf("Expected summary to contain %q, got %q", want, report.ExecutiveSummary)
+				}
+			}
+
+			markdown := report.ToMarkdown(FormatOptions{})
+			if !strings.Contains(markdown, "## Executive Summary") {
+				t.Error("Expected the executive summary section")
+			}
+			for _, want := range tt.markdown {
+				if !strings.Contains(markdown, want) {
+					t.Errorf("Expected markdown to contain %q", want)
+				}
+			}
+			for _, unwanted := range tt.omitted {
+				if strings.Contains(markdown, unwanted) {
+					t.Errorf("Expected markdown to omit %q", unwanted)
+				}
+			}
+
+			// Personas change presentation only
+			if len(report.Competitors) != 3 || len(report.Recommendations) == 0 {
+				t.Error("Expected the underlying report data to be unchanged")
+			}
+		})
+	}
+}
+
+func TestParsePersona(t *testing.T) {
+	if persona, err := ParsePersona("Investor"); err != nil || persona != PersonaInvestor {
+		t.Errorf("Expected investor persona, got %q, %v", persona, err)
+	}
+	if persona, err := ParsePersona(""); err != nil || persona != "" {
+		t.Errorf("Expected general persona for empty input, got %q, %v", persona, err)
+	}
+	if _, err := ParsePersona("auditor"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for unknown persona, got %v", err)
+	}
+}
+
+func TestToMarkdown_UnknownPersona(t *testing.T) {
+	report := &CompetitorReport{
+		TargetCompany:   "TestCorp",
+		Persona:         "auditor",
+		Competitors:     []CompetitorAnalysis{{CompetitorName: "Acme", ThreatLevel: ThreatHigh}},
+		MarketInsights:  "Crowded market",
+		Recommendations: []string{"Watch Acme"},
+	}
+
+	out := report.ToMarkdown(FormatOptions{})
+	for _, want := range []string{"## Market Insights", "Acme", "Watch Acme"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected the general layout with %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestWithDefaultPersona(t *testing.T) {
+	tests := []struct {
+		persona Persona
+		want    Persona
+	}{
+		{persona: "Investor", want: PersonaInvestor},
+		{persona: PersonaSales, want: PersonaSales},
+		{persona: "auditor", want: ""},
+	}
+
+	for _, tt := range tests {
+		agent := NewCompetitorIntelligenceAgent(WithDefaultPersona(tt.persona))
+		if agent.Config.Persona != tt.want {
+			t.Errorf("Expected persona %q for %q, got %q", tt.want, tt.persona, agent.Config.Persona)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Pipeline stages executed by Run
+const (
+	StageResearch    = "research"
+	StageAnalysis    = "analysis"
+	StageReport      = "report"
+	StagePersistence = "persistence"
+)
+
+// stageDescriptions are the human-readable stage names used in error messages
+var stageDescriptions = map[string]string{
+	StageResearch:    "market research",
+	StageAnalysis:    "analysis",
+	StageReport:      "report generation",
+	StagePersistence: "report persistence",
+}
+
+// StageError reports which pipeline stage failed
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+// Error implements the error interface
+func (e *StageError) Error() string {
+	return fmt.Sprintf("%s failed: %v", stageDescriptions[e.Stage], e.Err)
+}
+
+// Unwrap returns the underlying stage error
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// ErrInjectedFailure is returned by a stage forced to fail via WithInjectedFailure
+var ErrInjectedFailure = errors.New("injected failure")
+
+// injectedFailureKey is the context key carrying the stage to fail
+type injectedFailureKey struct{}
+
+// IsStage reports whether name is an injectable pipeline stage
+func IsStage(name string) bool {
+	_, ok := stageDescriptions[name]
+	return ok
+}
+
+// WithInjectedFailure returns a context that makes Run fail at the given
+// stage; persistence only runs, and so only fails, with a report store. It
+// exists for resilience testing; callers are responsible for keeping it out
+// of production paths.
+func WithInjectedFailure(ctx context.Context, stage string) context.Context {
+	return context.WithValue(ctx, injectedFailureKey{}, stage)
+}
+
+// injectedFailure returns ErrInjectedFailure when ctx targets stage
+func injectedFailure(ctx context.Context, stage string) error {
+	if target, _ := ctx.Value(injectedFailureKey{}).(string); target == stage {
+		return ErrInjectedFailure
+	}
+	return nil
+}
+
+// stageObserverKey is the context key carrying a stage observer
+type stageObserverKey struct{}
+
+// WithStageObserver returns a context that makes Run call observe as each
+// pipeline stage starts, for progress reporting. observe runs synchronously
+// and must not block.
+func WithStageObserver(ctx context.Context, observe func(stage string)) context.Context {
+	return context.WithValue(ctx, stageObserverKey{}, observe)
+}
+
+// enterStage notifies any stage observer that stage is starting
+func enterStage(ctx context.Context, stage string) {
+	if observe, ok := ctx.Value(stageObserverKey{}).(func(string)); ok && observe != nil {
+		observe(stage)
+	}
+}
+
+// partialAnalyses wraps analyses in a partial report when partial results
+// are enabled, otherwise it discards them
+func (a *CompetitorIntelligenceAgent) partialAnalyses(companyName string, analyses []CompetitorAnalysis, err *StageError) (*CompetitorReport, error) {
+	report := &CompetitorReport{
+		GeneratedAt:   a.reportTime(),
+		TargetCompany: companyName,
+		Competitors:   make([]CompetitorAnalysis, len(analyses)),
+	}
+	copy(report.Competitors, analyses)
+	return a.partialReport(report, err)
+}
+
+// partialReport marks report as missing the failed stage and everything
+// after it, returning it with err when partial results are enabled
+func (a *CompetitorIntelligenceAgent) partialReport(report *CompetitorReport, err *StageError) (*CompetitorReport, error) {
+	if !a.Config.PartialResults {
+		return nil, err
+	}
+	report.Partial = true
+	report.MissingStages = stagesFrom(err.Stage)
+	return report, err
+}
+
+// stagesFrom returns stage and the stages that run after it
+func stagesFrom(stage string) []string {
+	order := []string{StageResearch, StageAnalysis, StageReport, StagePersistence}
+	for i, s := range order {
+		if s == stage {
+			return append([]string(nil), order[i:]...)
+		}
+	}
+	return []string{stage}
+}
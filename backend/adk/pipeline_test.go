@@ -0,0 +1,144 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestRun_InjectedFailure tests that each stage can be forced to fail
+func TestRun_InjectedFailure(t *testing.T) {
+	store := NewMemoryReportStore()
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(store))
+
+	tests := []struct {
+		stage   string
+		message string
+	}{
+		{stage: StageResearch, message: "market research failed"},
+		{stage: StageAnalysis, message: "analysis failed"},
+		{stage: StageReport, message: "report generation failed"},
+		{stage: StagePersistence, message: "report persistence failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.stage, func(t *testing.T) {
+			ctx := WithInjectedFailure(context.Background(), tt.stage)
+
+			report, err := agent.Run(ctx, "TestCorp", "SaaS")
+			if err == nil {
+				t.Fatal("Expected an error from the injected stage")
+			}
+
+			if report != nil {
+				t.Error("Expected nil report on failure")
+			}
+
+			var stageErr *StageError
+			if !errors.As(err, &stageErr) || stageErr.Stage != tt.stage {
+				t.Errorf("Expected StageError for %s, got %v", tt.stage, err)
+			}
+
+			if !errors.Is(err, ErrInjectedFailure) {
+				t.Error("Expected error to wrap ErrInjectedFailure")
+			}
+
+			if !strings.HasPrefix(err.Error(), tt.message) {
+				t.Errorf("Expected message prefix %q, got %q", tt.message, err.Error())
+			}
+		})
+	}
+	if stored, _ := store.List(context.Background(), ""); len(stored) != 0 {
+		t.Errorf("Expected failed runs not stored, got %d reports", len(stored))
+	}
+
+	if _, err := agent.Run(context.Background(), "TestCorp", "SaaS"); err != nil {
+		t.Errorf("Expected no failure without injection, got %v", err)
+	}
+}
+
+// stageOf returns the failed stage named by err, if any
+func stageOf(err error) string {
+	var stageErr *StageError
+	if errors.As(err, &stageErr) {
+		return stageErr.Stage
+	}
+	return ""
+}
+
+// failingStore rejects every save
+type failingStore struct {
+	*MemoryReportStore
+}
+
+func (failingStore) Save(ctx context.Context, report *CompetitorReport) error {
+	return errors.New("disk full")
+}
+
+// TestRun_PartialResults tests that completed stages survive a later failure
+func TestRun_PartialResults(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithPartialResults())
+
+	ctx := WithInjectedFailure(context.Background(), StageReport)
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if stageOf(err) != StageReport {
+		t.Fatalf("Expected report stage error, got %v", err)
+	}
+	if report == nil {
+		t.Fatal("Expected partial report alongside the error")
+	}
+	if !report.Partial {
+		t.Error("Expected report to be marked partial")
+	}
+	if len(report.Competitors) != 3 {
+		t.Errorf("Expected 3 analyses, got %d", len(report.Competitors))
+	}
+	if len(report.Recommendations) != 0 || report.MarketInsights != "" {
+		t.Error("Expected report-stage output to be missing")
+	}
+	want := []string{StageReport, StagePersistence}
+	if strings.Join(report.MissingStages, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected missing stages %v, got %v", want, report.MissingStages)
+	}
+
+	// Earlier stages have nothing to salvage
+	ctx = WithInjectedFailure(context.Background(), StageAnalysis)
+	if report, err := agent.Run(ctx, "TestCorp", "SaaS"); err == nil || report != nil {
+		t.Errorf("Expected nil report on analysis failure, got %v, %v", report, err)
+	}
+}
+
+// TestRun_PartialResultsPersistence tests that a failed save keeps the full report
+func TestRun_PartialResultsPersistence(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithPartialResults(), WithReportStore(failingStore{NewMemoryReportStore()}))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if stageOf(err) != StagePersistence {
+		t.Fatalf("Expected persistence stage error, got %v", err)
+	}
+	if report == nil || !report.Partial {
+		t.Fatal("Expected partial report alongside the error")
+	}
+	if len(report.Recommendations) == 0 {
+		t.Error("Expected generated recommendations to be kept")
+	}
+}
+
+// TestRun_StageObserver tests that stages are reported in pipeline order
+func TestRun_StageObserver(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(NewMemoryReportStore()))
+
+	var stages []string
+	ctx := WithStageObserver(context.Background(), func(stage string) {
+		stages = append(stages, stage)
+	})
+	if _, err := agent.Run(ctx, "TestCorp", "Technology"); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := []string{StageResearch, StageAnalysis, StageReport, StagePersistence}
+	if strings.Join(stages, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected stages %v, got %v", want, stages)
+	}
+}
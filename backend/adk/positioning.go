@@ -0,0 +1,94 @@
+package adk
+
+// PositioningRule maps a combination of pricing, market share and product
+// breadth to a positioning label. Zero-valued bounds are unconstrained and
+// an empty Pricing matches any tier.
+type PositioningRule struct {
+	Pricing        string  `json:"pricing,omitempty"`
+	MinMarketShare float64 `json:"min_market_share,omitempty"`
+	MaxMarketShare float64 `json:"max_market_share,omitempty"`
+	MinProducts    int     `json:"min_products,omitempty"`
+	MaxProducts    int     `json:"max_products,omitempty"`
+	Label          string  `json:"label"`
+}
+
+// DefaultPositioningRules is the built-in composite positioning table.
+// Rules are evaluated in order and the first match wins.
+var DefaultPositioningRules = []PositioningRule{
+	{Pricing: "Premium", MinMarketShare: 20, Label: "Dominant premium leader"},
+	{Pricing: "Premium", MaxMarketShare: 10, Label: "Premium niche player"},
+	{Pricing: "Mid-range", MinMarketShare: 20, Label: "Mainstream market leader"},
+	{Pricing: "Mid-range", MinProducts: 2, Label: "Value-focused challenger"},
+	{Pricing: "Budget", MinMarketShare: 20, Label: "Mass-market price leader"},
+	{Pricing: "Budget", MaxMarketShare: 10, Label: "Budget niche player"},
+	{Pricing: "Enterprise", MinProducts: 3, Label: "Enterprise platform provider"},
+	{MinMarketShare: 30, MinProducts: 3, Label: "Broad market leader"},
+}
+
+// matches reports whether the rule applies to a competitor
+func (r PositioningRule) matches(competitor CompetitorData) bool {
+	if r.Pricing != "" && r.Pricing != competitor.Pricing {
+		return false
+	}
+	if r.MinMarketShare > 0 && competitor.MarketShare < r.MinMarketShare {
+		return false
+	}
+	if r.MaxMarketShare > 0 && competitor.MarketShare >= r.MaxMarketShare {
+		return false
+	}
+	if r.MinProducts > 0 && len(competitor.Products) < r.MinProducts {
+		return false
+	}
+	if r.MaxProducts > 0 && len(competitor.Products) > r.MaxProducts {
+		return false
+	}
+	return true
+}
+
+// Market share bounds used to infer positioning when pricing is unknown
+const (
+	inferredLeaderShare = 20.0
+	inferredNicheShare  = 10.0
+)
+
+// positioningFor returns the first matching composite label, falling back
+// to the pricing-only positioning. When pricing is unknown and inference is
+// enabled, it infers a provisional label from market share and reports it
+// as inferred.
+func (a *CompetitorIntelligenceAgent) positioningFor(competitor CompetitorData) (label string, inferred bool) {
+	for _, rule := range a.Config.PositioningRules {
+		if rule.matches(competitor) {
+			return rule.Label, false
+		}
+	}
+	if competitor.Pricing == "" && a.Config.InferPositioning {
+		return sharePositioning(competitor.MarketShare), true
+	}
+	return pricingPositioning(competitor.Pricing), false
+}
+
+// sharePositioning infers a provisional positioning from market share alone
+func sharePositioning(share float64) string {
+	switch {
+	case share >= inferredLeaderShare:
+		return "Market leader"
+	case share < inferredNicheShare:
+		return "Niche player"
+	default:
+		return "Challenger"
+	}
+}
+
+// pricingPositioning derives positioning from the pricing tier alone
+func pricingPositioning(pricing string) string {
+	switch pricing {
+	case "Premium":
+		return "Premium market leader"
+	case "Mid-range":
+		return "Value-focused challenger"
+	case "Enterprise":
+		return "Enterprise specialist"
+	default:
+		return "Undifferentiated"
+	}
+}
@@ -0,0 +1,112 @@
+package adk
+
+import (
+	"context"
+	"testing"
+)
+
+// TestAnalyze_CompositePositioning tests that combined factors yield richer labels
+func TestAnalyze_CompositePositioning(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithCompositePositioning())
+
+	tests := []struct {
+		name string
+		data CompetitorData
+		want string
+	}{
+		{
+			name: "High share premium",
+			data: CompetitorData{Name: "A", Pricing: "Premium", MarketShare: 25},
+			want: "Dominant premium leader",
+		},
+		{
+			name: "Low share budget",
+			data: CompetitorData{Name: "B", Pricing: "Budget", MarketShare: 4},
+			want: "Budget niche player",
+		},
+		{
+			name: "Broad enterprise portfolio",
+			data: CompetitorData{Name: "C", Pricing: "Enterprise", MarketShare: 12, Products: []string{"A", "B", "C"}},
+			want: "Enterprise platform provider",
+		},
+		{
+			name: "Unknown pricing with dominant share and breadth",
+			data: CompetitorData{Name: "D", Pricing: "Custom", MarketShare: 35, Products: []string{"A", "B", "C"}},
+			want: "Broad market leader",
+		},
+		{
+			name: "No rule matches falls back to pricing",
+			data: CompetitorData{Name: "E", Pricing: "Enterprise", MarketShare: 12, Products: []string{"Suite"}},
+			want: "Enterprise specialist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyses, err := agent.Analyze(context.Background(), []CompetitorData{tt.data})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+
+			if analyses[0].Positioning != tt.want {
+				t.Errorf("Positioning = %s, want %s", analyses[0].Positioning, tt.want)
+			}
+		})
+	}
+}
+
+// TestAnalyze_CustomPositioningRules tests that the decision table is overridable
+func TestAnalyze_CustomPositioningRules(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithPositioningRules([]PositioningRule{
+		{Pricing: "Premium", MinProducts: 2, Label: "Premium suite vendor"},
+	}))
+
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "A", Pricing: "Premium", MarketShare: 25, Products: []string{"X", "Y"}},
+		{Name: "B", Pricing: "Premium", MarketShare: 25, Products: []string{"X"}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if analyses[0].Positioning != "Premium suite vendor" {
+		t.Errorf("Expected custom label, got %s", analyses[0].Positioning)
+	}
+
+	if analyses[1].Positioning != "Premium market leader" {
+		t.Errorf("Expected pricing fallback, got %s", analyses[1].Positioning)
+	}
+}
+
+// TestAnalyze_InferredPositioning tests share-based positioning for unknown pricing
+func TestAnalyze_InferredPositioning(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []Option
+		data         CompetitorData
+		want         string
+		wantInferred bool
+	}{
+		{name: "Leader", opts: []Option{WithInferredPositioning()}, data: CompetitorData{Name: "A", MarketShare: 28}, want: "Market leader", wantInferred: true},
+		{name: "Challenger", opts: []Option{WithInferredPositioning()}, data: CompetitorData{Name: "B", MarketShare: 14}, want: "Challenger", wantInferred: true},
+		{name: "Niche", opts: []Option{WithInferredPositioning()}, data: CompetitorData{Name: "C", MarketShare: 3}, want: "Niche player", wantInferred: true},
+		{name: "Explicit pricing wins", opts: []Option{WithInferredPositioning()}, data: CompetitorData{Name: "D", MarketShare: 3, Pricing: "Premium"}, want: "Premium market leader"},
+		{name: "Disabled", data: CompetitorData{Name: "E", MarketShare: 28}, want: "Undifferentiated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(tt.opts...)
+			analyses, err := agent.Analyze(context.Background(), []CompetitorData{tt.data})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			if analyses[0].Positioning != tt.want {
+				t.Errorf("Positioning = %s, want %s", analyses[0].Positioning, tt.want)
+			}
+			if analyses[0].PositioningInferred != tt.wantInferred {
+				t.Errorf("PositioningInferred = %v, want %v", analyses[0].PositioningInferred, tt.wantInferred)
+			}
+		})
+	}
+}
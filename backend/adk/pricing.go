@@ -0,0 +1,87 @@
+package adk
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PriceBand assigns a pricing tier to prices up to and including Max
+// (USD per month). A zero Max is unbounded, so it belongs last.
+type PriceBand struct {
+	Tier string  `json:"tier"`
+	Max  float64 `json:"max,omitempty"`
+}
+
+// DefaultPriceBands is the built-in price-to-tier table, cheapest first
+var DefaultPriceBands = []PriceBand{
+	{Tier: "Budget", Max: 20},
+	{Tier: "Mid-range", Max: 100},
+	{Tier: "Premium", Max: 500},
+	{Tier: "Enterprise"},
+}
+
+// ClassifyPrice maps a price to the first band that contains it
+func ClassifyPrice(price float64, bands []PriceBand) string {
+	for _, band := range bands {
+		if band.Max == 0 || price <= band.Max {
+			return band.Tier
+		}
+	}
+	return ""
+}
+
+// priceNumber matches amounts like 49, $1,200 or 19.99
+var priceNumber = regexp.MustCompile(`\d[\d,]*(?:\.\d+)?`)
+
+// parsePrice extracts a price or price range from text such as "$49/mo" or
+// "49-199", returning the range midpoint
+func parsePrice(text string) (float64, bool) {
+	matches := priceNumber.FindAllString(text, 2)
+	if len(matches) == 0 {
+		return 0, false
+	}
+
+	var total float64
+	for _, match := range matches {
+		value, err := strconv.ParseFloat(strings.ReplaceAll(match, ",", ""), 64)
+		if err != nil {
+			return 0, false
+		}
+		total += value
+	}
+	return total / float64(len(matches)), true
+}
+
+// priceBands returns the configured bands or the defaults
+func (a *CompetitorIntelligenceAgent) priceBands() []PriceBand {
+	if len(a.Config.PriceBands) > 0 {
+		return a.Config.PriceBands
+	}
+	return DefaultPriceBands
+}
+
+// pricingTier resolves a competitor's pricing tier. Tier labels are kept
+// as-is; otherwise numeric Price/PriceMax, or a numeric Pricing string, is
+// classified by the price bands using the midpoint of any range.
+func (a *CompetitorIntelligenceAgent) pricingTier(competitor CompetitorData) string {
+	bands := a.priceBands()
+	for _, band := range bands {
+		if strings.EqualFold(competitor.Pricing, band.Tier) {
+			return band.Tier
+		}
+	}
+
+	if competitor.Price > 0 {
+		price := competitor.Price
+		if competitor.PriceMax > price {
+			price = (price + competitor.PriceMax) / 2
+		}
+		return ClassifyPrice(price, bands)
+	}
+
+	if price, ok := parsePrice(competitor.Pricing); ok {
+		return ClassifyPrice(price, bands)
+	}
+	return competitor.Pricing
+}
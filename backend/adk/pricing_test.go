@@ -0,0 +1,49 @@
+package adk
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPricingTier(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	tests := []struct {
+		name       string
+		competitor CompetitorData
+		want       string
+	}{
+		{"budget price", CompetitorData{Price: 9}, "Budget"},
+		{"band upper bound is inclusive", CompetitorData{Price: 20}, "Budget"},
+		{"mid-range price", CompetitorData{Price: 49}, "Mid-range"},
+		{"premium range midpoint", CompetitorData{Price: 150, PriceMax: 450}, "Premium"},
+		{"enterprise price", CompetitorData{Price: 2500}, "Enterprise"},
+		{"numeric pricing text", CompetitorData{Pricing: "$1,200/month"}, "Enterprise"},
+		{"pricing text range", CompetitorData{Pricing: "$10 - $50"}, "Mid-range"},
+		{"tier label kept", CompetitorData{Pricing: "premium", Price: 5}, "Premium"},
+		{"unknown label kept", CompetitorData{Pricing: "Custom"}, "Custom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := agent.pricingTier(tt.competitor); got != tt.want {
+				t.Errorf("Expected tier %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPricingTier_CustomBands(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithPriceBands(
+		PriceBand{Tier: "Mid-range", Max: 1000},
+		PriceBand{Tier: "Enterprise"},
+	))
+
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{{Name: "Acme", Price: 800}})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if analyses[0].Positioning != "Value-focused challenger" {
+		t.Errorf("Expected positioning from the derived Mid-range tier, got %s", analyses[0].Positioning)
+	}
+}
@@ -0,0 +1,69 @@
+package adk
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// neutralPricingScore is charted for competitors whose tier is unknown: the
+// midpoint of the Budget (1) to Enterprise (4) scale
+const neutralPricingScore = 2.5
+
+// pricingScores places the built-in tiers on a 1-4 scale, keyed by
+// pricingKey
+var pricingScores = map[string]float64{
+	"budget":     1,
+	"midrange":   2,
+	"premium":    3,
+	"enterprise": 4,
+}
+
+// PricingChart holds bar chart data comparing competitors' pricing tiers
+type PricingChart struct {
+	Bars []PricingBar `json:"bars"`
+}
+
+// PricingBar is one competitor's pricing score (Budget=1 .. Enterprise=4)
+// with the raw tier label. Known is false when the tier was missing or not
+// recognized and Score is the neutral midpoint.
+type PricingBar struct {
+	Competitor string  `json:"competitor"`
+	Tier       string  `json:"tier"`
+	Score      float64 `json:"score"`
+	Known      bool    `json:"known"`
+}
+
+// PricingData maps each competitor, in report order, to a normalized
+// pricing score suitable for a bar chart
+func (r *CompetitorReport) PricingData() *PricingChart {
+	chart := &PricingChart{Bars: make([]PricingBar, len(r.Competitors))}
+	for i, analysis := range r.Competitors {
+		score, known := pricingScore(analysis.PricingTier)
+		chart.Bars[i] = PricingBar{
+			Competitor: analysis.CompetitorName,
+			Tier:       analysis.PricingTier,
+			Score:      score,
+			Known:      known,
+		}
+	}
+	return chart
+}
+
+// pricingScore scores a tier label case-insensitively, ignoring spaces and
+// hyphens so "Mid-range" and "mid range" agree
+func pricingScore(tier string) (float64, bool) {
+	if score, ok := pricingScores[pricingKey(tier)]; ok {
+		return score, true
+	}
+	return neutralPricingScore, false
+}
+
+// pricingKey normalizes a tier label for lookup
+func pricingKey(tier string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(tier))
+}
+
+// pricingJSON renders the report's pricing chart data
+func pricingJSON(report *CompetitorReport) ([]byte, error) {
+	return json.MarshalIndent(report.PricingData(), "", "  ")
+}
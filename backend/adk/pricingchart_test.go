@@ -0,0 +1,88 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestPricingScore(t *testing.T) {
+	tests := []struct {
+		tier      string
+		wantScore float64
+		wantKnown bool
+	}{
+		{tier: "Budget", wantScore: 1, wantKnown: true},
+		{tier: "Mid-range", wantScore: 2, wantKnown: true},
+		{tier: "mid range", wantScore: 2, wantKnown: true},
+		{tier: "PREMIUM", wantScore: 3, wantKnown: true},
+		{tier: "Enterprise", wantScore: 4, wantKnown: true},
+		{tier: "Freemium", wantScore: neutralPricingScore},
+		{tier: "", wantScore: neutralPricingScore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tier, func(t *testing.T) {
+			score, known := pricingScore(tt.tier)
+			if score != tt.wantScore || known != tt.wantKnown {
+				t.Errorf("Expected %v (known %v), got %v (known %v)", tt.wantScore, tt.wantKnown, score, known)
+			}
+		})
+	}
+}
+
+func TestPricingData(t *testing.T) {
+	data := []CompetitorData{
+		{Name: "Cheap", MarketShare: 10, Pricing: "Budget"},
+		{Name: "Priced", MarketShare: 20, Price: 250},
+		{Name: "Big", MarketShare: 30, Pricing: "Enterprise"},
+		{Name: "Odd", MarketShare: 5, Pricing: "Pay what you want"},
+		{Name: "Silent", MarketShare: 5},
+	}
+
+	agent := NewCompetitorIntelligenceAgent()
+	analyses, err := agent.Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	want := map[string]PricingBar{
+		"Cheap":  {Competitor: "Cheap", Tier: "Budget", Score: 1, Known: true},
+		"Priced": {Competitor: "Priced", Tier: "Premium", Score: 3, Known: true},
+		"Big":    {Competitor: "Big", Tier: "Enterprise", Score: 4, Known: true},
+		"Odd":    {Competitor: "Odd", Tier: "Pay what you want", Score: 2.5},
+		"Silent": {Competitor: "Silent", Score: 2.5},
+	}
+	chart := report.PricingData()
+	if len(chart.Bars) != len(want) {
+		t.Fatalf("Expected %d bars, got %+v", len(want), chart.Bars)
+	}
+	for i, bar := range chart.Bars {
+		if bar.Competitor != report.Competitors[i].CompetitorName {
+			t.Errorf("Expected bars in report order, got %s at %d", bar.Competitor, i)
+		}
+		if bar != want[bar.Competitor] {
+			t.Errorf("Expected %+v, got %+v", want[bar.Competitor], bar)
+		}
+	}
+}
+
+func TestExport_Pricing(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "Acme", PricingTier: "Premium"}}}
+
+	body, err := NewCompetitorIntelligenceAgent().Export(report, FormatPricing)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	var chart PricingChart
+	if err := json.Unmarshal(body, &chart); err != nil {
+		t.Fatalf("Failed to decode pricing export: %v", err)
+	}
+	if len(chart.Bars) != 1 || chart.Bars[0].Score != 3 || chart.Bars[0].Tier != "Premium" {
+		t.Errorf("Unexpected pricing export: %s", body)
+	}
+}
@@ -0,0 +1,51 @@
+package adk
+
+import (
+	"context"
+	"strings"
+)
+
+// productFilterKey is the context key carrying a per-run products keyword
+type productFilterKey struct{}
+
+// productExclusionsKey is the context key carrying how many competitors the
+// products keyword excluded, for the report stage
+type productExclusionsKey struct{}
+
+// WithProductFilter returns a context that makes Run keep only competitors
+// with a product containing keyword (case-insensitive)
+func WithProductFilter(ctx context.Context, keyword string) context.Context {
+	return context.WithValue(ctx, productFilterKey{}, strings.TrimSpace(keyword))
+}
+
+// productFilterFor returns the run's products keyword, if any
+func productFilterFor(ctx context.Context) string {
+	keyword, _ := ctx.Value(productFilterKey{}).(string)
+	return keyword
+}
+
+// productExclusions returns how many competitors the products keyword excluded
+func productExclusions(ctx context.Context) int {
+	excluded, _ := ctx.Value(productExclusionsKey{}).(int)
+	return excluded
+}
+
+// filterByProduct keeps competitors offering a product whose name contains
+// keyword, case-insensitively. An empty keyword keeps everyone.
+func filterByProduct(data []CompetitorData, keyword string) ([]CompetitorData, int) {
+	if keyword == "" {
+		return data, 0
+	}
+
+	needle := strings.ToLower(keyword)
+	kept := make([]CompetitorData, 0, len(data))
+	for _, competitor := range data {
+		for _, product := range competitor.Products {
+			if strings.Contains(strings.ToLower(product), needle) {
+				kept = append(kept, competitor)
+				break
+			}
+		}
+	}
+	return kept, len(data) - len(kept)
+}
@@ -0,0 +1,69 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestFilterByProduct(t *testing.T) {
+	data := []CompetitorData{
+		{Name: "A", Products: []string{"CRM Cloud", "Helpdesk"}},
+		{Name: "B", Products: []string{"Payroll"}},
+		{Name: "C", Products: []string{"Mobile crm"}},
+		{Name: "D"},
+	}
+
+	tests := []struct {
+		keyword      string
+		wantNames    string
+		wantExcluded int
+	}{
+		{"crm", "A,C", 2},
+		{"PAYROLL", "B", 3},
+		{"", "A,B,C,D", 0},
+		{"analytics", "", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			kept, excluded := filterByProduct(data, tt.keyword)
+			var names []string
+			for _, competitor := range kept {
+				names = append(names, competitor.Name)
+			}
+			if got := strings.Join(names, ","); got != tt.wantNames {
+				t.Errorf("Expected %q kept, got %q", tt.wantNames, got)
+			}
+			if excluded != tt.wantExcluded {
+				t.Errorf("Expected %d excluded, got %d", tt.wantExcluded, excluded)
+			}
+		})
+	}
+}
+
+func TestRun_ProductFilter(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	ctx := WithProductFilter(context.Background(), "product ")
+
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// Competitors A and B sell "Product ..." lines; C only an "Enterprise Suite"
+	if len(report.Competitors) != 2 {
+		t.Fatalf("Expected 2 competitors, got %d", len(report.Competitors))
+	}
+	for _, analysis := range report.Competitors {
+		if analysis.CompetitorName == "Competitor C" {
+			t.Error("Expected Competitor C to be filtered out")
+		}
+	}
+	if report.ExcludedByProduct != 1 {
+		t.Errorf("Expected 1 excluded competitor, got %d", report.ExcludedByProduct)
+	}
+	if !strings.Contains(report.MarketInsights, `1 competitors without "product" products were excluded`) {
+		t.Errorf("Expected the exclusion noted in insights, got %q", report.MarketInsights)
+	}
+}
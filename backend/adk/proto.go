@@ -0,0 +1,400 @@
+package adk
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	competitorv1 "github.com/mk-knight23/ai-sdk-openai/proto/competitor/v1"
+)
+
+// ToProto converts the report to its protobuf message. Inputs are not
+// carried over; they stay with stored reports for replay.
+func (r *CompetitorReport) ToProto() *competitorv1.CompetitorReport {
+	msg := &competitorv1.CompetitorReport{
+		Id:                 r.ID,
+		GeneratedAt:        timestampProto(r.GeneratedAt),
+		TargetCompany:      r.TargetCompany,
+		MarketInsights:     r.MarketInsights,
+		Recommendations:    r.Recommendations,
+		ExecutiveSummary:   r.ExecutiveSummary,
+		Persona:            string(r.Persona),
+		ConfidenceNote:     r.ConfidenceNote,
+		OthersShare:        r.OthersShare,
+		OmittedCompetitors: int32(r.OmittedCompetitors),
+		ExcludedByProduct:  int32(r.ExcludedByProduct),
+		SkippedSteps:       r.SkippedSteps,
+		Pseudonyms:         r.Pseudonyms,
+		Partial:            r.Partial,
+		MissingStages:      r.MissingStages,
+		ScoreNormalization: string(r.ScoreNormalization),
+		Industries:         r.Industries,
+		IndustrySource:     string(r.IndustrySource),
+		EmergingPlayers:    r.EmergingPlayers,
+	}
+
+	for _, analysis := range r.Competitors {
+		msg.Competitors = append(msg.Competitors, analysis.toProto())
+	}
+	if len(r.TierOmissions) > 0 {
+		msg.TierOmissions = make(map[string]int32, len(r.TierOmissions))
+		for level, n := range r.TierOmissions {
+			msg.TierOmissions[level] = int32(n)
+		}
+	}
+	for _, rec := range r.RecommendationDetails {
+		msg.RecommendationDetails = append(msg.RecommendationDetails, &competitorv1.Recommendation{
+			Text:       rec.Text,
+			Priority:   int32(rec.Priority),
+			Category:   string(rec.Category),
+			Confidence: rec.Confidence,
+			Sources:    rec.Sources,
+			Default:    rec.Default,
+			Rationale:  rec.Rationale,
+		})
+	}
+	for _, group := range r.RecommendationGroups {
+		msg.RecommendationGroups = append(msg.RecommendationGroups, &competitorv1.RecommendationGroup{
+			Category:        string(group.Category),
+			Recommendations: group.Recommendations,
+		})
+	}
+	for _, share := range r.CategoryShares {
+		msg.CategoryShares = append(msg.CategoryShares, &competitorv1.CategoryShare{
+			Category:    share.Category,
+			Share:       share.Share,
+			Competitors: int32(share.Competitors),
+		})
+	}
+	if r.Meta != nil {
+		msg.Meta = &competitorv1.ReportMeta{}
+		if len(r.Meta.SourcesLastUpdated) > 0 {
+			msg.Meta.SourcesLastUpdated = make(map[string]*timestamppb.Timestamp, len(r.Meta.SourcesLastUpdated))
+			for source, at := range r.Meta.SourcesLastUpdated {
+				msg.Meta.SourcesLastUpdated[source] = timestampProto(at)
+			}
+		}
+	}
+	if r.Sampling != nil {
+		msg.Sampling = &competitorv1.SamplingInfo{
+			Method:        string(r.Sampling.Method),
+			OriginalCount: int32(r.Sampling.OriginalCount),
+			SampleSize:    int32(r.Sampling.SampleSize),
+			Seed:          r.Sampling.Seed,
+		}
+	}
+	if v := r.MarketViability; v != nil {
+		msg.MarketViability = &competitorv1.MarketViability{
+			ReconsiderEntry: v.ReconsiderEntry,
+			Hhi:             v.HHI,
+			TopThreat:       v.TopThreat,
+			TopThreatShare:  v.TopThreatShare,
+			Advisory:        v.Advisory,
+		}
+	}
+	if m := r.FeatureMatrix; m != nil {
+		msg.FeatureMatrix = &competitorv1.FeatureMatrix{Features: m.Features, Competitors: m.Competitors, Gaps: m.Gaps}
+		for _, row := range m.Support {
+			support := make([]string, len(row))
+			for i, level := range row {
+				support[i] = string(level)
+			}
+			msg.FeatureMatrix.Support = append(msg.FeatureMatrix.Support, &competitorv1.FeatureSupportRow{Support: support})
+		}
+	}
+	return msg
+}
+
+// toProto converts one competitor analysis
+func (a CompetitorAnalysis) toProto() *competitorv1.CompetitorAnalysis {
+	msg := &competitorv1.CompetitorAnalysis{
+		CompetitorName:      a.CompetitorName,
+		ThreatLevel:         a.ThreatLevel,
+		ThreatScore:         a.ThreatScore,
+		EmergingThreat:      a.EmergingThreat,
+		EmergingPlayer:      a.EmergingPlayer,
+		StalenessDiscount:   a.StalenessDiscount,
+		Rank:                int32(a.Rank),
+		ScoreBreakdown:      a.ScoreBreakdown,
+		MarketShare:         a.MarketShare,
+		NormalizedShare:     a.NormalizedShare,
+		Funding:             a.Funding,
+		Momentum:            a.Momentum,
+		Positioning:         a.Positioning,
+		KeyDifferentiators:  a.KeyDifferentiators,
+		TechStack:           a.TechStack,
+		Weaknesses:          a.Weaknesses,
+		Opportunities:       a.Opportunities,
+		Risks:               a.Risks,
+		ScreenshotUrl:       a.ScreenshotURL,
+		Completeness:        a.Completeness,
+		Confidence:          a.Confidence,
+		Industries:          a.Industries,
+		ActionPlan:          a.ActionPlan,
+		Notes:               a.Notes,
+		PositioningInferred: a.PositioningInferred,
+		ShareUncertainty:    a.ShareUncertainty,
+		ValuePosition:       a.ValuePosition,
+		Category:            a.Category,
+		Customers:           int64(a.Customers),
+		BenchmarkNotes:      a.BenchmarkNotes,
+		DataWarnings:        a.DataWarnings,
+		Provenance:          a.Provenance,
+		PricingTier:         a.PricingTier,
+		Highlighted:         a.Highlighted,
+		FocusSummary:        a.FocusSummary,
+	}
+
+	if a.NormalizedThreatScore != nil {
+		score := *a.NormalizedThreatScore
+		msg.NormalizedThreatScore = &score
+	}
+	if len(a.FeatureSupport) > 0 {
+		msg.FeatureSupport = make(map[string]string, len(a.FeatureSupport))
+		for feature, level := range a.FeatureSupport {
+			msg.FeatureSupport[feature] = string(level)
+		}
+	}
+	if a.WebsiteStatus != nil {
+		msg.WebsiteStatus = &competitorv1.WebsiteStatus{
+			Reachable:  a.WebsiteStatus.Reachable,
+			StatusCode: int32(a.WebsiteStatus.StatusCode),
+			Error:      a.WebsiteStatus.Error,
+			CheckedAt:  timestampProto(a.WebsiteStatus.CheckedAt),
+		}
+	}
+	for _, person := range a.Leadership {
+		msg.Leadership = append(msg.Leadership, &competitorv1.Person{
+			Name:            person.Name,
+			Role:            person.Role,
+			YearsExperience: int32(person.YearsExperience),
+		})
+	}
+	for _, rel := range a.Relationships {
+		msg.Relationships = append(msg.Relationships, &competitorv1.Relationship{Type: rel.Type, Target: rel.Target})
+	}
+	for _, item := range a.RecentNews {
+		msg.RecentNews = append(msg.RecentNews, &competitorv1.NewsItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			PublishedAt: timestampProto(item.PublishedAt),
+			Sentiment:   item.Sentiment,
+		})
+	}
+	for _, opportunity := range a.OpportunityPriorities {
+		msg.OpportunityPriorities = append(msg.OpportunityPriorities, &competitorv1.PrioritizedOpportunity{
+			Opportunity: opportunity.Opportunity,
+			Weakness:    opportunity.Weakness,
+			Priority:    opportunity.Priority,
+		})
+	}
+	return msg
+}
+
+// ReportFromProto converts a protobuf message back into a report
+func ReportFromProto(msg *competitorv1.CompetitorReport) *CompetitorReport {
+	r := &CompetitorReport{
+		ID:                 msg.GetId(),
+		GeneratedAt:        timeFromProto(msg.GetGeneratedAt()),
+		TargetCompany:      msg.GetTargetCompany(),
+		MarketInsights:     msg.GetMarketInsights(),
+		Recommendations:    msg.GetRecommendations(),
+		ExecutiveSummary:   msg.GetExecutiveSummary(),
+		Persona:            Persona(msg.GetPersona()),
+		ConfidenceNote:     msg.GetConfidenceNote(),
+		OthersShare:        msg.GetOthersShare(),
+		OmittedCompetitors: int(msg.GetOmittedCompetitors()),
+		ExcludedByProduct:  int(msg.GetExcludedByProduct()),
+		SkippedSteps:       msg.GetSkippedSteps(),
+		Pseudonyms:         msg.GetPseudonyms(),
+		Partial:            msg.GetPartial(),
+		MissingStages:      msg.GetMissingStages(),
+		ScoreNormalization: ScoreNormalization(msg.GetScoreNormalization()),
+		Industries:         msg.GetIndustries(),
+		IndustrySource:     IndustrySource(msg.GetIndustrySource()),
+		EmergingPlayers:    msg.GetEmergingPlayers(),
+	}
+
+	for _, analysis := range msg.GetCompetitors() {
+		r.Competitors = append(r.Competitors, analysisFromProto(analysis))
+	}
+	if len(msg.GetTierOmissions()) > 0 {
+		r.TierOmissions = make(map[string]int, len(msg.GetTierOmissions()))
+		for level, n := range msg.GetTierOmissions() {
+			r.TierOmissions[level] = int(n)
+		}
+	}
+	for _, rec := range msg.GetRecommendationDetails() {
+		r.RecommendationDetails = append(r.RecommendationDetails, Recommendation{
+			Text:       rec.GetText(),
+			Priority:   int(rec.GetPriority()),
+			Category:   RecommendationCategory(rec.GetCategory()),
+			Confidence: rec.GetConfidence(),
+			Sources:    rec.GetSources(),
+			Default:    rec.GetDefault(),
+			Rationale:  rec.GetRationale(),
+		})
+	}
+	for _, group := range msg.GetRecommendationGroups() {
+		r.RecommendationGroups = append(r.RecommendationGroups, RecommendationGroup{
+			Category:        RecommendationCategory(group.GetCategory()),
+			Recommendations: group.GetRecommendations(),
+		})
+	}
+	for _, share := range msg.GetCategoryShares() {
+		r.CategoryShares = append(r.CategoryShares, CategoryShare{
+			Category:    share.GetCategory(),
+			Share:       share.GetShare(),
+			Competitors: int(share.GetCompetitors()),
+		})
+	}
+	if meta := msg.GetMeta(); meta != nil {
+		r.Meta = &ReportMeta{}
+		if len(meta.GetSourcesLastUpdated()) > 0 {
+			r.Meta.SourcesLastUpdated = make(map[string]time.Time, len(meta.GetSourcesLastUpdated()))
+			for source, at := range meta.GetSourcesLastUpdated() {
+				r.Meta.SourcesLastUpdated[source] = timeFromProto(at)
+			}
+		}
+	}
+	if sampling := msg.GetSampling(); sampling != nil {
+		r.Sampling = &SamplingInfo{
+			Method:        SampleMethod(sampling.GetMethod()),
+			OriginalCount: int(sampling.GetOriginalCount()),
+			SampleSize:    int(sampling.GetSampleSize()),
+			Seed:          sampling.GetSeed(),
+		}
+	}
+	if v := msg.GetMarketViability(); v != nil {
+		r.MarketViability = &MarketViability{
+			ReconsiderEntry: v.GetReconsiderEntry(),
+			HHI:             v.GetHhi(),
+			TopThreat:       v.GetTopThreat(),
+			TopThreatShare:  v.GetTopThreatShare(),
+			Advisory:        v.GetAdvisory(),
+		}
+	}
+	if m := msg.GetFeatureMatrix(); m != nil {
+		r.FeatureMatrix = &FeatureMatrix{
+			Features:    m.GetFeatures(),
+			Competitors: m.GetCompetitors(),
+			Gaps:        append([]string{}, m.GetGaps()...),
+		}
+		for _, row := range m.GetSupport() {
+			support := make([]FeatureSupport, len(row.GetSupport()))
+			for i, level := range row.GetSupport() {
+				support[i] = FeatureSupport(level)
+			}
+			r.FeatureMatrix.Support = append(r.FeatureMatrix.Support, support)
+		}
+	}
+	return r
+}
+
+// analysisFromProto converts one competitor analysis message
+func analysisFromProto(msg *competitorv1.CompetitorAnalysis) CompetitorAnalysis {
+	a := CompetitorAnalysis{
+		CompetitorName:      msg.GetCompetitorName(),
+		ThreatLevel:         msg.GetThreatLevel(),
+		ThreatScore:         msg.GetThreatScore(),
+		EmergingThreat:      msg.GetEmergingThreat(),
+		EmergingPlayer:      msg.GetEmergingPlayer(),
+		StalenessDiscount:   msg.GetStalenessDiscount(),
+		Rank:                int(msg.GetRank()),
+		ScoreBreakdown:      msg.GetScoreBreakdown(),
+		MarketShare:         msg.GetMarketShare(),
+		NormalizedShare:     msg.GetNormalizedShare(),
+		Funding:             msg.GetFunding(),
+		Momentum:            msg.GetMomentum(),
+		Positioning:         msg.GetPositioning(),
+		KeyDifferentiators:  msg.GetKeyDifferentiators(),
+		TechStack:           msg.GetTechStack(),
+		Weaknesses:          msg.GetWeaknesses(),
+		Opportunities:       msg.GetOpportunities(),
+		Risks:               msg.GetRisks(),
+		ScreenshotURL:       msg.GetScreenshotUrl(),
+		Completeness:        msg.GetCompleteness(),
+		Confidence:          msg.GetConfidence(),
+		Industries:          msg.GetIndustries(),
+		ActionPlan:          msg.GetActionPlan(),
+		Notes:               msg.GetNotes(),
+		PositioningInferred: msg.GetPositioningInferred(),
+		ShareUncertainty:    msg.GetShareUncertainty(),
+		ValuePosition:       msg.GetValuePosition(),
+		Category:            msg.GetCategory(),
+		Customers:           int(msg.GetCustomers()),
+		BenchmarkNotes:      msg.GetBenchmarkNotes(),
+		DataWarnings:        msg.GetDataWarnings(),
+		Provenance:          msg.GetProvenance(),
+		PricingTier:         msg.GetPricingTier(),
+		Highlighted:         msg.GetHighlighted(),
+		FocusSummary:        msg.GetFocusSummary(),
+	}
+
+	if msg.NormalizedThreatScore != nil {
+		score := msg.GetNormalizedThreatScore()
+		a.NormalizedThreatScore = &score
+	}
+	if len(msg.GetFeatureSupport()) > 0 {
+		a.FeatureSupport = make(map[string]FeatureSupport, len(msg.GetFeatureSupport()))
+		for feature, level := range msg.GetFeatureSupport() {
+			a.FeatureSupport[feature] = FeatureSupport(level)
+		}
+	}
+	if status := msg.GetWebsiteStatus(); status != nil {
+		a.WebsiteStatus = &WebsiteStatus{
+			Reachable:  status.GetReachable(),
+			StatusCode: int(status.GetStatusCode()),
+			Error:      status.GetError(),
+			CheckedAt:  timeFromProto(status.GetCheckedAt()),
+		}
+	}
+	for _, person := range msg.GetLeadership() {
+		a.Leadership = append(a.Leadership, Person{
+			Name:            person.GetName(),
+			Role:            person.GetRole(),
+			YearsExperience: int(person.GetYearsExperience()),
+		})
+	}
+	for _, rel := range msg.GetRelationships() {
+		a.Relationships = append(a.Relationships, Relationship{Type: rel.GetType(), Target: rel.GetTarget()})
+	}
+	for _, item := range msg.GetRecentNews() {
+		a.RecentNews = append(a.RecentNews, NewsItem{
+			Title:       item.GetTitle(),
+			Link:        item.GetLink(),
+			PublishedAt: timeFromProto(item.GetPublishedAt()),
+			Sentiment:   item.GetSentiment(),
+		})
+	}
+	for _, opportunity := range msg.GetOpportunityPriorities() {
+		a.OpportunityPriorities = append(a.OpportunityPriorities, PrioritizedOpportunity{
+			Opportunity: opportunity.GetOpportunity(),
+			Weakness:    opportunity.GetWeakness(),
+			Priority:    opportunity.GetPriority(),
+		})
+	}
+	return a
+}
+
+// ToProtobuf encodes the report in protobuf wire format
+func (r *CompetitorReport) ToProtobuf() ([]byte, error) {
+	return proto.Marshal(r.ToProto())
+}
+
+// timestampProto converts a time, leaving zero times unset
+func timestampProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// timeFromProto converts a timestamp, mapping unset to the zero time
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,125 @@
+package adk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	competitorv1 "github.com/mk-knight23/ai-sdk-openai/proto/competitor/v1"
+)
+
+// TestReportProtoRoundTrip tests that every mirrored field survives protobuf encoding
+func TestReportProtoRoundTrip(t *testing.T) {
+	at := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	normalized := 1.25
+	report := &CompetitorReport{
+		ID:            "report-1",
+		GeneratedAt:   at,
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{{
+			CompetitorName:      "Acme",
+			ThreatLevel:         "High",
+			ThreatScore:         72.5,
+			EmergingThreat:      true,
+			EmergingPlayer:      true,
+			StalenessDiscount:   4.5,
+			Rank:                1,
+			ScoreBreakdown:      map[string]float64{ScoreComponentMarketShare: 40, ScoreComponentGrowth: 12.5},
+			MarketShare:         30,
+			NormalizedShare:     33.3,
+			Funding:             120,
+			Momentum:            MomentumRising,
+			Positioning:         "Premium",
+			KeyDifferentiators:  []string{"Brand"},
+			TechStack:           []string{"Go"},
+			Weaknesses:          []string{"Support"},
+			Opportunities:       []string{"Win on support"},
+			Risks:               []string{"Brand pull"},
+			ScreenshotURL:       "https://img.example/acme.png",
+			WebsiteStatus:       &WebsiteStatus{Reachable: false, StatusCode: 503, Error: "unavailable", CheckedAt: at},
+			Completeness:        0.9,
+			Confidence:          0.75,
+			Industries:          []string{"SaaS", "Fintech"},
+			Leadership:          []Person{{Name: "Ada", Role: "CEO", YearsExperience: 20}},
+			ActionPlan:          []string{"Match pricing"},
+			Notes:               []string{"Watch closely"},
+			PositioningInferred: true,
+			ShareUncertainty:    2.5,
+			Relationships:       []Relationship{{Type: RelationPartner, Target: "Beta"}},
+			ValuePosition:       "fair",
+			Category:            CompetitorDirect,
+			Customers:           25000,
+			RecentNews:          []NewsItem{{Title: "Acme raises funding", Link: "https://news.example/1", PublishedAt: at, Sentiment: SentimentPositive}},
+			BenchmarkNotes:      []string{"Unusually high price for SaaS (Enterprise vs typical Premium)"},
+			DataWarnings:        []string{"Customer count at odds with share"},
+			Provenance:          map[string]string{"market_share": "crunchbase"},
+			PricingTier:         "Premium",
+			Highlighted:         true,
+			FocusSummary:        "Acme leads",
+
+			NormalizedThreatScore: &normalized,
+			OpportunityPriorities: []PrioritizedOpportunity{{Opportunity: "Win on support", Weakness: "Support", Priority: 2.5}},
+			FeatureSupport:        map[string]FeatureSupport{"sso": FeatureYes, "api": FeatureNo},
+		}},
+		MarketInsights:        "Concentrated market",
+		Recommendations:       []string{"Invest in support"},
+		ExecutiveSummary:      "Acme is the main threat",
+		Persona:               PersonaSales,
+		RecommendationDetails: []Recommendation{{Text: "Invest in support", Priority: 1, Category: "product", Confidence: 0.8, Sources: []string{"Acme"}, Default: true, Rationale: "Acme is weak on support"}},
+		ConfidenceNote:        "Based on limited data",
+		RecommendationGroups:  []RecommendationGroup{{Category: "product", Recommendations: []string{"Invest in support"}}},
+		CategoryShares:        []CategoryShare{{Category: CompetitorDirect, Share: 30, Competitors: 1}},
+		OthersShare:           70,
+		OmittedCompetitors:    2,
+		TierOmissions:         map[string]int{ThreatMedium: 1},
+		FeatureMatrix:         &FeatureMatrix{Features: []string{"sso", "api"}, Competitors: []string{"Acme"}, Support: [][]FeatureSupport{{FeatureYes}, {FeatureNo}}, Gaps: []string{"api"}},
+		ExcludedByProduct:     1,
+		Meta:                  &ReportMeta{SourcesLastUpdated: map[string]time.Time{"stub": at}},
+		SkippedSteps:          []string{"news"},
+		Sampling:              &SamplingInfo{Method: SampleRandom, OriginalCount: 500, SampleSize: 50, Seed: 42},
+		Pseudonyms:            map[string]string{"Competitor 1": "Acme"},
+		Partial:               true,
+		MissingStages:         []string{StageReport},
+		ScoreNormalization:    ScoreNormalizationZScore,
+		Industries:            []string{"Fintech"},
+		IndustrySource:        IndustryInferred,
+		EmergingPlayers:       []string{"Acme"},
+		MarketViability:       &MarketViability{ReconsiderEntry: true, HHI: 4200, TopThreat: "Acme", TopThreatShare: 62, Advisory: "Reconsider market entry"},
+	}
+
+	encoded, err := report.ToProtobuf()
+	if err != nil {
+		t.Fatalf("ToProtobuf() error = %v", err)
+	}
+	msg := &competitorv1.CompetitorReport{}
+	if err := proto.Unmarshal(encoded, msg); err != nil {
+		t.Fatalf("Failed to decode protobuf: %v", err)
+	}
+	decoded := ReportFromProto(msg)
+
+	want, _ := json.Marshal(report)
+	got, _ := json.Marshal(decoded)
+	if string(want) != string(got) {
+		t.Errorf("Round trip changed the report:\nwant %s\ngot  %s", want, got)
+	}
+}
+
+// TestExport_Protobuf tests the protobuf export format
+func TestExport_Protobuf(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	report := &CompetitorReport{TargetCompany: "TestCorp", Competitors: []CompetitorAnalysis{{CompetitorName: "Acme", MarketShare: 12}}}
+
+	body, err := agent.Export(report, FormatProtobuf)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	msg := &competitorv1.CompetitorReport{}
+	if err := proto.Unmarshal(body, msg); err != nil {
+		t.Fatalf("Failed to decode protobuf: %v", err)
+	}
+	if msg.GetTargetCompany() != "TestCorp" || len(msg.GetCompetitors()) != 1 || msg.GetCompetitors()[0].GetMarketShare() != 12 {
+		t.Errorf("Unexpected protobuf export: %v", msg)
+	}
+}
@@ -0,0 +1,109 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// provenanceFields maps each sourced analysis field, by JSON name, to the
+// competitor data field it is derived from
+var provenanceFields = map[string]string{
+	"market_share":        "market_share",
+	"funding":             "funding",
+	"positioning":         "pricing",
+	"key_differentiators": "strengths",
+	"risks":               "strengths",
+	"weaknesses":          "weaknesses",
+	"opportunities":       "weaknesses",
+	"tech_stack":          "tech_stack",
+	"industries":          "industries",
+	"leadership":          "key_people",
+	"notes":               "notes",
+	"relationships":       "relationships",
+	"category":            "category",
+	"customers":           "customers",
+}
+
+// provenanceFor names the data source behind each populated analysis
+// field, preferring per-field sources from merged data over the record's
+// source. It returns nil when the data carries no provenance.
+func provenanceFor(competitor CompetitorData, analysis CompetitorAnalysis) map[string]string {
+	if competitor.Source == "" && len(competitor.FieldSources) == 0 {
+		return nil
+	}
+
+	populated := map[string]bool{
+		"market_share":        analysis.MarketShare != 0,
+		"funding":             analysis.Funding != 0,
+		"positioning":         competitor.Pricing != "" && !analysis.PositioningInferred,
+		"key_differentiators": len(competitor.Strengths) > 0,
+		"risks":               len(analysis.Risks) > 0,
+		"weaknesses":          len(analysis.Weaknesses) > 0,
+		"opportunities":       len(analysis.Opportunities) > 0,
+		"tech_stack":          len(analysis.TechStack) > 0,
+		"industries":          len(analysis.Industries) > 0,
+		"leadership":          len(analysis.Leadership) > 0,
+		"notes":               len(analysis.Notes) > 0,
+		"relationships":       len(analysis.Relationships) > 0,
+		"category":            competitor.Category != "",
+		"customers":           analysis.Customers != 0,
+	}
+
+	provenance := make(map[string]string)
+	for field, dataField := range provenanceFields {
+		if !populated[field] {
+			continue
+		}
+		source := competitor.FieldSources[dataField]
+		if source == "" {
+			source = competitor.Source
+		}
+		if source != "" {
+			provenance[field] = source
+		}
+	}
+	if len(provenance) == 0 {
+		return nil
+	}
+	return provenance
+}
+
+// citations numbers sources as Markdown footnotes in order of first use
+type citations struct {
+	refs    map[string]int
+	sources []string
+}
+
+// cite returns the footnote marker for field's source, or "" when the
+// field is unsourced or citations are off
+func (c *citations) cite(analysis CompetitorAnalysis, field string) string {
+	if c == nil {
+		return ""
+	}
+	source := analysis.Provenance[field]
+	if source == "" {
+		return ""
+	}
+	if c.refs == nil {
+		c.refs = make(map[string]int)
+	}
+	ref, ok := c.refs[source]
+	if !ok {
+		c.sources = append(c.sources, source)
+		ref = len(c.sources)
+		c.refs[source] = ref
+	}
+	return fmt.Sprintf(" [^%d]", ref)
+}
+
+// write appends the footnote definitions for every cited source
+func (c *citations) write(b *strings.Builder) {
+	if c == nil || len(c.sources) == 0 {
+		return
+	}
+	b.WriteString("## Sources\n\n")
+	for i, source := range c.sources {
+		fmt.Fprintf(b, "[^%d]: %s\n", i+1, strings.ReplaceAll(source, ",", ", "))
+	}
+	b.WriteString("\n")
+}
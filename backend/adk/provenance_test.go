@@ -0,0 +1,136 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze_Provenance(t *testing.T) {
+	data := []CompetitorData{
+		{
+			Name:         "Acme",
+			Pricing:      "Premium",
+			MarketShare:  30,
+			Funding:      120,
+			Strengths:    []string{"Brand"},
+			Weaknesses:   []string{"Support"},
+			Source:       "crm",
+			FieldSources: map[string]string{"market_share": "analyst", "strengths": "crm,web"},
+		},
+		{Name: "Unsourced", MarketShare: 10, Strengths: []string{"Price"}},
+	}
+
+	analyses, err := NewCompetitorIntelligenceAgent().Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	want := map[string]string{
+		"market_share":        "analyst",
+		"funding":             "crm",
+		"positioning":         "crm",
+		"key_differentiators": "crm,web",
+		"risks":               "crm,web",
+		"weaknesses":          "crm",
+		"opportunities":       "crm",
+	}
+	got := analyses[0].Provenance
+	if len(got) != len(want) {
+		t.Errorf("Expected provenance %v, got %v", want, got)
+	}
+	for field, source := range want {
+		if got[field] != source {
+			t.Errorf("Expected %s from %q, got %q", field, source, got[field])
+		}
+	}
+	if analyses[1].Provenance != nil {
+		t.Errorf("Expected no provenance without a source, got %v", analyses[1].Provenance)
+	}
+}
+
+func TestToMarkdown_Citations(t *testing.T) {
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{
+			{
+				CompetitorName:     "Acme",
+				Positioning:        "Premium",
+				MarketShare:        30,
+				Funding:            120,
+				KeyDifferentiators: []string{"Brand"},
+				Risks:              []string{"Strong brand"},
+				Provenance:         map[string]string{"positioning": "crm", "market_share": "analyst", "funding": "crm", "key_differentiators": "crm", "risks": "crm"},
+			},
+			{
+				CompetitorName: "Beta",
+				MarketShare:    10,
+				Provenance:     map[string]string{"market_share": "web"},
+			},
+		},
+	}
+
+	out := report.ToMarkdown(FormatOptions{Citations: true})
+
+	for _, want := range []string{
+		"- **Positioning:** Premium [^1]",
+		"- **Market share:** 30% [^2]",
+		"**Key differentiators** [^1]",
+		"**Risks** [^1]",
+		"- **Market share:** 10% [^3]",
+		"## Sources",
+		"[^1]: crm",
+		"[^2]: analyst",
+		"[^3]: web",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected Markdown to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Every marker resolves to a footnote definition
+	for _, ref := range regexp.MustCompile(`\[\^(\d+)\][^:]`).FindAllStringSubmatch(out, -1) {
+		if !strings.Contains(out, "[^"+ref[1]+"]: ") {
+			t.Errorf("Expected footnote %s to be defined", ref[1])
+		}
+	}
+
+	if plain := report.ToMarkdown(FormatOptions{}); strings.Contains(plain, "[^") || strings.Contains(plain, "## Sources") {
+		t.Errorf("Expected no citations by default, got:\n%s", plain)
+	}
+}
+
+func TestExport_ProvenanceMap(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{{
+		CompetitorName: "Acme",
+		Funding:        120,
+		Provenance:     map[string]string{"market_share": "analyst", "funding": "crm"},
+	}}}
+	agent := NewCompetitorIntelligenceAgent()
+
+	out, err := agent.Export(report, FormatJSON)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	var decoded CompetitorReport
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("Failed to decode export: %v", err)
+	}
+	if decoded.Competitors[0].Provenance["market_share"] != "analyst" {
+		t.Errorf("Expected a structured provenance map, got %s", out)
+	}
+
+	// Redacted fields lose their provenance too
+	public, err := agent.ExportProfile(report, FormatJSON, ProfilePublic)
+	if err != nil {
+		t.Fatalf("ExportProfile() error = %v", err)
+	}
+	if strings.Contains(string(public), `"funding"`) {
+		t.Errorf("Expected funding provenance redacted, got %s", public)
+	}
+	if report.Competitors[0].Provenance["funding"] != "crm" {
+		t.Error("Expected the report's provenance to be left intact")
+	}
+}
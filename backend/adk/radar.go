@@ -0,0 +1,115 @@
+package adk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Radar chart axes available to RadarData
+const (
+	AxisMarketShare = "market_share"
+	AxisBreadth     = "breadth"
+	AxisThreatScore = "threat_score"
+	AxisConfidence  = "confidence"
+)
+
+// DefaultRadarAxes is the axis set used when none is configured
+var DefaultRadarAxes = []string{AxisMarketShare, AxisBreadth, AxisThreatScore, AxisConfidence}
+
+// radarAxes extracts the raw value of each axis from an analysis
+var radarAxes = map[string]func(CompetitorAnalysis) float64{
+	AxisMarketShare: func(a CompetitorAnalysis) float64 { return a.MarketShare },
+	AxisBreadth:     func(a CompetitorAnalysis) float64 { return float64(len(a.KeyDifferentiators)) },
+	AxisThreatScore: func(a CompetitorAnalysis) float64 { return a.ThreatScore },
+	AxisConfidence:  func(a CompetitorAnalysis) float64 { return analysisConfidence(a) },
+}
+
+// RadarChart holds per-competitor values normalized to 0-1 on each axis
+type RadarChart struct {
+	Axes   []string      `json:"axes"`
+	Series []RadarSeries `json:"series"`
+}
+
+// RadarSeries is one competitor's normalized values, in axis order
+type RadarSeries struct {
+	Competitor string    `json:"competitor"`
+	Values     []float64 `json:"values"`
+}
+
+// RadarData min-max normalizes each axis across the report's competitors.
+// When every competitor has the same value on an axis, they all get 0.5.
+// An empty axes list selects DefaultRadarAxes.
+func (r *CompetitorReport) RadarData(axes []string) (*RadarChart, error) {
+	if len(axes) == 0 {
+		axes = DefaultRadarAxes
+	}
+	if err := validateRadarAxes(axes); err != nil {
+		return nil, err
+	}
+
+	chart := &RadarChart{
+		Axes:   append([]string(nil), axes...),
+		Series: make([]RadarSeries, len(r.Competitors)),
+	}
+	for i, analysis := range r.Competitors {
+		chart.Series[i] = RadarSeries{Competitor: analysis.CompetitorName, Values: make([]float64, len(axes))}
+	}
+
+	for j, axis := range axes {
+		value := radarAxes[axis]
+		lo, hi := 0.0, 0.0
+		for i, analysis := range r.Competitors {
+			v := value(analysis)
+			if i == 0 || v < lo {
+				lo = v
+			}
+			if i == 0 || v > hi {
+				hi = v
+			}
+		}
+
+		for i, analysis := range r.Competitors {
+			normalized := 0.5
+			if hi > lo {
+				normalized = (value(analysis) - lo) / (hi - lo)
+			}
+			chart.Series[i].Values[j] = round2(normalized)
+		}
+	}
+
+	return chart, nil
+}
+
+// ParseRadarAxes parses a comma-separated axis list, rejecting unknown axes
+func ParseRadarAxes(list string) ([]string, error) {
+	var axes []string
+	for _, axis := range strings.Split(list, ",") {
+		if axis = strings.TrimSpace(axis); axis != "" {
+			axes = append(axes, axis)
+		}
+	}
+	if err := validateRadarAxes(axes); err != nil {
+		return nil, err
+	}
+	return axes, nil
+}
+
+// validateRadarAxes rejects axes RadarData cannot compute
+func validateRadarAxes(axes []string) error {
+	for _, axis := range axes {
+		if _, ok := radarAxes[axis]; !ok {
+			return fmt.Errorf("%w: unknown radar axis %q", ErrInvalidInput, axis)
+		}
+	}
+	return nil
+}
+
+// radarJSON renders radar data for the configured axes
+func (a *CompetitorIntelligenceAgent) radarJSON(report *CompetitorReport) ([]byte, error) {
+	chart, err := report.RadarData(a.Config.RadarAxes)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(chart, "", "  ")
+}
@@ -0,0 +1,65 @@
+package adk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRadarData(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{CompetitorName: "A", MarketShare: 30, ThreatScore: 80, KeyDifferentiators: []string{"x", "y"}},
+		{CompetitorName: "B", MarketShare: 10, ThreatScore: 40, KeyDifferentiators: []string{"x"}},
+		{CompetitorName: "C", MarketShare: 20, ThreatScore: 60},
+	}}
+
+	chart, err := report.RadarData([]string{AxisMarketShare, AxisThreatScore, AxisBreadth})
+	if err != nil {
+		t.Fatalf("RadarData() error = %v", err)
+	}
+
+	want := map[string][]float64{
+		"A": {1, 1, 1},
+		"B": {0, 0, 0.5},
+		"C": {0.5, 0.5, 0},
+	}
+	for _, series := range chart.Series {
+		for j, value := range series.Values {
+			if value != want[series.Competitor][j] {
+				t.Errorf("%s %s: expected %v, got %v", series.Competitor, chart.Axes[j], want[series.Competitor][j], value)
+			}
+		}
+	}
+}
+
+func TestRadarData_AllEqual(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{CompetitorName: "A", MarketShare: 15, Confidence: 0.9},
+		{CompetitorName: "B", MarketShare: 15, Confidence: 0.9},
+	}}
+
+	chart, err := report.RadarData(nil)
+	if err != nil {
+		t.Fatalf("RadarData() error = %v", err)
+	}
+
+	if len(chart.Axes) != len(DefaultRadarAxes) {
+		t.Fatalf("Expected default axes, got %v", chart.Axes)
+	}
+	for _, series := range chart.Series {
+		for j, value := range series.Values {
+			if value != 0.5 {
+				t.Errorf("%s %s: expected 0.5 for all-equal input, got %v", series.Competitor, chart.Axes[j], value)
+			}
+		}
+	}
+}
+
+func TestParseRadarAxes(t *testing.T) {
+	axes, err := ParseRadarAxes("market_share, confidence")
+	if err != nil || len(axes) != 2 || axes[1] != AxisConfidence {
+		t.Errorf("Expected two parsed axes, got %v, %v", axes, err)
+	}
+	if _, err := ParseRadarAxes("market_share,charisma"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for unknown axis, got %v", err)
+	}
+}
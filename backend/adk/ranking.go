@@ -0,0 +1,81 @@
+package adk
+
+import (
+	"sort"
+	"time"
+)
+
+// assignRanks sets Rank (1 = greatest threat) by descending threat score,
+// settling equal scores with the tie-break chain
+func assignRanks(analyses []CompetitorAnalysis, chain []TieBreak) {
+	order := make([]int, len(analyses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return threatLess(analyses[order[i]], analyses[order[j]], chain)
+	})
+	for rank, i := range order {
+		analyses[i].Rank = rank + 1
+	}
+}
+
+// RankChange records a competitor moving between ranks across two runs
+type RankChange struct {
+	CompetitorName string `json:"competitor_name"`
+	FromRank       int    `json:"from_rank"`
+	ToRank         int    `json:"to_rank"`
+	// Delta is positive when the competitor climbed (became a bigger threat)
+	Delta        int       `json:"delta"`
+	FromReportID string    `json:"from_report_id"`
+	ToReportID   string    `json:"to_report_id"`
+	From         time.Time `json:"from"`
+	At           time.Time `json:"at"`
+}
+
+// RankingChanges builds a chronological feed of rank movements between
+// consecutive reports in history. Competitors missing from either report of
+// a pair, or whose rank did not change, produce no entry.
+func RankingChanges(history []*CompetitorReport) []RankChange {
+	return MaterialRankingChanges(history, 0)
+}
+
+// MaterialRankingChanges is RankingChanges limited to material moves: with a
+// positive minShareChange, a rank move only surfaces when the competitor's
+// share also moved more than minShareChange points or its threat level changed
+func MaterialRankingChanges(history []*CompetitorReport, minShareChange float64) []RankChange {
+	ordered := append([]*CompetitorReport(nil), history...)
+	sortReports(ordered)
+
+	changes := []RankChange{}
+	for i := 1; i < len(ordered); i++ {
+		previous, current := ordered[i-1], ordered[i]
+
+		prior := make(map[string]CompetitorAnalysis, len(previous.Competitors))
+		for _, analysis := range previous.Competitors {
+			prior[nameKey(analysis.CompetitorName)] = analysis
+		}
+
+		for _, analysis := range current.Competitors {
+			before, ok := prior[nameKey(analysis.CompetitorName)]
+			fromRank := before.Rank
+			if !ok || fromRank == 0 || analysis.Rank == 0 || fromRank == analysis.Rank {
+				continue
+			}
+			if minShareChange > 0 && !materialChange(round2(analysis.MarketShare-before.MarketShare), before.ThreatLevel, analysis.ThreatLevel, minShareChange) {
+				continue
+			}
+			changes = append(changes, RankChange{
+				CompetitorName: analysis.CompetitorName,
+				FromRank:       fromRank,
+				ToRank:         analysis.Rank,
+				Delta:          fromRank - analysis.Rank,
+				FromReportID:   previous.ID,
+				ToReportID:     current.ID,
+				From:           previous.GeneratedAt,
+				At:             current.GeneratedAt,
+			})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,87 @@
+package adk
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestGenerateReport_AssignsRanks tests that ranks follow threat scores
+func TestGenerateReport_AssignsRanks(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	analyses := []CompetitorAnalysis{
+		{CompetitorName: "Low", ThreatScore: 10},
+		{CompetitorName: "High", ThreatScore: 80},
+		{CompetitorName: "Mid", ThreatScore: 40},
+	}
+
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	want := map[string]int{"High": 1, "Mid": 2, "Low": 3}
+	for _, analysis := range report.Competitors {
+		if analysis.Rank != want[analysis.CompetitorName] {
+			t.Errorf("%s rank = %d, want %d", analysis.CompetitorName, analysis.Rank, want[analysis.CompetitorName])
+		}
+	}
+
+	if analyses[0].Rank != 0 {
+		t.Error("GenerateReport should not mutate the caller's analyses")
+	}
+}
+
+// TestRankingChanges tests that a rank swap between runs appears in the feed
+func TestRankingChanges(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []*CompetitorReport{
+		{
+			ID:          "second",
+			GeneratedAt: base.Add(24 * time.Hour),
+			Competitors: []CompetitorAnalysis{
+				{CompetitorName: "Competitor A", Rank: 3},
+				{CompetitorName: "Competitor B", Rank: 2},
+				{CompetitorName: "Competitor C", Rank: 1},
+			},
+		},
+		{
+			ID:          "first",
+			GeneratedAt: base,
+			Competitors: []CompetitorAnalysis{
+				{CompetitorName: "Competitor A", Rank: 1},
+				{CompetitorName: "Competitor B", Rank: 2},
+				{CompetitorName: "Competitor C", Rank: 3},
+			},
+		},
+	}
+
+	changes := RankingChanges(history)
+
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 rank changes, got %d: %+v", len(changes), changes)
+	}
+
+	byName := make(map[string]RankChange)
+	for _, change := range changes {
+		byName[change.CompetitorName] = change
+	}
+
+	c := byName["Competitor C"]
+	if c.FromRank != 3 || c.ToRank != 1 || c.Delta != 2 {
+		t.Errorf("Competitor C change = %+v, want 3 -> 1 (delta 2)", c)
+	}
+	if c.FromReportID != "first" || c.ToReportID != "second" || !c.At.Equal(base.Add(24*time.Hour)) {
+		t.Errorf("Competitor C change has wrong report references: %+v", c)
+	}
+
+	a := byName["Competitor A"]
+	if a.FromRank != 1 || a.ToRank != 3 || a.Delta != -2 {
+		t.Errorf("Competitor A change = %+v, want 1 -> 3 (delta -2)", a)
+	}
+
+	if _, ok := byName["Competitor B"]; ok {
+		t.Error("Expected no entry for an unchanged rank")
+	}
+}
@@ -0,0 +1,128 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Rationales for recommendations not triggered by particular competitors
+const (
+	genericRationale = "No competitor in this analysis triggered a specific recommendation; this is standard competitive practice."
+	defaultRationale = "Configured house recommendation."
+)
+
+// rationaleKey is the context key carrying a per-run rationale toggle
+type rationaleKey struct{}
+
+// WithRationale returns a context that makes Run explain each
+// recommendation, or not, overriding the agent's configured default
+func WithRationale(ctx context.Context, on bool) context.Context {
+	return context.WithValue(ctx, rationaleKey{}, on)
+}
+
+// rationaleFor reports whether a run explains its recommendations,
+// preferring a context override
+func (a *CompetitorIntelligenceAgent) rationaleFor(ctx context.Context) bool {
+	if on, ok := ctx.Value(rationaleKey{}).(bool); ok {
+		return on
+	}
+	return a.Config.WithRationale
+}
+
+// differentiationRationale names the weaknesses competitors can be beaten on
+func differentiationRationale(supporting []CompetitorAnalysis) string {
+	parts := make([]string, 0, len(supporting))
+	for _, analysis := range supporting {
+		gaps := analysis.Weaknesses
+		if len(gaps) == 0 {
+			gaps = analysis.Opportunities
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s)", analysis.CompetitorName, strings.Join(gaps, ", ")))
+	}
+	return "Competitor weaknesses to differentiate on: " + strings.Join(parts, "; ") + "."
+}
+
+// pricingGapRationale names the competitors crowding the top of the market
+func pricingGapRationale(supporting []CompetitorAnalysis) string {
+	names := make([]string, len(supporting))
+	for i, analysis := range supporting {
+		names[i] = fmt.Sprintf("%s (%s)", analysis.CompetitorName, analysis.Positioning)
+	}
+	return fmt.Sprintf("%s %s positioned at the top of the market, leaving mid-market pricing open.", joinNames(names), verbFor(len(names)))
+}
+
+// supportRationale names the competitors weak on customer support
+func supportRationale(supporting []CompetitorAnalysis) string {
+	return fmt.Sprintf("Customer support is a weakness of %s.", joinNames(competitorNames(supporting)))
+}
+
+// integrationRationale separates competitors lacking integrations from
+// those differentiating on them
+func integrationRationale(supporting []CompetitorAnalysis) string {
+	var weak, strong []string
+	for _, analysis := range supporting {
+		if mentions(analysis.Weaknesses, "integration") {
+			weak = append(weak, analysis.CompetitorName)
+		} else {
+			strong = append(strong, analysis.CompetitorName)
+		}
+	}
+
+	var parts []string
+	if len(weak) > 0 {
+		parts = append(parts, fmt.Sprintf("%s %s integrations", joinNames(weak), pluralVerb(len(weak), "lacks", "lack")))
+	}
+	if len(strong) > 0 {
+		parts = append(parts, fmt.Sprintf("%s %s on them", joinNames(strong), pluralVerb(len(strong), "differentiates", "differentiate")))
+	}
+	return strings.Join(parts, "; ") + "."
+}
+
+// pricingWatchRationale describes the market the competitors' prices shape
+func pricingWatchRationale(supporting []CompetitorAnalysis) string {
+	var total float64
+	leader := supporting[0]
+	for _, analysis := range supporting {
+		total += analysis.MarketShare
+		if analysis.MarketShare > leader.MarketShare {
+			leader = analysis
+		}
+	}
+	return fmt.Sprintf("Tracked competitors hold %s%% of the market, led by %s at %s%%, so their price moves shape buyer expectations.",
+		formatFloat(round2(total)), leader.CompetitorName, formatFloat(leader.MarketShare))
+}
+
+// competitorNames lists the analyses' competitor names
+func competitorNames(analyses []CompetitorAnalysis) []string {
+	names := make([]string, len(analyses))
+	for i, analysis := range analyses {
+		names[i] = analysis.CompetitorName
+	}
+	return names
+}
+
+// joinNames joins names as prose: "A", "A and B", "A, B and C"
+func joinNames(names []string) string {
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return names[0]
+	default:
+		return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	}
+}
+
+// verbFor conjugates "to be" for n subjects
+func verbFor(n int) string {
+	return pluralVerb(n, "is", "are")
+}
+
+// pluralVerb picks the singular or plural verb form for n subjects
+func pluralVerb(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,113 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRecommendationRationale(t *testing.T) {
+	analyses := []CompetitorAnalysis{
+		{
+			CompetitorName:     "Acme",
+			MarketShare:        30,
+			Positioning:        "Enterprise",
+			Weaknesses:         []string{"Slow customer support"},
+			KeyDifferentiators: []string{"Deep integrations"},
+		},
+		{
+			CompetitorName: "Globex",
+			MarketShare:    10,
+			Positioning:    "Budget",
+			Weaknesses:     []string{"Few integrations"},
+		},
+	}
+
+	agent := NewCompetitorIntelligenceAgent(
+		WithRecommendationRationale(),
+		WithMinRecommendations(7),
+		WithDefaultRecommendations("Review with legal"),
+	)
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	// Each triggered recommendation names the competitors behind it
+	want := map[string][]string{
+		"Focus on differentiation in areas where competitors are weak": {"Acme (Slow customer support)", "Globex (Few integrations)"},
+		"Target mid-market segment with competitive pricing":           {"Acme (Enterprise)"},
+		"Invest in customer support to outperform competitors":         {"Acme"},
+		"Develop integrations to match competitor ecosystems":          {"Globex lacks integrations", "Acme differentiates on them"},
+		"Monitor competitor pricing and adjust strategy quarterly":     {"40%", "led by Acme at 30%"},
+		"Review with legal": {defaultRationale},
+	}
+
+	if len(report.RecommendationDetails) < len(want)+1 {
+		t.Fatalf("Expected padded recommendations, got %d", len(report.RecommendationDetails))
+	}
+	for _, rec := range report.RecommendationDetails {
+		if rec.Rationale == "" {
+			t.Errorf("Expected a rationale for %q", rec.Text)
+			continue
+		}
+		fragments, ok := want[rec.Text]
+		if !ok {
+			if rec.Rationale != genericRationale {
+				t.Errorf("Expected the generic rationale for %q, got %q", rec.Text, rec.Rationale)
+			}
+			continue
+		}
+		for _, fragment := range fragments {
+			if !strings.Contains(rec.Rationale, fragment) {
+				t.Errorf("Expected rationale for %q to mention %q, got %q", rec.Text, fragment, rec.Rationale)
+			}
+		}
+	}
+}
+
+func TestRecommendationRationale_Toggle(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		ctx     context.Context
+		wantAny bool
+	}{
+		{name: "Off by default", ctx: context.Background()},
+		{name: "Configured", opts: []Option{WithRecommendationRationale()}, ctx: context.Background(), wantAny: true},
+		{name: "Context enables", ctx: WithRationale(context.Background(), true), wantAny: true},
+		{name: "Context disables", opts: []Option{WithRecommendationRationale()}, ctx: WithRationale(context.Background(), false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := NewCompetitorIntelligenceAgent(tt.opts...).Run(tt.ctx, "TestCorp", "SaaS")
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			for _, rec := range report.RecommendationDetails {
+				if (rec.Rationale != "") != tt.wantAny {
+					t.Errorf("Expected rationale present = %v for %q, got %q", tt.wantAny, rec.Text, rec.Rationale)
+				}
+			}
+		})
+	}
+}
+
+func TestJoinNames(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{names: nil, want: ""},
+		{names: []string{"A"}, want: "A"},
+		{names: []string{"A", "B"}, want: "A and B"},
+		{names: []string{"A", "B", "C"}, want: "A, B and C"},
+	}
+
+	for _, tt := range tests {
+		if got := joinNames(tt.names); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
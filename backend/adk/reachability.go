@@ -0,0 +1,158 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// unreachablePenalty scales confidence for competitors whose site is down
+const unreachablePenalty = 0.8
+
+// maxConcurrentProbes bounds how many websites are checked at once
+const maxConcurrentProbes = 8
+
+// Fixed failure reasons recorded in WebsiteStatus.Error
+const (
+	probeTimeout     = "timeout"
+	probeUnreachable = "unreachable"
+)
+
+// errInternalAddress refuses probes that would connect to an internal address
+var errInternalAddress = errors.New("refusing to dial an internal address")
+
+// WebsiteStatus records the outcome of a website reachability check
+type WebsiteStatus struct {
+	Reachable  bool      `json:"reachable"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	CheckedAt  time.Time `json:"checked_at"`
+}
+
+// ReachabilityEnricher HEAD-requests each competitor website and records
+// the result in WebsiteStatus. Sites answering with a status below 400 are
+// reachable; servers rejecting HEAD are retried with GET. Redirects are not
+// followed.
+type ReachabilityEnricher struct {
+	Timeout time.Duration
+	Client  *http.Client
+}
+
+// NewReachabilityEnricher creates a reachability check with a per-site
+// timeout, using a client that only connects to public addresses
+func NewReachabilityEnricher(timeout time.Duration) *ReachabilityEnricher {
+	return &ReachabilityEnricher{
+		Timeout: timeout,
+		Client:  publicOnlyClient(),
+	}
+}
+
+// publicOnlyClient returns an HTTP client that does not follow redirects
+// and refuses to dial loopback, private or link-local addresses, whatever
+// a website's name resolves to
+func publicOnlyClient() *http.Client {
+	dialer := &net.Dialer{
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+				return errInternalAddress
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Name identifies the enrichment step
+func (e *ReachabilityEnricher) Name() string {
+	return "reachability"
+}
+
+// Enrich sets WebsiteStatus for each competitor with a website, checking up
+// to maxConcurrentProbes sites at once. Unreachable sites are recorded, not
+// returned as errors.
+func (e *ReachabilityEnricher) Enrich(ctx context.Context, data []CompetitorData) error {
+	slots := make(chan struct{}, maxConcurrentProbes)
+	var wg sync.WaitGroup
+	for i := range data {
+		if data[i].Website == "" {
+			continue
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			data[i].WebsiteStatus = e.check(ctx, data[i].Website)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// check probes website once with HEAD, falling back to GET when HEAD is not allowed
+func (e *ReachabilityEnricher) check(ctx context.Context, website string) *WebsiteStatus {
+	status := &WebsiteStatus{CheckedAt: time.Now()}
+
+	code, err := e.probe(ctx, http.MethodHead, website)
+	if err == nil && code == http.StatusMethodNotAllowed {
+		code, err = e.probe(ctx, http.MethodGet, website)
+	}
+	if err != nil {
+		status.Error = probeFailure(err)
+		return status
+	}
+
+	status.StatusCode = code
+	status.Reachable = code < http.StatusBadRequest
+	return status
+}
+
+// probeFailure reduces a probe error to a fixed reason, keeping addresses
+// and resolver details out of reports
+func probeFailure(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return probeTimeout
+	}
+	return probeUnreachable
+}
+
+// probe issues a single request and returns the response status
+func (e *ReachabilityEnricher) probe(ctx context.Context, method, website string) (int, error) {
+	if e.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, website, nil)
+	if err != nil {
+		return 0, fmt.Errorf("invalid website URL: %w", err)
+	}
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
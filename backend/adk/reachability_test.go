@@ -0,0 +1,115 @@
+package adk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestReachabilityEnricher tests that website statuses are recorded per competitor
+func TestReachabilityEnricher(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/up":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/up", http.StatusFound)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer site.Close()
+
+	data := []CompetitorData{
+		{Name: "Up", Website: site.URL + "/up"},
+		{Name: "Missing", Website: site.URL + "/missing"},
+		{Name: "Get Only", Website: site.URL + "/get-only"},
+		{Name: "Down", Website: "http://127.0.0.1:1"},
+		{Name: "No Site"},
+		{Name: "Redirect", Website: site.URL + "/redirect"},
+	}
+
+	// The test server listens on loopback, which the default client refuses
+	enricher := NewReachabilityEnricher(time.Second)
+	enricher.Client.Transport = http.DefaultTransport
+	if err := enricher.Enrich(context.Background(), data); err != nil {
+		t.Fatalf("Expected reachability failures to be non-fatal, got %v", err)
+	}
+
+	tests := []struct {
+		index      int
+		reachable  bool
+		statusCode int
+	}{
+		{0, true, http.StatusOK},
+		{1, false, http.StatusNotFound},
+		{2, true, http.StatusOK},
+		{3, false, 0},
+		{5, true, http.StatusFound},
+	}
+	for _, tt := range tests {
+		status := data[tt.index].WebsiteStatus
+		if status == nil {
+			t.Errorf("%s: expected a website status", data[tt.index].Name)
+			continue
+		}
+		if status.Reachable != tt.reachable || status.StatusCode != tt.statusCode {
+			t.Errorf("%s: expected reachable=%v status=%d, got reachable=%v status=%d",
+				data[tt.index].Name, tt.reachable, tt.statusCode, status.Reachable, status.StatusCode)
+		}
+	}
+	if data[3].WebsiteStatus != nil && data[3].WebsiteStatus.Error != probeUnreachable {
+		t.Errorf("Expected connection failures to record %q, got %q", probeUnreachable, data[3].WebsiteStatus.Error)
+	}
+	if data[4].WebsiteStatus != nil {
+		t.Error("Expected no status for a competitor without a website")
+	}
+}
+
+// TestReachabilityEnricher_InternalAddress tests that the default client
+// refuses to probe loopback addresses
+func TestReachabilityEnricher_InternalAddress(t *testing.T) {
+	var hits atomic.Int32
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer site.Close()
+
+	data := []CompetitorData{{Name: "Internal", Website: site.URL}}
+	if err := NewReachabilityEnricher(time.Second).Enrich(context.Background(), data); err != nil {
+		t.Fatalf("Enrich() error = %v", err)
+	}
+
+	status := data[0].WebsiteStatus
+	if status == nil || status.Reachable || status.Error != probeUnreachable {
+		t.Errorf("Expected an unreachable status, got %+v", status)
+	}
+	if hits.Load() != 0 {
+		t.Errorf("Expected no request to reach the internal server, got %d", hits.Load())
+	}
+}
+
+// TestDataConfidence_UnreachableWebsite tests that unreachable sites lower confidence
+func TestDataConfidence_UnreachableWebsite(t *testing.T) {
+	now := time.Now()
+	reachable := completeData("Up")
+	reachable.RetrievedAt, reachable.WebsiteStatus = now, &WebsiteStatus{Reachable: true, StatusCode: 200}
+	unreachable := completeData("Down")
+	unreachable.RetrievedAt, unreachable.WebsiteStatus = now, &WebsiteStatus{StatusCode: 404}
+
+	if got := dataConfidence(reachable, now); got != 1 {
+		t.Errorf("Expected full confidence for a reachable site, got %v", got)
+	}
+	if got := dataConfidence(unreachable, now); got != unreachablePenalty {
+		t.Errorf("Expected confidence %v for an unreachable site, got %v", unreachablePenalty, got)
+	}
+}
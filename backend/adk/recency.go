@@ -0,0 +1,119 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+)
+
+// defaultObservationHalfLife is how long until a dated strength or weakness
+// counts half as much as a fresh one
+const defaultObservationHalfLife = 180 * 24 * time.Hour
+
+// Observation is a strength or weakness with when it was observed. In JSON
+// it may also be given as a plain string.
+type Observation struct {
+	Text       string    `json:"text"`
+	ObservedAt time.Time `json:"observed_at,omitempty"`
+}
+
+// UnmarshalJSON accepts either a bare string or an object
+func (o *Observation) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		*o = Observation{}
+		return json.Unmarshal(data, &o.Text)
+	}
+	type plain Observation
+	return json.Unmarshal(data, (*plain)(o))
+}
+
+// UnmarshalJSON reads strengths and weaknesses given as plain strings or as
+// dated observations, recording observation times in ObservedAt
+func (c *CompetitorData) UnmarshalJSON(data []byte) error {
+	type plain CompetitorData
+	aux := struct {
+		*plain
+		Strengths  []Observation `json:"strengths"`
+		Weaknesses []Observation `json:"weaknesses"`
+	}{plain: (*plain)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	observe := func(observations []Observation) []string {
+		texts := make([]string, len(observations))
+		for i, o := range observations {
+			texts[i] = o.Text
+			if !o.ObservedAt.IsZero() {
+				if c.ObservedAt == nil {
+					c.ObservedAt = make(map[string]time.Time)
+				}
+				c.ObservedAt[o.Text] = o.ObservedAt
+			}
+		}
+		return texts
+	}
+	if aux.Strengths != nil {
+		c.Strengths = observe(aux.Strengths)
+	}
+	if aux.Weaknesses != nil {
+		c.Weaknesses = observe(aux.Weaknesses)
+	}
+	return nil
+}
+
+// mergeObservedAt copies observation times, keeping the newest per entry
+func mergeObservedAt(into map[string]time.Time, from map[string]time.Time) map[string]time.Time {
+	for text, at := range from {
+		if into == nil {
+			into = make(map[string]time.Time)
+		}
+		if at.After(into[text]) {
+			into[text] = at
+		}
+	}
+	return into
+}
+
+// observationHalfLife returns the configured recency half-life
+func (a *CompetitorIntelligenceAgent) observationHalfLife() time.Duration {
+	if a.Config.ObservationHalfLife > 0 {
+		return a.Config.ObservationHalfLife
+	}
+	return defaultObservationHalfLife
+}
+
+// observationWeight scores an entry's recency: 1 for undated or fresh
+// entries, halving every half-life of age
+func (a *CompetitorIntelligenceAgent) observationWeight(competitor CompetitorData, text string, now time.Time) float64 {
+	observedAt := competitor.ObservedAt[text]
+	if observedAt.IsZero() || !observedAt.Before(now) {
+		return 1
+	}
+	return math.Pow(0.5, float64(now.Sub(observedAt))/float64(a.observationHalfLife()))
+}
+
+// recencyWeight totals the recency weights of entries; without timestamps
+// it is simply their count
+func (a *CompetitorIntelligenceAgent) recencyWeight(competitor CompetitorData, entries []string, now time.Time) float64 {
+	total := 0.0
+	for _, text := range entries {
+		total += a.observationWeight(competitor, text, now)
+	}
+	return total
+}
+
+// byRecency returns entries ordered most recent first, keeping the given
+// order among undated entries and ties
+func (a *CompetitorIntelligenceAgent) byRecency(competitor CompetitorData, entries []string, now time.Time) []string {
+	if len(competitor.ObservedAt) == 0 {
+		return entries
+	}
+	sorted := append([]string(nil), entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return a.observationWeight(competitor, sorted[i], now) > a.observationWeight(competitor, sorted[j], now)
+	})
+	return sorted
+}
@@ -0,0 +1,80 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAnalyze_RecencyWeighting(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), analysisTimeKey{}, now)
+	agent := NewCompetitorIntelligenceAgent()
+
+	stale := CompetitorData{
+		Name:        "Acme",
+		MarketShare: 15,
+		Weaknesses:  []string{"Slow support", "Limited features"},
+		ObservedAt: map[string]time.Time{
+			"Slow support":     now.AddDate(-2, 0, 0),
+			"Limited features": now.AddDate(0, 0, -7),
+		},
+	}
+	undated := stale
+	undated.ObservedAt = nil
+
+	analyses, err := agent.Analyze(ctx, []CompetitorData{stale, undated})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	weighted, plain := analyses[0], analyses[1]
+
+	if want := agent.opportunityText("Limited features"); weighted.Opportunities[0] != want {
+		t.Errorf("Expected the recent weakness to lead opportunities, got %v", weighted.Opportunities)
+	}
+	if want := agent.opportunityText("Slow support"); plain.Opportunities[0] != want {
+		t.Errorf("Expected undated weaknesses to keep their order, got %v", plain.Opportunities)
+	}
+	if weighted.ThreatScore <= plain.ThreatScore {
+		t.Errorf("Expected a stale weakness to count less against the threat score, got %v and %v", weighted.ThreatScore, plain.ThreatScore)
+	}
+}
+
+func TestCompetitorData_UnmarshalObservations(t *testing.T) {
+	var competitor CompetitorData
+	err := json.Unmarshal([]byte(`{
+		"name": "Acme",
+		"strengths": ["Strong brand", {"text": "Fast growth", "observed_at": "2024-05-01T00:00:00Z"}],
+		"weaknesses": [{"text": "Slow support"}]
+	}`), &competitor)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if len(competitor.Strengths) != 2 || competitor.Strengths[0] != "Strong brand" || competitor.Strengths[1] != "Fast growth" {
+		t.Errorf("Expected plain strength texts, got %v", competitor.Strengths)
+	}
+	if len(competitor.Weaknesses) != 1 || competitor.Weaknesses[0] != "Slow support" {
+		t.Errorf("Expected plain weakness texts, got %v", competitor.Weaknesses)
+	}
+	if got := competitor.ObservedAt["Fast growth"]; !got.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected Fast growth observed on 2024-05-01, got %v", got)
+	}
+	if len(competitor.ObservedAt) != 1 {
+		t.Errorf("Expected only dated entries in ObservedAt, got %v", competitor.ObservedAt)
+	}
+
+	// Round-trips through the plain string form
+	data, err := json.Marshal(competitor)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded CompetitorData
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(decoded.Strengths) != 2 || !decoded.ObservedAt["Fast growth"].Equal(competitor.ObservedAt["Fast growth"]) {
+		t.Errorf("Expected observations to round-trip, got %+v", decoded)
+	}
+}
@@ -0,0 +1,53 @@
+package adk
+
+// defaultMinRecommendations keeps every baseline rule in reports by default
+var defaultMinRecommendations = len(baselineRecommendations)
+
+// genericRecommendations pad reports once untriggered baseline advice runs out
+var genericRecommendations = []recommendationRule{
+	{
+		text:     "Track win/loss outcomes against each competitor",
+		priority: 4,
+		category: CategoryGTM,
+	},
+	{
+		text:     "Review competitor positioning against your roadmap each quarter",
+		priority: 4,
+		category: CategoryProduct,
+	},
+	{
+		text:     "Gather more competitor data to sharpen these recommendations",
+		priority: 5,
+		category: CategoryOps,
+	},
+}
+
+// minRecommendations returns the configured recommendation floor
+func (a *CompetitorIntelligenceAgent) minRecommendations() int {
+	if a.Config.MinRecommendations > 0 {
+		return a.Config.MinRecommendations
+	}
+	return defaultMinRecommendations
+}
+
+// padRecommendations appends generic advice from candidates, in order, until
+// the floor is met. Padding is general advice, as certain as the data overall.
+func (a *CompetitorIntelligenceAgent) padRecommendations(recommendations []Recommendation, candidates []recommendationRule, analyses []CompetitorAnalysis) []Recommendation {
+	floor := a.minRecommendations()
+	for _, rule := range candidates {
+		if len(recommendations) >= floor {
+			break
+		}
+		text := applyTone(a.Config.Tone, rule.text)
+		if hasRecommendation(recommendations, text) {
+			continue
+		}
+		recommendations = append(recommendations, Recommendation{
+			Text:       text,
+			Priority:   rule.priority,
+			Category:   rule.category,
+			Confidence: averageConfidence(analyses),
+		})
+	}
+	return recommendations
+}
@@ -0,0 +1,71 @@
+package adk
+
+import (
+	"context"
+	"testing"
+)
+
+// TestGenerateReport_MinRecommendations tests padding thin reports up to the floor
+func TestGenerateReport_MinRecommendations(t *testing.T) {
+	thin := []CompetitorAnalysis{{CompetitorName: "Thin Co", Confidence: 0.5}}
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		analyses  []CompetitorAnalysis
+		wantCount int
+	}{
+		{name: "Default keeps five", analyses: thin, wantCount: 5},
+		{name: "No analyses still meets default", wantCount: 5},
+		{name: "Floor beyond baseline", opts: []Option{WithMinRecommendations(7)}, analyses: thin, wantCount: 7},
+		{name: "Low floor keeps triggered only", opts: []Option{WithMinRecommendations(1)}, analyses: thin, wantCount: 1},
+		{name: "Floor capped by available advice", opts: []Option{WithMinRecommendations(20)}, analyses: thin, wantCount: len(baselineRecommendations) + len(genericRecommendations)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(tt.opts...)
+			report, err := agent.GenerateReport(context.Background(), "TestCorp", tt.analyses)
+			if err != nil {
+				t.Fatalf("GenerateReport() error = %v", err)
+			}
+
+			if len(report.Recommendations) != tt.wantCount {
+				t.Errorf("Expected %d recommendations, got %d: %v", tt.wantCount, len(report.Recommendations), report.Recommendations)
+			}
+
+			seen := make(map[string]bool)
+			for i, rec := range report.RecommendationDetails {
+				if seen[rec.Text] {
+					t.Errorf("Duplicate recommendation %q", rec.Text)
+				}
+				seen[rec.Text] = true
+				if i > 0 && rec.Priority < report.RecommendationDetails[i-1].Priority {
+					t.Errorf("Expected recommendations in priority order, got %+v", report.RecommendationDetails)
+				}
+			}
+		})
+	}
+}
+
+// TestGenerateReport_MinRecommendationsTriggeredFirst tests that triggered
+// rules keep their evidence and padding does not repeat them
+func TestGenerateReport_MinRecommendationsTriggeredFirst(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithMinRecommendations(2))
+	analyses := []CompetitorAnalysis{{CompetitorName: "Rival", Weaknesses: []string{"Poor support"}, Confidence: 0.8}}
+
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	// Differentiation, support and pricing monitoring all trigger
+	if len(report.RecommendationDetails) != 3 {
+		t.Fatalf("Expected 3 triggered recommendations, got %+v", report.RecommendationDetails)
+	}
+	for _, rec := range report.RecommendationDetails {
+		if len(rec.Sources) == 0 {
+			t.Errorf("Expected triggered recommendation %q to cite sources", rec.Text)
+		}
+	}
+}
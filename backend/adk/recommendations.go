@@ -0,0 +1,194 @@
+package adk
+
+import (
+	"sort"
+	"strings"
+)
+
+// Recommendation is a strategic recommendation with the evidence behind it
+type Recommendation struct {
+	Text     string                 `json:"text"`
+	Priority int                    `json:"priority"` // 1 is the highest priority
+	Category RecommendationCategory `json:"category"`
+	// Confidence is derived from the data of the competitors that triggered it
+	Confidence float64  `json:"confidence"`
+	Sources    []string `json:"sources,omitempty"`
+	// Default marks house recommendations configured on the agent
+	Default bool `json:"default,omitempty"`
+	// Rationale explains the analysis behind the recommendation, when requested
+	Rationale string `json:"rationale,omitempty"`
+}
+
+// recommendationRule produces a baseline recommendation. applies selects the
+// competitors that support it; nil means every competitor does. rationale
+// explains the recommendation from those competitors.
+type recommendationRule struct {
+	text      string
+	priority  int
+	category  RecommendationCategory
+	applies   func(CompetitorAnalysis) bool
+	rationale func([]CompetitorAnalysis) string
+}
+
+// baselineRecommendations are always emitted, with confidence drawn from
+// whichever competitors trigger them
+var baselineRecommendations = []recommendationRule{
+	{
+		text:     "Focus on differentiation in areas where competitors are weak",
+		priority: 1,
+		category: CategoryProduct,
+		applies: func(a CompetitorAnalysis) bool {
+			return len(a.Weaknesses) > 0 || len(a.Opportunities) > 0
+		},
+		rationale: differentiationRationale,
+	},
+	{
+		text:     "Target mid-market segment with competitive pricing",
+		priority: 2,
+		category: CategoryPricing,
+		applies: func(a CompetitorAnalysis) bool {
+			return strings.Contains(a.Positioning, "Premium") || strings.Contains(a.Positioning, "Enterprise")
+		},
+		rationale: pricingGapRationale,
+	},
+	{
+		text:     "Invest in customer support to outperform competitors",
+		priority: 2,
+		category: CategoryOps,
+		applies: func(a CompetitorAnalysis) bool {
+			return mentions(a.Weaknesses, "support") || mentions(a.Opportunities, "support")
+		},
+		rationale: supportRationale,
+	},
+	{
+		text:     "Develop integrations to match competitor ecosystems",
+		priority: 3,
+		category: CategoryProduct,
+		applies: func(a CompetitorAnalysis) bool {
+			return mentions(a.Weaknesses, "integration") || mentions(a.KeyDifferentiators, "integration")
+		},
+		rationale: integrationRationale,
+	},
+	{
+		text:      "Monitor competitor pricing and adjust strategy quarterly",
+		priority:  3,
+		category:  CategoryPricing,
+		rationale: pricingWatchRationale,
+	},
+}
+
+// buildRecommendations evaluates the baseline rules against the analyses,
+// padding with generic advice up to the configured minimum. With explain,
+// each recommendation carries its rationale.
+func (a *CompetitorIntelligenceAgent) buildRecommendations(analyses []CompetitorAnalysis, explain bool) []Recommendation {
+	recommendations := make([]Recommendation, 0, len(baselineRecommendations))
+	var untriggered []recommendationRule
+
+	for _, rule := range baselineRecommendations {
+		var supporting []CompetitorAnalysis
+		for _, analysis := range analyses {
+			if rule.applies == nil || rule.applies(analysis) {
+				supporting = append(supporting, analysis)
+			}
+		}
+		if len(supporting) == 0 {
+			untriggered = append(untriggered, rule)
+			continue
+		}
+
+		rec := Recommendation{
+			Text:       applyTone(a.Config.Tone, rule.text),
+			Priority:   rule.priority,
+			Category:   rule.category,
+			Confidence: averageConfidence(supporting),
+		}
+		for _, analysis := range supporting {
+			rec.Sources = append(rec.Sources, analysis.CompetitorName)
+		}
+		if explain && rule.rationale != nil {
+			rec.Rationale = rule.rationale(supporting)
+		}
+		recommendations = append(recommendations, rec)
+	}
+
+	recommendations = a.padRecommendations(recommendations, append(untriggered, genericRecommendations...), analyses)
+	sortRecommendations(recommendations)
+	recommendations = appendDefaultRecommendations(recommendations, a.Config.DefaultRecommendations)
+
+	// Padding and house advice rest on no particular competitor
+	if explain {
+		for i, rec := range recommendations {
+			switch {
+			case rec.Rationale != "":
+			case rec.Default:
+				recommendations[i].Rationale = defaultRationale
+			default:
+				recommendations[i].Rationale = genericRationale
+			}
+		}
+	}
+	return recommendations
+}
+
+// appendDefaultRecommendations adds the configured house recommendations
+// after the generated ones, skipping any that duplicate an earlier entry
+func appendDefaultRecommendations(recommendations []Recommendation, defaults []string) []Recommendation {
+	priority := 0
+	for _, rec := range recommendations {
+		priority = max(priority, rec.Priority)
+	}
+
+	for _, text := range defaults {
+		text = strings.TrimSpace(text)
+		if text == "" || hasRecommendation(recommendations, text) {
+			continue
+		}
+		recommendations = append(recommendations, Recommendation{
+			Text:       text,
+			Priority:   priority + 1,
+			Category:   inferCategory(text),
+			Confidence: 1,
+			Default:    true,
+		})
+	}
+	return recommendations
+}
+
+// hasRecommendation reports whether text is already recommended
+func hasRecommendation(recommendations []Recommendation, text string) bool {
+	for _, rec := range recommendations {
+		if strings.EqualFold(rec.Text, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortRecommendations orders by priority, then by descending confidence
+func sortRecommendations(recommendations []Recommendation) {
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Priority != recommendations[j].Priority {
+			return recommendations[i].Priority < recommendations[j].Priority
+		}
+		return recommendations[i].Confidence > recommendations[j].Confidence
+	})
+}
+
+// recommendationTexts flattens recommendations to their text
+func recommendationTexts(recommendations []Recommendation) []string {
+	texts := make([]string, 0, len(recommendations))
+	for _, rec := range recommendations {
+		texts = append(texts, rec.Text)
+	}
+	return texts
+}
+
+// mentions reports whether any value contains keyword (case-insensitive)
+func mentions(values []string, keyword string) bool {
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), keyword) {
+			return true
+		}
+	}
+	return false
+}
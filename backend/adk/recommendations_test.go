@@ -0,0 +1,63 @@
+package adk
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultRecommendations(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithDefaultRecommendations(
+		"Review with legal",
+		"monitor competitor pricing and adjust strategy quarterly",
+		"Review with legal",
+		"Share with the board",
+	))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	recs := report.RecommendationDetails
+	if len(recs) < 2 {
+		t.Fatalf("Expected recommendations, got %v", recs)
+	}
+
+	// Defaults come last, in configured order, without duplicates
+	tail := recs[len(recs)-2:]
+	if tail[0].Text != "Review with legal" || tail[1].Text != "Share with the board" {
+		t.Errorf("Expected defaults last, got %q and %q", tail[0].Text, tail[1].Text)
+	}
+	for _, rec := range tail {
+		if !rec.Default {
+			t.Errorf("Expected %q to be marked default", rec.Text)
+		}
+		if rec.Priority <= recs[len(recs)-3].Priority {
+			t.Errorf("Expected %q to rank below generated recommendations", rec.Text)
+		}
+	}
+
+	seen := make(map[string]int)
+	for _, text := range report.Recommendations {
+		seen[text]++
+	}
+	if seen["Review with legal"] != 1 {
+		t.Errorf("Expected one 'Review with legal', got %d", seen["Review with legal"])
+	}
+	if seen["monitor competitor pricing and adjust strategy quarterly"] != 0 {
+		t.Error("Expected a default duplicating a generated recommendation to be dropped")
+	}
+}
+
+func TestDefaultRecommendations_EmptyByDefault(t *testing.T) {
+	report, err := NewCompetitorIntelligenceAgent().Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, rec := range report.RecommendationDetails {
+		if rec.Default {
+			t.Errorf("Expected no default recommendations, got %q", rec.Text)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package adk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Output profiles with built-in redaction rules
+const (
+	ProfileInternal = "internal"
+	ProfilePublic   = "public"
+)
+
+// Redactable fields
+const (
+	RedactFunding       = "funding"
+	RedactLeadership    = "leadership"
+	RedactNotes         = "notes"
+	RedactScreenshotURL = "screenshot_url"
+	RedactWebsiteStatus = "website_status"
+	RedactTechStack     = "tech_stack"
+)
+
+// redactedName masks a person's name while keeping their role
+const redactedName = "[redacted]"
+
+// redactors blank or mask one field of an analysis copy, along with any
+// derived text or score component that would reveal it
+var redactors = map[string]func(*CompetitorAnalysis){
+	RedactFunding: func(a *CompetitorAnalysis) {
+		a.Funding = 0
+		if _, scored := a.ScoreBreakdown[ScoreComponentFunding]; scored {
+			breakdown := make(map[string]float64, len(a.ScoreBreakdown))
+			for component, points := range a.ScoreBreakdown {
+				breakdown[component] = points
+			}
+			delete(breakdown, ScoreComponentFunding)
+			a.ScoreBreakdown = breakdown
+		}
+	},
+	RedactLeadership: func(a *CompetitorAnalysis) {
+		masked := make([]Person, len(a.Leadership))
+		for i, person := range a.Leadership {
+			masked[i] = Person{Name: redactedName, Role: person.Role}
+		}
+		a.Leadership = masked
+		a.KeyDifferentiators = withoutLeaderNames(a.KeyDifferentiators)
+	},
+	RedactNotes:         func(a *CompetitorAnalysis) { a.Notes = nil },
+	RedactScreenshotURL: func(a *CompetitorAnalysis) { a.ScreenshotURL = "" },
+	RedactWebsiteStatus: func(a *CompetitorAnalysis) { a.WebsiteStatus = nil },
+	RedactTechStack:     func(a *CompetitorAnalysis) { a.TechStack = nil },
+}
+
+// DefaultRedactionRules keep everything for internal readers and hide
+// funding, named leaders and analyst notes from the public
+var DefaultRedactionRules = map[string][]string{
+	ProfileInternal: {},
+	ProfilePublic:   {RedactFunding, RedactLeadership, RedactNotes},
+}
+
+// ParseRedactionRules parses "profile=field,field;profile=field" rules,
+// rejecting unknown fields
+func ParseRedactionRules(spec string) (map[string][]string, error) {
+	rules := make(map[string][]string)
+	for _, entry := range strings.Split(spec, ";") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		profile, list, ok := strings.Cut(entry, "=")
+		profile = strings.ToLower(strings.TrimSpace(profile))
+		if !ok || profile == "" {
+			return nil, fmt.Errorf("%w: redaction rule %q must be profile=fields", ErrInvalidInput, entry)
+		}
+		fields := []string{}
+		for _, field := range strings.Split(list, ",") {
+			if field = strings.ToLower(strings.TrimSpace(field)); field == "" {
+				continue
+			}
+			if _, known := redactors[field]; !known {
+				return nil, fmt.Errorf("%w: unknown redaction field %q", ErrInvalidInput, field)
+			}
+			fields = append(fields, field)
+		}
+		rules[profile] = fields
+	}
+	return rules, nil
+}
+
+// redactionRules returns the configured rules, or the defaults
+func (a *CompetitorIntelligenceAgent) redactionRules() map[string][]string {
+	if a.Config.RedactionRules != nil {
+		return a.Config.RedactionRules
+	}
+	return DefaultRedactionRules
+}
+
+// profileFields returns the fields a profile redacts; an empty profile
+// redacts nothing
+func (a *CompetitorIntelligenceAgent) profileFields(profile string) ([]string, error) {
+	if profile == "" {
+		return nil, nil
+	}
+	fields, ok := a.redactionRules()[strings.ToLower(profile)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown output profile %q", ErrInvalidInput, profile)
+	}
+	return fields, nil
+}
+
+// RedactsAtLeast reports whether profile redacts every field base does, so
+// serving it in place of base discloses nothing more
+func (a *CompetitorIntelligenceAgent) RedactsAtLeast(profile, base string) (bool, error) {
+	fields, err := a.profileFields(profile)
+	if err != nil {
+		return false, err
+	}
+	baseFields, err := a.profileFields(base)
+	if err != nil {
+		return false, err
+	}
+	for _, field := range baseFields {
+		if !slices.Contains(fields, field) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Redacted returns a copy of the report with the profile's fields blanked or
+// masked; the report itself is left intact. An empty profile redacts nothing.
+func (a *CompetitorIntelligenceAgent) Redacted(report *CompetitorReport, profile string) (*CompetitorReport, error) {
+	fields, err := a.profileFields(profile)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return report, nil
+	}
+
+	out := *report
+	out.Competitors = make([]CompetitorAnalysis, len(report.Competitors))
+	for i, analysis := range report.Competitors {
+		for _, field := range fields {
+			if redact, known := redactors[field]; known {
+				redact(&analysis)
+			}
+		}
+		analysis.Provenance = withoutFields(analysis.Provenance, fields)
+		if analysis.Highlighted {
+			analysis.FocusSummary = focusSummary(analysis)
+		}
+		out.Competitors[i] = analysis
+	}
+	if slices.Contains(fields, RedactFunding) {
+		out.ExecutiveSummary = withoutFundingSummary(report.ExecutiveSummary)
+	}
+	return &out, nil
+}
+
+// withoutFields copies a provenance map minus redacted fields, whose names
+// match their JSON field names
+func withoutFields(provenance map[string]string, fields []string) map[string]string {
+	if provenance == nil {
+		return nil
+	}
+	out := make(map[string]string, len(provenance))
+	for field, source := range provenance {
+		out[field] = source
+	}
+	for _, field := range fields {
+		delete(out, field)
+	}
+	return out
+}
+
+// ExportProfile renders the report for an output profile, redacting fields
+// before serialization
+func (a *CompetitorIntelligenceAgent) ExportProfile(report *CompetitorReport, format, profile string) ([]byte, error) {
+	redacted, err := a.Redacted(report, profile)
+	if err != nil {
+		return nil, err
+	}
+	return a.export(redacted, format)
+}
@@ -0,0 +1,214 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// sensitiveReport has every redactable field populated
+func sensitiveReport() *CompetitorReport {
+	return &CompetitorReport{TargetCompany: "TestCorp", Competitors: []CompetitorAnalysis{{
+		CompetitorName: "Rival",
+		MarketShare:    20,
+		Funding:        150,
+		Leadership:     []Person{{Name: "Jane Doe", Role: "CEO", YearsExperience: 20}},
+		Notes:          []string{"Heard they are raising"},
+		TechStack:      []string{"Go"},
+	}}}
+}
+
+func TestRedacted_Profiles(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	tests := []struct {
+		name        string
+		profile     string
+		wantFunding float64
+		wantLeader  string
+		wantNotes   int
+	}{
+		{name: "No profile", wantFunding: 150, wantLeader: "Jane Doe", wantNotes: 1},
+		{name: "Internal", profile: ProfileInternal, wantFunding: 150, wantLeader: "Jane Doe", wantNotes: 1},
+		{name: "Public", profile: "Public", wantFunding: 0, wantLeader: redactedName, wantNotes: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := sensitiveReport()
+			redacted, err := agent.Redacted(report, tt.profile)
+			if err != nil {
+				t.Fatalf("Redacted() error = %v", err)
+			}
+
+			got := redacted.Competitors[0]
+			if got.Funding != tt.wantFunding {
+				t.Errorf("Expected funding %v, got %v", tt.wantFunding, got.Funding)
+			}
+			if got.Leadership[0].Name != tt.wantLeader || got.Leadership[0].Role != "CEO" {
+				t.Errorf("Expected leader %q keeping their role, got %+v", tt.wantLeader, got.Leadership[0])
+			}
+			if len(got.Notes) != tt.wantNotes {
+				t.Errorf("Expected %d notes, got %v", tt.wantNotes, got.Notes)
+			}
+			if got.MarketShare != 20 || len(got.TechStack) != 1 {
+				t.Errorf("Expected unredacted fields intact, got %+v", got)
+			}
+
+			// The source report is never modified
+			if report.Competitors[0].Funding != 150 || report.Competitors[0].Leadership[0].Name != "Jane Doe" {
+				t.Errorf("Expected the original report intact, got %+v", report.Competitors[0])
+			}
+		})
+	}
+}
+
+// TestRedacted_DerivedFields tests that redacted funding and leaders do not
+// survive in text and scores derived from them
+func TestRedacted_DerivedFields(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithScoreBreakdown(true))
+	report, err := agent.Run(WithHighlights(WithPersona(context.Background(), PersonaInvestor), "Competitor A"), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(report.ExecutiveSummary, "best funded") {
+		t.Fatalf("Expected an investor summary mentioning funding, got %q", report.ExecutiveSummary)
+	}
+	var leaders []string
+	for _, analysis := range report.Competitors {
+		for _, person := range analysis.Leadership {
+			leaders = append(leaders, person.Name)
+		}
+	}
+	if len(leaders) == 0 {
+		t.Fatal("Expected stub data with named leaders")
+	}
+
+	redacted, err := agent.Redacted(report, ProfilePublic)
+	if err != nil {
+		t.Fatalf("Redacted() error = %v", err)
+	}
+	out, err := json.Marshal(redacted)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, name := range leaders {
+		if strings.Contains(string(out), name) {
+			t.Errorf("Expected leader %q redacted everywhere, found it in:\n%s", name, out)
+		}
+	}
+	if strings.Contains(redacted.ExecutiveSummary, "funded") || !strings.HasSuffix(redacted.ExecutiveSummary, ").") {
+		t.Errorf("Expected the funding sentence dropped, got %q", redacted.ExecutiveSummary)
+	}
+	for _, analysis := range redacted.Competitors {
+		if _, ok := analysis.ScoreBreakdown[ScoreComponentFunding]; ok {
+			t.Errorf("Expected no funding score component for %s, got %v", analysis.CompetitorName, analysis.ScoreBreakdown)
+		}
+	}
+	scored := false
+	for _, analysis := range report.Competitors {
+		_, ok := analysis.ScoreBreakdown[ScoreComponentFunding]
+		scored = scored || ok
+	}
+	if !scored {
+		t.Error("Expected the original score breakdowns to keep funding")
+	}
+}
+
+func TestRedactsAtLeast(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	tests := []struct {
+		name    string
+		profile string
+		base    string
+		want    bool
+		wantErr bool
+	}{
+		{name: "Same profile", profile: ProfilePublic, base: ProfilePublic, want: true},
+		{name: "More restrictive", profile: ProfilePublic, base: ProfileInternal, want: true},
+		{name: "Less restrictive", profile: ProfileInternal, base: ProfilePublic, want: false},
+		{name: "No default", profile: ProfileInternal, base: "", want: true},
+		{name: "Unknown profile", profile: "partner", base: ProfilePublic, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := agent.RedactsAtLeast(tt.profile, tt.base)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRedacted_UnknownProfile(t *testing.T) {
+	_, err := NewCompetitorIntelligenceAgent().Redacted(sensitiveReport(), "partner")
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestExport_OutputProfile(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(
+		WithRedactionRules(map[string][]string{"partner": {RedactTechStack}}),
+		WithOutputProfile("partner"),
+	)
+
+	data, err := agent.Export(sensitiveReport(), FormatJSON)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if strings.Contains(string(data), "tech_stack") {
+		t.Errorf("Expected tech stack redacted under the default profile, got %s", data)
+	}
+	if !strings.Contains(string(data), `"funding": 150`) {
+		t.Errorf("Expected funding kept by custom rules, got %s", data)
+	}
+
+	data, err = agent.ExportProfile(sensitiveReport(), FormatJSON, ProfilePublic)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected custom rules to replace the defaults, got %v (%s)", err, data)
+	}
+}
+
+func TestParseRedactionRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    map[string][]string
+		wantErr bool
+	}{
+		{name: "Profiles", spec: "Public=funding, leadership;internal=", want: map[string][]string{"public": {"funding", "leadership"}, "internal": {}}},
+		{name: "Unknown field", spec: "public=salary", wantErr: true},
+		{name: "Missing fields", spec: "public", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRedactionRules(tt.spec)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInput) {
+					t.Errorf("Expected ErrInvalidInput, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRedactionRules() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			for profile, fields := range tt.want {
+				if strings.Join(got[profile], ",") != strings.Join(fields, ",") {
+					t.Errorf("Expected %s fields %v, got %v", profile, fields, got[profile])
+				}
+			}
+		})
+	}
+}
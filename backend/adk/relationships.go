@@ -0,0 +1,157 @@
+package adk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Relationship types, read from the competitor holding the relationship:
+// "Acme parent Beta" means Acme is Beta's parent company
+const (
+	RelationPartner    = "partner"
+	RelationParent     = "parent"
+	RelationSubsidiary = "subsidiary"
+	RelationAcquired   = "acquired"
+)
+
+// relationTypes lists the accepted relationship types
+var relationTypes = map[string]bool{
+	RelationPartner:    true,
+	RelationParent:     true,
+	RelationSubsidiary: true,
+	RelationAcquired:   true,
+}
+
+// Relationship links a competitor to another company
+type Relationship struct {
+	Type   string `json:"type"`
+	Target string `json:"target"`
+}
+
+// mergeRelationships appends relationships not already present
+func mergeRelationships(into, from []Relationship) []Relationship {
+	for _, rel := range from {
+		if !hasRelationship(into, rel) {
+			into = append(into, rel)
+		}
+	}
+	return into
+}
+
+// hasRelationship reports whether rels already holds rel
+func hasRelationship(rels []Relationship, rel Relationship) bool {
+	for _, existing := range rels {
+		if strings.EqualFold(existing.Type, rel.Type) && nameKey(existing.Target) == nameKey(rel.Target) {
+			return true
+		}
+	}
+	return false
+}
+
+// relationshipsFor combines a competitor's own relationships with any
+// configured for it by name
+func (a *CompetitorIntelligenceAgent) relationshipsFor(competitor CompetitorData) []Relationship {
+	rels := append([]Relationship(nil), competitor.Relationships...)
+	for name, configured := range a.Config.Relationships {
+		if nameKey(name) == nameKey(competitor.Name) {
+			rels = mergeRelationships(rels, configured)
+		}
+	}
+	return rels
+}
+
+// RelationshipEdge is a directed link in the relationship graph. Partner
+// edges are undirected and listed once, From sorting before To.
+type RelationshipEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// RelationshipGraph shows how the competitive field is interconnected
+type RelationshipGraph struct {
+	Nodes []string           `json:"nodes"`
+	Edges []RelationshipEdge `json:"edges"`
+}
+
+// RelationshipGraph collects every competitor's relationships into a graph.
+// Subsidiary links are turned around into parent edges so each tie appears
+// once; companies outside the report still become nodes.
+func (r *CompetitorReport) RelationshipGraph() *RelationshipGraph {
+	names := make(map[string]string)
+	addNode := func(name string) string {
+		key := nameKey(name)
+		if _, ok := names[key]; !ok {
+			names[key] = strings.TrimSpace(name)
+		}
+		return names[key]
+	}
+	for _, analysis := range r.Competitors {
+		addNode(analysis.CompetitorName)
+	}
+
+	seen := make(map[RelationshipEdge]bool)
+	graph := &RelationshipGraph{Edges: []RelationshipEdge{}}
+	for _, analysis := range r.Competitors {
+		from := addNode(analysis.CompetitorName)
+		for _, rel := range analysis.Relationships {
+			edge := RelationshipEdge{From: from, To: addNode(rel.Target), Type: strings.ToLower(rel.Type)}
+			switch edge.Type {
+			case RelationSubsidiary:
+				edge = RelationshipEdge{From: edge.To, To: edge.From, Type: RelationParent}
+			case RelationPartner:
+				if edge.To < edge.From {
+					edge.From, edge.To = edge.To, edge.From
+				}
+			}
+			if !seen[edge] {
+				seen[edge] = true
+				graph.Edges = append(graph.Edges, edge)
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(names) {
+		graph.Nodes = append(graph.Nodes, names[key])
+	}
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Type < b.Type
+	})
+	return graph
+}
+
+// relationStyles are the DOT edge attributes per relationship type
+var relationStyles = map[string]string{
+	RelationParent:   `color="#2c3e50", label="parent of"`,
+	RelationAcquired: `color="#8e44ad", label="acquired"`,
+	RelationPartner:  `color="#2980b9", label="partner", dir=none, style=dashed`,
+}
+
+// ToDOT renders the graph as a GraphViz digraph
+func (g *RelationshipGraph) ToDOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote("relationships"))
+	b.WriteString("  node [fontname=\"Helvetica\", shape=box];\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", dotID("company", node), dotQuote(node))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotID("company", edge.From), dotID("company", edge.To), relationStyles[edge.Type])
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// relationshipsJSON renders the report's relationship graph
+func relationshipsJSON(report *CompetitorReport) ([]byte, error) {
+	return json.MarshalIndent(report.RelationshipGraph(), "", "  ")
+}
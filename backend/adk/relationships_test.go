@@ -0,0 +1,84 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRelationshipGraph(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithRelationships(map[string][]Relationship{
+		"gamma": {{Type: RelationPartner, Target: "Acme"}},
+	}))
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "Acme", MarketShare: 30, Relationships: []Relationship{
+			{Type: RelationParent, Target: "Beta"},
+			{Type: RelationPartner, Target: "Gamma"},
+		}},
+		{Name: "Beta", MarketShare: 10, Relationships: []Relationship{{Type: RelationSubsidiary, Target: "Acme"}}},
+		{Name: "Gamma", MarketShare: 5, Relationships: []Relationship{{Type: RelationAcquired, Target: "Delta Labs"}}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	graph := (&CompetitorReport{Competitors: analyses}).RelationshipGraph()
+
+	wantNodes := []string{"Acme", "Beta", "Delta Labs", "Gamma"}
+	if strings.Join(graph.Nodes, ",") != strings.Join(wantNodes, ",") {
+		t.Errorf("Expected nodes %v, got %v", wantNodes, graph.Nodes)
+	}
+
+	// The subsidiary link and the configured partner link collapse onto
+	// existing edges
+	wantEdges := []RelationshipEdge{
+		{From: "Acme", To: "Beta", Type: RelationParent},
+		{From: "Acme", To: "Gamma", Type: RelationPartner},
+		{From: "Gamma", To: "Delta Labs", Type: RelationAcquired},
+	}
+	if len(graph.Edges) != len(wantEdges) {
+		t.Fatalf("Expected edges %+v, got %+v", wantEdges, graph.Edges)
+	}
+	for i, edge := range wantEdges {
+		if graph.Edges[i] != edge {
+			t.Errorf("Expected edge %+v, got %+v", edge, graph.Edges[i])
+		}
+	}
+
+	dot := graph.ToDOT()
+	parentEdge := `"company:Acme" -> "company:Beta" [color="#2c3e50", label="parent of"];`
+	if !strings.Contains(dot, parentEdge) {
+		t.Errorf("Expected parent edge %s in:\n%s", parentEdge, dot)
+	}
+	if !strings.HasPrefix(dot, "digraph \"relationships\" {\n") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("Expected a digraph block, got:\n%s", dot)
+	}
+}
+
+func TestNormalizeCompetitors_MergesRelationships(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	merged := agent.NormalizeCompetitors([]CompetitorData{
+		{Name: "Acme", Relationships: []Relationship{{Type: RelationParent, Target: "Beta"}}},
+		{Name: "acme", Relationships: []Relationship{{Type: RelationParent, Target: "beta"}, {Type: RelationPartner, Target: "Gamma"}}},
+	})
+
+	if len(merged) != 1 || len(merged[0].Relationships) != 2 {
+		t.Errorf("Expected two distinct relationships after merging, got %+v", merged)
+	}
+}
+
+func TestValidateCompetitors_Relationships(t *testing.T) {
+	err := ValidateCompetitors([]CompetitorData{{Name: "Acme", Relationships: []Relationship{
+		{Type: "rival", Target: "Beta"},
+		{Type: RelationPartner},
+	}}})
+
+	var invalid ValidationErrors
+	if !errors.As(err, &invalid) || len(invalid) != 2 {
+		t.Fatalf("Expected two relationship errors, got %v", err)
+	}
+	if invalid[0].Path != "competitors[0].relationships[0].type" || invalid[1].Path != "competitors[0].relationships[1].target" {
+		t.Errorf("Unexpected error paths: %+v", invalid)
+	}
+}
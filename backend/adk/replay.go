@@ -0,0 +1,138 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotReplayable is returned for stored reports saved without their inputs
+var ErrNotReplayable = errors.New("report has no stored inputs to replay")
+
+// ReportInputs records exactly what a stored report was generated from
+type ReportInputs struct {
+	// Competitors is the data handed to Analyze, after normalization,
+	// filtering and enrichment
+	Competitors []CompetitorData `json:"competitors"`
+
+	// Config is the effective configuration, including per-request order,
+	// persona and highlight overrides
+	Config Config `json:"config"`
+
+	// ProductFilter is the products keyword the run was focused on, if any
+	ProductFilter string `json:"product_filter,omitempty"`
+
+	// AnalyzedAt is the clock data freshness was measured against
+	AnalyzedAt time.Time `json:"analyzed_at"`
+
+	// Momentum holds trends derived from stored history, which override
+	// the heuristic momentum Analyze computes
+	Momentum map[string]string `json:"momentum,omitempty"`
+}
+
+// analysisTimeKey carries the clock Analyze measures freshness against
+type analysisTimeKey struct{}
+
+// analysisTime returns the run's analysis clock, defaulting to now
+func analysisTime(ctx context.Context) time.Time {
+	if at, ok := ctx.Value(analysisTimeKey{}).(time.Time); ok {
+		return at
+	}
+	return time.Now()
+}
+
+// reportInputs captures the inputs of a run for later replay
+func (a *CompetitorIntelligenceAgent) reportInputs(ctx context.Context, data []CompetitorData) *ReportInputs {
+	config := a.Config
+	config.OrderBy = a.orderFor(ctx)
+	config.Persona = a.personaFor(ctx)
+	config.Highlights = a.highlightsFor(ctx)
+
+	return &ReportInputs{
+		Competitors:   append([]CompetitorData(nil), data...),
+		Config:        config,
+		ProductFilter: productFilterFor(ctx),
+		AnalyzedAt:    analysisTime(ctx),
+	}
+}
+
+// replayAgent builds a standalone agent from a stored configuration; it has
+// no data source, store, enrichers or cache so results come from logic alone
+func replayAgent(config Config) (*CompetitorIntelligenceAgent, error) {
+	agent := NewCompetitorIntelligenceAgent()
+	agent.Config = config
+	agent.Config.AnalysisCacheSize = 0
+
+	if config.Timezone != "" {
+		loc, err := ParseTimezone(config.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		agent.timezone = loc
+	}
+	if config.InsightsTemplate != "" {
+		tmpl, err := ParseInsightsTemplate(config.InsightsTemplate)
+		if err != nil {
+			return nil, err
+		}
+		agent.insightsTemplate = tmpl
+	}
+	return agent, nil
+}
+
+// Replay re-runs Analyze and GenerateReport on a stored report's inputs with
+// its stored configuration. The result is not saved.
+func (a *CompetitorIntelligenceAgent) Replay(ctx context.Context, id string) (*CompetitorReport, error) {
+	if a.store == nil {
+		return nil, errors.New("report storage is not configured")
+	}
+	original, err := a.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	inputs := original.Inputs
+	if inputs == nil {
+		return nil, ErrNotReplayable
+	}
+
+	agent, err := replayAgent(inputs.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore configuration: %w", err)
+	}
+
+	ctx = WithProductFilter(ctx, inputs.ProductFilter)
+	ctx = context.WithValue(ctx, productExclusionsKey{}, original.ExcludedByProduct)
+	ctx = context.WithValue(ctx, analysisTimeKey{}, inputs.AnalyzedAt)
+
+	analyses, err := agent.Analyze(ctx, inputs.Competitors)
+	if err != nil {
+		return nil, &StageError{Stage: StageAnalysis, Err: err}
+	}
+	report, err := agent.GenerateReport(ctx, original.TargetCompany, analyses)
+	if err != nil {
+		return nil, &StageError{Stage: StageReport, Err: err}
+	}
+
+	// Run-level results outside Analyze and GenerateReport carry over as-is
+	applyMomentum(report, inputs.Momentum)
+	report.Meta = original.Meta
+	report.OthersShare = original.OthersShare
+	report.SkippedSteps = original.SkippedSteps
+	report.Sampling = original.Sampling
+	return report, nil
+}
+
+// ReplayMatches reports whether a replay reproduced the original analyses
+func ReplayMatches(original, replay *CompetitorReport) bool {
+	want, err := json.Marshal(original.Competitors)
+	if err != nil {
+		return false
+	}
+	got, err := json.Marshal(replay.Competitors)
+	if err != nil {
+		return false
+	}
+	return string(want) == string(got)
+}
@@ -0,0 +1,107 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestReplay tests that replaying a stored report reproduces its analyses
+func TestReplay(t *testing.T) {
+	fileStore, err := NewFileReportStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileReportStore() error = %v", err)
+	}
+
+	stores := map[string]ReportStore{
+		"memory": NewMemoryReportStore(),
+		"file":   fileStore,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			loc, err := ParseTimezone("Europe/Berlin")
+			if err != nil {
+				t.Fatalf("ParseTimezone() error = %v", err)
+			}
+			agent := NewCompetitorIntelligenceAgent(
+				WithReportStore(store),
+				WithCompositePositioning(),
+				WithTimezone(loc),
+			)
+			ctx := WithPersona(WithOrder(context.Background(), OrderName), PersonaSales)
+
+			// A second run picks up history-derived momentum
+			if _, err := agent.Run(ctx, "TestCorp", "Technology"); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			original, err := agent.Run(ctx, "TestCorp", "Technology")
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if original.Inputs != nil {
+				t.Error("Expected inputs to be kept out of the returned report")
+			}
+
+			replay, err := agent.Replay(context.Background(), original.ID)
+			if err != nil {
+				t.Fatalf("Replay() error = %v", err)
+			}
+
+			if !ReplayMatches(original, replay) {
+				t.Errorf("Expected replay to reproduce analyses, got %+v, want %+v", replay.Competitors, original.Competitors)
+			}
+			if replay.Persona != PersonaSales {
+				t.Errorf("Expected stored persona override, got %q", replay.Persona)
+			}
+			if replay.MarketInsights != original.MarketInsights {
+				t.Errorf("Expected insights %q, got %q", original.MarketInsights, replay.MarketInsights)
+			}
+			if len(replay.Recommendations) != len(original.Recommendations) {
+				t.Errorf("Expected %d recommendations, got %d", len(original.Recommendations), len(replay.Recommendations))
+			}
+		})
+	}
+}
+
+// TestReplay_Errors tests replaying unknown and input-less reports
+func TestReplay_Errors(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryReportStore()
+	legacy := &CompetitorReport{TargetCompany: "TestCorp"}
+	if err := store.Save(ctx, legacy); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(store))
+
+	tests := []struct {
+		name string
+		id   string
+		want error
+	}{
+		{name: "Unknown report", id: "missing", want: ErrReportNotFound},
+		{name: "No stored inputs", id: legacy.ID, want: ErrNotReplayable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := agent.Replay(ctx, tt.id); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// TestReplayMatches tests detecting diverging analyses
+func TestReplayMatches(t *testing.T) {
+	original := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "A", ThreatLevel: ThreatHigh}}}
+	same := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "A", ThreatLevel: ThreatHigh}}}
+	changed := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "A", ThreatLevel: ThreatLow}}}
+
+	if !ReplayMatches(original, same) {
+		t.Error("Expected identical analyses to match")
+	}
+	if ReplayMatches(original, changed) {
+		t.Error("Expected changed analyses not to match")
+	}
+}
@@ -0,0 +1,71 @@
+package adk
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// defaultCleanupInterval is how often expired reports are swept by default
+const defaultCleanupInterval = time.Hour
+
+// RetentionPolicy controls how long stored reports are kept
+type RetentionPolicy struct {
+	// MaxAge is how long a report is kept after it was generated
+	MaxAge time.Duration
+	// Interval is the time between sweeps (defaultCleanupInterval when zero)
+	Interval time.Duration
+	// DryRun logs expired reports instead of deleting them
+	DryRun bool
+}
+
+// ExpireReports deletes stored reports generated more than maxAge before
+// now and returns their IDs. With dryRun, expired reports are only logged.
+func ExpireReports(ctx context.Context, store ReportStore, maxAge time.Duration, now time.Time, dryRun bool) ([]string, error) {
+	reports, err := store.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := now.Add(-maxAge)
+	expired := []string{}
+	for _, report := range reports {
+		if !report.GeneratedAt.Before(cutoff) {
+			continue
+		}
+		if dryRun {
+			log.Printf("report cleanup (dry run): would delete %s for %s generated %s", report.ID, report.TargetCompany, report.GeneratedAt.Format(time.RFC3339))
+		} else if err := store.Delete(ctx, report.ID); err != nil {
+			return expired, err
+		}
+		expired = append(expired, report.ID)
+	}
+	return expired, nil
+}
+
+// RunReportCleanup sweeps expired reports immediately and then every
+// policy.Interval until ctx is done. Failed sweeps are logged and retried on
+// the next tick.
+func RunReportCleanup(ctx context.Context, store ReportStore, policy RetentionPolicy) {
+	interval := policy.Interval
+	if interval <= 0 {
+		interval = defaultCleanupInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := ExpireReports(ctx, store, policy.MaxAge, time.Now(), policy.DryRun)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("report cleanup failed: %v", err)
+		} else if len(expired) > 0 && !policy.DryRun {
+			log.Printf("report cleanup: deleted %d expired reports", len(expired))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestExpireReports tests that only reports past the retention period are removed
+func TestExpireReports(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	fileStore, err := NewFileReportStore(t.TempDir(), WithCompression())
+	if err != nil {
+		t.Fatalf("NewFileReportStore() error = %v", err)
+	}
+
+	stores := map[string]ReportStore{
+		"memory": NewMemoryReportStore(),
+		"file":   fileStore,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			old := &CompetitorReport{ID: "old", TargetCompany: "TestCorp", GeneratedAt: now.AddDate(0, 0, -40)}
+			fresh := &CompetitorReport{ID: "fresh", TargetCompany: "TestCorp", GeneratedAt: now.AddDate(0, 0, -5)}
+			for _, report := range []*CompetitorReport{old, fresh} {
+				if err := store.Save(ctx, report); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+
+			expired, err := ExpireReports(ctx, store, 30*24*time.Hour, now, true)
+			if err != nil {
+				t.Fatalf("ExpireReports() dry run error = %v", err)
+			}
+			if len(expired) != 1 || expired[0] != "old" {
+				t.Errorf("Expected dry run to report the old report, got %v", expired)
+			}
+			if _, err := store.Get(ctx, "old"); err != nil {
+				t.Errorf("Expected dry run to keep the old report, got %v", err)
+			}
+
+			if expired, err = ExpireReports(ctx, store, 30*24*time.Hour, now, false); err != nil || len(expired) != 1 {
+				t.Fatalf("Expected one report deleted, got %v (%v)", expired, err)
+			}
+			if _, err := store.Get(ctx, "old"); !errors.Is(err, ErrReportNotFound) {
+				t.Errorf("Expected the old report to be deleted, got %v", err)
+			}
+			if _, err := store.Get(ctx, "fresh"); err != nil {
+				t.Errorf("Expected the fresh report to be retained, got %v", err)
+			}
+
+			if err := store.Delete(ctx, "old"); !errors.Is(err, ErrReportNotFound) {
+				t.Errorf("Expected deleting a missing report to fail with ErrReportNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+// TestRunReportCleanup tests that the cleanup loop sweeps and stops on cancel
+func TestRunReportCleanup(t *testing.T) {
+	store := NewMemoryReportStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := store.Save(ctx, &CompetitorReport{ID: "old", GeneratedAt: time.Now().Add(-2 * time.Hour)}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		RunReportCleanup(ctx, store, RetentionPolicy{MaxAge: time.Hour, Interval: 10 * time.Millisecond})
+		close(done)
+	}()
+
+	deadline := time.After(time.Second)
+	for {
+		if _, err := store.Get(ctx, "old"); errors.Is(err, ErrReportNotFound) {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("Expected the cleanup loop to delete the expired report")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the cleanup loop to stop after cancel")
+	}
+}
@@ -0,0 +1,51 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// defaultRunRetryBackoff is the first retry delay when none is configured
+const defaultRunRetryBackoff = 100 * time.Millisecond
+
+// ErrInvalidInput marks validation failures, which are never retried
+var ErrInvalidInput = errors.New("invalid input")
+
+// isRetryable reports whether a failed run is worth re-executing
+func isRetryable(err error) bool {
+	switch {
+	case errors.Is(err, ErrInvalidInput):
+		return false
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	}
+	return true
+}
+
+// withRunRetry calls run, re-executing it with exponential backoff while it
+// fails with retryable errors and retries remain
+func (a *CompetitorIntelligenceAgent) withRunRetry(ctx context.Context, run func() (*CompetitorReport, error)) (*CompetitorReport, error) {
+	backoff := a.Config.RunRetryBackoff
+	if backoff <= 0 {
+		backoff = defaultRunRetryBackoff
+	}
+
+	for attempt := 0; ; attempt++ {
+		report, err := run()
+		if err == nil || attempt >= a.Config.RunRetries || !isRetryable(err) {
+			return report, err
+		}
+		log.Printf("run attempt %d failed, retrying in %s: %v", attempt+1, backoff, err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,94 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// flakySource fails its first fetches with err, then delegates to the stub
+type flakySource struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakySource) Name() string { return "flaky" }
+
+func (f *flakySource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return StubDataSource{}.FetchCompetitors(ctx, companyName, industry)
+}
+
+func TestRunRetry(t *testing.T) {
+	transient := errors.New("upstream unavailable")
+	invalid := fmt.Errorf("%w: unknown industry", ErrInvalidInput)
+
+	tests := []struct {
+		name      string
+		retries   int
+		failures  int
+		err       error
+		wantErr   error
+		wantCalls int
+	}{
+		{"succeeds after one failure", 2, 1, transient, nil, 2},
+		{"no retries configured", 0, 1, transient, transient, 1},
+		{"retries exhausted", 2, 5, transient, transient, 3},
+		{"validation errors fail immediately", 3, 1, invalid, ErrInvalidInput, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &flakySource{failures: tt.failures, err: tt.err}
+			agent := NewCompetitorIntelligenceAgent(WithDataSource(source), WithRunRetry(tt.retries, time.Millisecond))
+
+			report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Run() error = %v", err)
+				}
+				if len(report.Competitors) != 3 {
+					t.Errorf("Expected 3 competitors, got %d", len(report.Competitors))
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if source.calls != tt.wantCalls {
+				t.Errorf("Expected %d fetches, got %d", tt.wantCalls, source.calls)
+			}
+		})
+	}
+}
+
+func TestRunRetryRespectsContext(t *testing.T) {
+	source := &flakySource{failures: 10, err: errors.New("upstream unavailable")}
+	agent := NewCompetitorIntelligenceAgent(WithDataSource(source), WithRunRetry(5, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected backoff to stop on cancellation, took %s", elapsed)
+	}
+	if source.calls != 1 {
+		t.Errorf("Expected 1 fetch, got %d", source.calls)
+	}
+}
+
+func TestRunIndustriesRequiresIndustry(t *testing.T) {
+	_, err := NewCompetitorIntelligenceAgent().RunIndustries(context.Background(), "TestCorp", nil)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SampleMethod selects how a large competitor set is reduced before analysis
+type SampleMethod string
+
+// Supported sampling methods
+const (
+	// SampleTopShare keeps the competitors with the largest market share
+	SampleTopShare SampleMethod = "top_share"
+	// SampleRandom keeps a seeded random subset
+	SampleRandom SampleMethod = "random"
+	// SampleStratified keeps a seeded random subset from each threat tier in
+	// proportion to the tier's size
+	SampleStratified SampleMethod = "stratified"
+)
+
+// sampleMethods is the set of valid sampling methods
+var sampleMethods = []SampleMethod{SampleTopShare, SampleRandom, SampleStratified}
+
+// ParseSampleMethod validates a sampling method name; empty selects
+// SampleTopShare
+func ParseSampleMethod(name string) (SampleMethod, error) {
+	if name == "" {
+		return SampleTopShare, nil
+	}
+	names := make([]string, len(sampleMethods))
+	for i, method := range sampleMethods {
+		if string(method) == name {
+			return method, nil
+		}
+		names[i] = string(method)
+	}
+	return "", fmt.Errorf("%w: unknown sampling method %q (want one of %s)", ErrInvalidInput, name, strings.Join(names, ", "))
+}
+
+// SamplingInfo records how competitors were sampled for a report
+type SamplingInfo struct {
+	Method        SampleMethod `json:"method"`
+	OriginalCount int          `json:"original_count"`
+	SampleSize    int          `json:"sample_size"`
+	// Seed is the random seed used, for random and stratified sampling
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// sample reduces data to the configured sample size, keeping the input
+// order. Highlighted competitors are always kept. It returns nil info when
+// no sampling was needed.
+func (a *CompetitorIntelligenceAgent) sample(ctx context.Context, data []CompetitorData) ([]CompetitorData, *SamplingInfo) {
+	size := a.Config.SampleSize
+	if size <= 0 || len(data) <= size {
+		return data, nil
+	}
+
+	info := &SamplingInfo{Method: a.Config.SampleMethod, OriginalCount: len(data), SampleSize: size}
+	if info.Method == "" {
+		info.Method = SampleTopShare
+	}
+	if info.Method != SampleTopShare {
+		info.Seed = a.Config.SampleSeed
+		if info.Seed == 0 {
+			info.Seed = time.Now().UnixNano()
+		}
+	}
+
+	keep := make(map[int]bool, size)
+	var candidates []int
+	for i, competitor := range data {
+		if len(keep) < size && a.isHighlighted(ctx, competitor.Name) {
+			keep[i] = true
+		} else {
+			candidates = append(candidates, i)
+		}
+	}
+
+	remaining := size - len(keep)
+	switch info.Method {
+	case SampleTopShare:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return data[candidates[i]].MarketShare > data[candidates[j]].MarketShare
+		})
+		for _, i := range candidates[:remaining] {
+			keep[i] = true
+		}
+	case SampleRandom:
+		rng := rand.New(rand.NewSource(info.Seed))
+		rng.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+		for _, i := range candidates[:remaining] {
+			keep[i] = true
+		}
+	case SampleStratified:
+		for _, i := range a.stratifiedSample(data, candidates, remaining, rand.New(rand.NewSource(info.Seed))) {
+			keep[i] = true
+		}
+	}
+
+	sampled := make([]CompetitorData, 0, size)
+	for i, competitor := range data {
+		if keep[i] {
+			sampled = append(sampled, competitor)
+		}
+	}
+	return sampled, info
+}
+
+// stratifiedSample picks n of the candidate indices, allotting each threat
+// tier a share of n proportional to its size (largest remainders first) and
+// choosing randomly within tiers
+func (a *CompetitorIntelligenceAgent) stratifiedSample(data []CompetitorData, candidates []int, n int, rng *rand.Rand) []int {
+	tiers := make(map[string][]int)
+	for _, i := range candidates {
+		tier := a.threatLevelFor(data[i].MarketShare)
+		tiers[tier] = append(tiers[tier], i)
+	}
+
+	type allotment struct {
+		tier      string
+		count     int
+		remainder float64
+	}
+	var allotments []allotment
+	allotted := 0
+	for _, tier := range []string{ThreatHigh, ThreatMedium, ThreatLow} {
+		if len(tiers[tier]) == 0 {
+			continue
+		}
+		exact := float64(n) * float64(len(tiers[tier])) / float64(len(candidates))
+		count := int(exact)
+		allotments = append(allotments, allotment{tier: tier, count: count, remainder: exact - float64(count)})
+		allotted += count
+	}
+	sort.SliceStable(allotments, func(i, j int) bool {
+		return allotments[i].remainder > allotments[j].remainder
+	})
+	for i := 0; allotted < n; i = (i + 1) % len(allotments) {
+		if allotments[i].count < len(tiers[allotments[i].tier]) {
+			allotments[i].count++
+			allotted++
+		}
+	}
+
+	var picked []int
+	for _, alloc := range allotments {
+		members := tiers[alloc.tier]
+		rng.Shuffle(len(members), func(i, j int) { members[i], members[j] = members[j], members[i] })
+		picked = append(picked, members[:alloc.count]...)
+	}
+	return picked
+}
+
+// isHighlighted reports whether a run highlights the named competitor
+func (a *CompetitorIntelligenceAgent) isHighlighted(ctx context.Context, name string) bool {
+	for _, highlighted := range a.highlightsFor(ctx) {
+		if nameKey(highlighted) == nameKey(name) {
+			return true
+		}
+	}
+	return false
+}
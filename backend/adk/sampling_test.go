@@ -0,0 +1,115 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// largeMarket returns n competitors, Competitor k holding k*0.075% share,
+// in scrambled input order (n must not be a multiple of 7)
+func largeMarket(n int) []CompetitorData {
+	data := make([]CompetitorData, n)
+	for i := range data {
+		k := (i*7)%n + 1
+		data[i] = CompetitorData{Name: fmt.Sprintf("Competitor %02d", k), MarketShare: float64(k) * 0.075}
+	}
+	return data
+}
+
+func TestRun_SamplingTopShare(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithSampling(SampleTopShare, 5, 0))
+	ctx := WithCompetitorData(context.Background(), largeMarket(50))
+
+	report, err := agent.Run(ctx, "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if len(report.Competitors) != 5 {
+		t.Fatalf("Expected 5 sampled competitors, got %d", len(report.Competitors))
+	}
+	for _, analysis := range report.Competitors {
+		if analysis.MarketShare <= 45*0.075 {
+			t.Errorf("Expected only the top 5 by share, got %s at %v%%", analysis.CompetitorName, analysis.MarketShare)
+		}
+	}
+
+	want := SamplingInfo{Method: SampleTopShare, OriginalCount: 50, SampleSize: 5}
+	if report.Sampling == nil || *report.Sampling != want {
+		t.Errorf("Expected sampling info %+v, got %+v", want, report.Sampling)
+	}
+}
+
+func TestSample(t *testing.T) {
+	data := largeMarket(40)
+
+	t.Run("Small markets are untouched", func(t *testing.T) {
+		agent := NewCompetitorIntelligenceAgent(WithSampling(SampleRandom, 40, 1))
+		sampled, info := agent.sample(context.Background(), data)
+		if len(sampled) != 40 || info != nil {
+			t.Errorf("Expected no sampling, got %d competitors and %+v", len(sampled), info)
+		}
+	})
+
+	t.Run("Random is reproducible by seed", func(t *testing.T) {
+		agent := NewCompetitorIntelligenceAgent(WithSampling(SampleRandom, 8, 42))
+		first, info := agent.sample(context.Background(), data)
+		second, _ := agent.sample(context.Background(), data)
+		if len(first) != 8 || info.Seed != 42 {
+			t.Fatalf("Expected 8 competitors with seed 42, got %d and %+v", len(first), info)
+		}
+		for i := range first {
+			if first[i].Name != second[i].Name {
+				t.Fatalf("Expected the same sample for the same seed, got %s and %s", first[i].Name, second[i].Name)
+			}
+		}
+	})
+
+	t.Run("Stratified covers every tier", func(t *testing.T) {
+		var tiered []CompetitorData
+		for i, share := range []float64{25, 21, 15, 14, 13, 12, 11, 10} {
+			tiered = append(tiered, CompetitorData{Name: fmt.Sprintf("Tiered %d", i), MarketShare: share})
+		}
+		for i := 0; i < 12; i++ {
+			tiered = append(tiered, CompetitorData{Name: fmt.Sprintf("Small %d", i), MarketShare: 1})
+		}
+
+		agent := NewCompetitorIntelligenceAgent(WithSampling(SampleStratified, 10, 7))
+		sampled, _ := agent.sample(context.Background(), tiered)
+		if len(sampled) != 10 {
+			t.Fatalf("Expected 10 competitors, got %d", len(sampled))
+		}
+		tiers := make(map[string]int)
+		for _, competitor := range sampled {
+			tiers[agent.threatLevelFor(competitor.MarketShare)]++
+		}
+		// Half of 2 High, 6 Medium and 12 Low competitors
+		if tiers[ThreatLow] != 6 || tiers[ThreatMedium] != 3 || tiers[ThreatHigh] != 1 {
+			t.Errorf("Expected tiers sampled in proportion, got %v", tiers)
+		}
+	})
+
+	t.Run("Highlighted competitors are kept", func(t *testing.T) {
+		agent := NewCompetitorIntelligenceAgent(WithSampling(SampleTopShare, 3, 0))
+		ctx := WithHighlights(context.Background(), "Competitor 01")
+		sampled, _ := agent.sample(ctx, data)
+		found := false
+		for _, competitor := range sampled {
+			found = found || competitor.Name == "Competitor 01"
+		}
+		if len(sampled) != 3 || !found {
+			t.Errorf("Expected the highlighted competitor in a sample of 3, got %+v", sampled)
+		}
+	})
+}
+
+func TestParseSampleMethod(t *testing.T) {
+	if method, err := ParseSampleMethod(""); err != nil || method != SampleTopShare {
+		t.Errorf("Expected the default top_share, got %q, %v", method, err)
+	}
+	if _, err := ParseSampleMethod("biggest"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package adk
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ScoreNormalization rescales threat scores against the rest of a report's
+// competitors so reports on different markets can be compared
+type ScoreNormalization string
+
+// Supported score normalizations
+const (
+	// ScoreNormalizationNone leaves only raw threat scores
+	ScoreNormalizationNone ScoreNormalization = ""
+	// ScoreNormalizationZScore expresses scores in standard deviations from
+	// the report's mean score
+	ScoreNormalizationZScore ScoreNormalization = "zscore"
+	// ScoreNormalizationMinMax spreads scores over 0-100, from the report's
+	// lowest score to its highest
+	ScoreNormalizationMinMax ScoreNormalization = "minmax"
+)
+
+// ParseScoreNormalization validates a normalization name; empty or "none"
+// disables normalization
+func ParseScoreNormalization(name string) (ScoreNormalization, error) {
+	switch mode := ScoreNormalization(strings.ToLower(strings.TrimSpace(name))); mode {
+	case ScoreNormalizationNone, "none":
+		return ScoreNormalizationNone, nil
+	case ScoreNormalizationZScore, ScoreNormalizationMinMax:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: unknown score normalization %q (want zscore or minmax)", ErrInvalidInput, name)
+	}
+}
+
+// normalizeThreatScores sets NormalizedThreatScore on every analysis,
+// leaving ThreatScore untouched. When every score is equal there is no
+// spread to scale by, so each competitor sits at the middle: a z-score of
+// 0 or a min-max score of 50.
+func normalizeThreatScores(analyses []CompetitorAnalysis, mode ScoreNormalization) {
+	if mode == ScoreNormalizationNone || len(analyses) == 0 {
+		return
+	}
+
+	low, high := math.Inf(1), math.Inf(-1)
+	var sum float64
+	for _, analysis := range analyses {
+		low = math.Min(low, analysis.ThreatScore)
+		high = math.Max(high, analysis.ThreatScore)
+		sum += analysis.ThreatScore
+	}
+	mean := sum / float64(len(analyses))
+
+	var variance float64
+	for _, analysis := range analyses {
+		variance += (analysis.ThreatScore - mean) * (analysis.ThreatScore - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(analyses)))
+
+	for i := range analyses {
+		score := analyses[i].ThreatScore
+		var normalized float64
+		switch mode {
+		case ScoreNormalizationZScore:
+			if stddev > 0 {
+				normalized = (score - mean) / stddev
+			}
+		case ScoreNormalizationMinMax:
+			normalized = 50
+			if high > low {
+				normalized = (score - low) / (high - low) * 100
+			}
+		}
+		normalized = round2(normalized)
+		analyses[i].NormalizedThreatScore = &normalized
+	}
+}
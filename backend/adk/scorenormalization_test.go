@@ -0,0 +1,116 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseScoreNormalization(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    ScoreNormalization
+		wantErr bool
+	}{
+		{name: "", want: ScoreNormalizationNone},
+		{name: "none", want: ScoreNormalizationNone},
+		{name: "ZScore", want: ScoreNormalizationZScore},
+		{name: "minmax", want: ScoreNormalizationMinMax},
+		{name: "percentile", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScoreNormalization(tt.name)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInput) {
+					t.Errorf("Expected ErrInvalidInput, got %v", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Expected %q, got %q (%v)", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeThreatScores(t *testing.T) {
+	scores := []float64{20, 50, 80}
+
+	tests := []struct {
+		name     string
+		mode     ScoreNormalization
+		scores   []float64
+		want     []float64
+		low      float64
+		high     float64
+		wantNone bool
+	}{
+		{name: "MinMax", mode: ScoreNormalizationMinMax, scores: scores, want: []float64{0, 50, 100}, low: 0, high: 100},
+		{name: "ZScore", mode: ScoreNormalizationZScore, scores: scores, want: []float64{-1.22, 0, 1.22}, low: -3, high: 3},
+		{name: "MinMax zero variance", mode: ScoreNormalizationMinMax, scores: []float64{40, 40}, want: []float64{50, 50}, low: 0, high: 100},
+		{name: "ZScore zero variance", mode: ScoreNormalizationZScore, scores: []float64{40, 40}, want: []float64{0, 0}, low: -3, high: 3},
+		{name: "Disabled", mode: ScoreNormalizationNone, scores: scores, wantNone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyses := make([]CompetitorAnalysis, len(tt.scores))
+			for i, score := range tt.scores {
+				analyses[i].ThreatScore = score
+			}
+
+			normalizeThreatScores(analyses, tt.mode)
+
+			for i, analysis := range analyses {
+				if analysis.ThreatScore != tt.scores[i] {
+					t.Errorf("Expected raw score %v preserved, got %v", tt.scores[i], analysis.ThreatScore)
+				}
+				if tt.wantNone {
+					if analysis.NormalizedThreatScore != nil {
+						t.Errorf("Expected no normalized score, got %v", *analysis.NormalizedThreatScore)
+					}
+					continue
+				}
+				if analysis.NormalizedThreatScore == nil {
+					t.Fatalf("Expected a normalized score for %v", analysis.ThreatScore)
+				}
+				got := *analysis.NormalizedThreatScore
+				if math.IsNaN(got) || got < tt.low || got > tt.high {
+					t.Errorf("Expected normalized score in [%v, %v], got %v", tt.low, tt.high, got)
+				}
+				if got != tt.want[i] {
+					t.Errorf("Expected normalized score %v, got %v", tt.want[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateReport_ScoreNormalization(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithScoreNormalization(ScoreNormalizationMinMax))
+	analyses := []CompetitorAnalysis{
+		{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 90},
+		{CompetitorName: "Tiny", ThreatLevel: ThreatLow, ThreatScore: 10},
+	}
+
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	if report.ScoreNormalization != ScoreNormalizationMinMax {
+		t.Errorf("Expected the report to name its normalization, got %q", report.ScoreNormalization)
+	}
+	for _, analysis := range report.Competitors {
+		want := map[string]float64{"Acme": 100, "Tiny": 0}[analysis.CompetitorName]
+		if analysis.NormalizedThreatScore == nil || *analysis.NormalizedThreatScore != want {
+			t.Errorf("%s: expected normalized score %v, got %v", analysis.CompetitorName, want, analysis.NormalizedThreatScore)
+		}
+	}
+	if analyses[0].NormalizedThreatScore != nil {
+		t.Error("Expected the input analyses to be left untouched")
+	}
+}
@@ -0,0 +1,68 @@
+package adk
+
+import "math"
+
+// Threat score components reported in CompetitorAnalysis.ScoreBreakdown
+const (
+	ScoreComponentMarketShare = "market_share"
+	ScoreComponentStrengths   = "strengths"
+	ScoreComponentWeaknesses  = "weaknesses"
+	ScoreComponentFunding     = "funding"
+	ScoreComponentGrowth      = "growth"
+	ScoreComponentCustomers   = "customers"
+	ScoreComponentBounds      = "bounds_adjustment"
+)
+
+// Threat score weights; the final score is clamped to 0-100
+const (
+	marketShareWeight   = 1.5
+	strengthWeight      = 5.0
+	weaknessWeight      = -3.0
+	maxFundingScore     = 15.0
+	fundingSaturationMM = 500.0
+)
+
+// threatScoreBreakdown returns the contribution of each signal to the threat score.
+// Contributions are rounded so that they always sum exactly to the reported total.
+func threatScoreBreakdown(competitor CompetitorData) map[string]float64 {
+	return weightedThreatScoreBreakdown(competitor, float64(len(competitor.Strengths)), float64(len(competitor.Weaknesses)))
+}
+
+// weightedThreatScoreBreakdown scores strengths and weaknesses by the given
+// weights rather than their counts, so stale observations can count less
+func weightedThreatScoreBreakdown(competitor CompetitorData, strengths, weaknesses float64) map[string]float64 {
+	breakdown := map[string]float64{
+		ScoreComponentMarketShare: round2(competitor.MarketShare * marketShareWeight),
+		ScoreComponentStrengths:   round2(strengths * strengthWeight),
+		ScoreComponentWeaknesses:  round2(weaknesses * weaknessWeight),
+		ScoreComponentFunding:     round2(math.Min(competitor.Funding/fundingSaturationMM, 1) * maxFundingScore),
+	}
+	if competitor.GrowthRate != 0 {
+		breakdown[ScoreComponentGrowth] = round2(growthScore(competitor.GrowthRate))
+	}
+	if competitor.Customers > 0 {
+		breakdown[ScoreComponentCustomers] = round2(customerScore(competitor.Customers))
+	}
+
+	raw := sumBreakdown(breakdown)
+	clamped := math.Max(0, math.Min(100, raw))
+	if clamped != raw {
+		breakdown[ScoreComponentBounds] = round2(clamped - raw)
+	}
+
+	return breakdown
+}
+
+// sumBreakdown totals score components
+func sumBreakdown(breakdown map[string]float64) float64 {
+	total := 0.0
+	for _, contribution := range breakdown {
+		total += contribution
+	}
+	return round2(total)
+}
+
+// round2 rounds a value to two decimal places
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
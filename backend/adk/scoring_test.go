@@ -0,0 +1,90 @@
+package adk
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+// TestAnalyze_ScoreBreakdown tests that breakdown components sum to the threat score
+func TestAnalyze_ScoreBreakdown(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithScoreBreakdown(true))
+
+	data := []CompetitorData{
+		{
+			Name:        "Leader Corp",
+			MarketShare: 30,
+			Strengths:   []string{"Brand", "Scale"},
+			Weaknesses:  []string{"Price"},
+			Funding:     300,
+		},
+		{
+			Name:        "Tiny Corp",
+			MarketShare: 0.5,
+			Weaknesses:  []string{"Support", "Features", "Brand"},
+		},
+		{
+			Name:        "Giant Corp",
+			MarketShare: 80,
+			Strengths:   []string{"Brand", "Scale", "Price", "Support"},
+			Funding:     5000,
+		},
+	}
+
+	analyses, err := agent.Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	for _, analysis := range analyses {
+		if analysis.ScoreBreakdown == nil {
+			t.Fatalf("%s: expected score breakdown, got nil", analysis.CompetitorName)
+		}
+
+		for _, component := range []string{ScoreComponentMarketShare, ScoreComponentStrengths, ScoreComponentWeaknesses, ScoreComponentFunding} {
+			if _, ok := analysis.ScoreBreakdown[component]; !ok {
+				t.Errorf("%s: breakdown missing component %q", analysis.CompetitorName, component)
+			}
+		}
+
+		if sum := sumBreakdown(analysis.ScoreBreakdown); math.Abs(sum-analysis.ThreatScore) > 1e-9 {
+			t.Errorf("%s: breakdown sums to %v, want %v", analysis.CompetitorName, sum, analysis.ThreatScore)
+		}
+
+		if analysis.ThreatScore < 0 || analysis.ThreatScore > 100 {
+			t.Errorf("%s: ThreatScore = %v, want within 0-100", analysis.CompetitorName, analysis.ThreatScore)
+		}
+	}
+
+	if analyses[0].ScoreBreakdown[ScoreComponentMarketShare] != 45 {
+		t.Errorf("Expected market share contribution 45, got %v", analyses[0].ScoreBreakdown[ScoreComponentMarketShare])
+	}
+
+	if _, ok := analyses[1].ScoreBreakdown[ScoreComponentBounds]; !ok {
+		t.Error("Expected bounds adjustment for a score clamped at 0")
+	}
+
+	if analyses[2].ThreatScore != 100 {
+		t.Errorf("Expected clamped ThreatScore 100, got %v", analyses[2].ThreatScore)
+	}
+}
+
+// TestAnalyze_ScoreBreakdownDisabled tests that the breakdown is omitted by default
+func TestAnalyze_ScoreBreakdownDisabled(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "Test Corp", MarketShare: 20, Strengths: []string{"Brand"}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if analyses[0].ScoreBreakdown != nil {
+		t.Errorf("Expected no breakdown by default, got %v", analyses[0].ScoreBreakdown)
+	}
+
+	if analyses[0].ThreatScore != 35 {
+		t.Errorf("Expected ThreatScore 35, got %v", analyses[0].ThreatScore)
+	}
+}
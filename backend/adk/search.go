@@ -0,0 +1,116 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Pagination defaults for report searches
+const (
+	DefaultSearchPageSize = 20
+	MaxSearchPageSize     = 100
+)
+
+// ReportQuery filters stored reports. Empty fields match everything.
+type ReportQuery struct {
+	CompanyName    string
+	CompetitorName string
+	ThreatLevel    string
+	Page           int
+	PageSize       int
+}
+
+// CompetitorMatch is a competitor within a report that satisfied the query
+type CompetitorMatch struct {
+	CompetitorName string  `json:"competitor_name"`
+	ThreatLevel    string  `json:"threat_level"`
+	ThreatScore    float64 `json:"threat_score"`
+}
+
+// ReportSummary is a lightweight view of a stored report
+type ReportSummary struct {
+	ID              string            `json:"id"`
+	GeneratedAt     time.Time         `json:"generated_at"`
+	TargetCompany   string            `json:"target_company"`
+	CompetitorCount int               `json:"competitor_count"`
+	Matches         []CompetitorMatch `json:"matches"`
+}
+
+// ReportSearchResult is one page of report summaries
+type ReportSearchResult struct {
+	Results  []ReportSummary `json:"results"`
+	Total    int             `json:"total"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"page_size"`
+}
+
+// SearchReports scans stored reports for competitors matching the query,
+// newest reports first
+func SearchReports(ctx context.Context, store ReportStore, query ReportQuery) (*ReportSearchResult, error) {
+	reports, err := store.List(ctx, query.CompanyName)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = DefaultSearchPageSize
+	}
+	if query.PageSize > MaxSearchPageSize {
+		query.PageSize = MaxSearchPageSize
+	}
+
+	var summaries []ReportSummary
+	for i := len(reports) - 1; i >= 0; i-- {
+		report := reports[i]
+
+		var matches []CompetitorMatch
+		for _, analysis := range report.Competitors {
+			if query.CompetitorName != "" && !strings.EqualFold(analysis.CompetitorName, query.CompetitorName) {
+				continue
+			}
+			if query.ThreatLevel != "" && !strings.EqualFold(analysis.ThreatLevel, query.ThreatLevel) {
+				continue
+			}
+			matches = append(matches, CompetitorMatch{
+				CompetitorName: analysis.CompetitorName,
+				ThreatLevel:    analysis.ThreatLevel,
+				ThreatScore:    analysis.ThreatScore,
+			})
+		}
+
+		filtered := query.CompetitorName != "" || query.ThreatLevel != ""
+		if filtered && len(matches) == 0 {
+			continue
+		}
+
+		summaries = append(summaries, ReportSummary{
+			ID:              report.ID,
+			GeneratedAt:     report.GeneratedAt,
+			TargetCompany:   report.TargetCompany,
+			CompetitorCount: len(report.Competitors),
+			Matches:         matches,
+		})
+	}
+
+	result := &ReportSearchResult{
+		Results:  []ReportSummary{},
+		Total:    len(summaries),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	// Comparing page counts before computing the offset keeps huge page
+	// numbers from overflowing; pages past the end are empty
+	pages := (len(summaries) + query.PageSize - 1) / query.PageSize
+	if query.Page <= pages {
+		start := (query.Page - 1) * query.PageSize
+		end := min(start+query.PageSize, len(summaries))
+		result.Results = summaries[start:end]
+	}
+
+	return result, nil
+}
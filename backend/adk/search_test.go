@@ -0,0 +1,84 @@
+package adk
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+// seedSearchStore stores reports with varying threat levels for Competitor X
+func seedSearchStore(t *testing.T) ReportStore {
+	t.Helper()
+
+	store := NewMemoryReportStore()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	levels := []string{"High", "Low", "High", "Medium", "High"}
+
+	for i, level := range levels {
+		report := &CompetitorReport{
+			GeneratedAt:   base.Add(time.Duration(i) * time.Hour),
+			TargetCompany: "TestCorp",
+			Competitors: []CompetitorAnalysis{
+				{CompetitorName: "Competitor X", ThreatLevel: level},
+				{CompetitorName: "Competitor Y", ThreatLevel: "High"},
+			},
+		}
+		if err := store.Save(context.Background(), report); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	return store
+}
+
+// TestSearchReports tests filtering and pagination over stored reports
+func TestSearchReports(t *testing.T) {
+	store := seedSearchStore(t)
+	ctx := context.Background()
+
+	result, err := SearchReports(ctx, store, ReportQuery{CompetitorName: "competitor x", ThreatLevel: "High"})
+	if err != nil {
+		t.Fatalf("SearchReports() error = %v", err)
+	}
+
+	if result.Total != 3 {
+		t.Fatalf("Expected 3 matching reports, got %d", result.Total)
+	}
+
+	for _, summary := range result.Results {
+		if len(summary.Matches) != 1 || summary.Matches[0].CompetitorName != "Competitor X" || summary.Matches[0].ThreatLevel != "High" {
+			t.Errorf("Unexpected matches %+v", summary.Matches)
+		}
+	}
+
+	if !result.Results[0].GeneratedAt.After(result.Results[1].GeneratedAt) {
+		t.Error("Expected newest reports first")
+	}
+
+	paged, err := SearchReports(ctx, store, ReportQuery{CompetitorName: "Competitor X", ThreatLevel: "High", Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("SearchReports() error = %v", err)
+	}
+
+	if paged.Total != 3 || len(paged.Results) != 1 {
+		t.Errorf("Expected 1 result on page 2 of 3 total, got %d of %d", len(paged.Results), paged.Total)
+	}
+
+	past, err := SearchReports(ctx, store, ReportQuery{Page: math.MaxInt, PageSize: 2})
+	if err != nil {
+		t.Fatalf("SearchReports() error = %v", err)
+	}
+
+	if past.Total != 5 || len(past.Results) != 0 {
+		t.Errorf("Expected an empty page past the end, got %d of %d", len(past.Results), past.Total)
+	}
+
+	none, err := SearchReports(ctx, store, ReportQuery{CompetitorName: "Nobody"})
+	if err != nil {
+		t.Fatalf("SearchReports() error = %v", err)
+	}
+
+	if none.Total != 0 || none.Results == nil {
+		t.Errorf("Expected empty non-nil results, got %+v", none)
+	}
+}
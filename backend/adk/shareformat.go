@@ -0,0 +1,49 @@
+package adk
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ShareFormat controls how market shares are serialized in reports
+type ShareFormat string
+
+// Supported share formats
+const (
+	// ShareFloat keeps market shares as computed (the default)
+	ShareFloat ShareFormat = "float"
+	// ShareInteger rounds market shares to whole percents
+	ShareInteger ShareFormat = "integer"
+)
+
+// ParseShareFormat validates a share format name; empty selects ShareFloat
+func ParseShareFormat(name string) (ShareFormat, error) {
+	switch format := ShareFormat(strings.ToLower(name)); format {
+	case "":
+		return ShareFloat, nil
+	case ShareFloat, ShareInteger:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: unknown share format %q (want float or integer)", ErrInvalidInput, name)
+	}
+}
+
+// WithShareFormat returns a copy of the report with market shares rendered
+// in format. Only ShareInteger changes anything; it rounds half away from
+// zero, so 25.5 becomes 26.
+func (r *CompetitorReport) WithShareFormat(format ShareFormat) *CompetitorReport {
+	if format != ShareInteger {
+		return r
+	}
+
+	out := *r
+	out.OthersShare = math.Round(r.OthersShare)
+	out.Competitors = make([]CompetitorAnalysis, len(r.Competitors))
+	for i, analysis := range r.Competitors {
+		analysis.MarketShare = math.Round(analysis.MarketShare)
+		analysis.NormalizedShare = math.Round(analysis.NormalizedShare)
+		out.Competitors[i] = analysis
+	}
+	return &out
+}
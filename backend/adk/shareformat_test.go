@@ -0,0 +1,66 @@
+package adk
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExport_ShareFormat(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "A", MarketShare: 25.5}}}
+
+	tests := []struct {
+		format   ShareFormat
+		json     float64
+		markdown string
+		csv      string
+	}{
+		{ShareFloat, 25.5, "- **Market share:** 25.5%", ",25.5,"},
+		{ShareInteger, 26, "- **Market share:** 26%", ",26,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(WithShareFormat(tt.format))
+
+			out, err := agent.Export(report, FormatJSON)
+			if err != nil {
+				t.Fatalf("Export(json) error = %v", err)
+			}
+			var decoded CompetitorReport
+			if err := json.Unmarshal(out, &decoded); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got := decoded.Competitors[0].MarketShare; got != tt.json {
+				t.Errorf("Expected JSON market share %v, got %v", tt.json, got)
+			}
+
+			out, _ = agent.Export(report, FormatMarkdown)
+			if !strings.Contains(string(out), tt.markdown) {
+				t.Errorf("Expected Markdown to contain %q, got:\n%s", tt.markdown, out)
+			}
+
+			out, _ = agent.Export(report, FormatCSV)
+			if !strings.Contains(string(out), tt.csv) {
+				t.Errorf("Expected CSV to contain %q, got:\n%s", tt.csv, out)
+			}
+		})
+	}
+
+	if report.Competitors[0].MarketShare != 25.5 {
+		t.Errorf("Expected the source report to be unchanged, got %v", report.Competitors[0].MarketShare)
+	}
+}
+
+func TestParseShareFormat(t *testing.T) {
+	if format, err := ParseShareFormat(""); err != nil || format != ShareFloat {
+		t.Errorf("Expected float by default, got %q, %v", format, err)
+	}
+	if format, err := ParseShareFormat("Integer"); err != nil || format != ShareInteger {
+		t.Errorf("Expected integer, got %q, %v", format, err)
+	}
+	if _, err := ParseShareFormat("percent"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
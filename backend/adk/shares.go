@@ -0,0 +1,43 @@
+package adk
+
+import "math"
+
+// normalizeShares fills NormalizedShare so competitor shares plus the
+// returned "others" share sum to exactly 100. Shares summing above 100 are
+// scaled down proportionally; shares below 100 are kept and the remainder
+// is attributed to others. Original MarketShare values are left untouched.
+func normalizeShares(data []CompetitorData) (others float64) {
+	var total float64
+	for _, competitor := range data {
+		total += max(competitor.MarketShare, 0)
+	}
+	if total == 0 {
+		for i := range data {
+			data[i].NormalizedShare = 0
+		}
+		return 100
+	}
+
+	scale := 1.0
+	if total > 100 {
+		scale = 100 / total
+	}
+
+	var sum float64
+	largest := 0
+	for i := range data {
+		data[i].NormalizedShare = round2(max(data[i].MarketShare, 0) * scale)
+		sum += data[i].NormalizedShare
+		if data[i].NormalizedShare > data[largest].NormalizedShare {
+			largest = i
+		}
+	}
+
+	others = round2(math.Max(100-sum, 0))
+	if total > 100 {
+		// Absorb rounding drift in the largest share so the total is exact
+		data[largest].NormalizedShare = round2(data[largest].NormalizedShare + 100 - sum)
+		others = 0
+	}
+	return others
+}
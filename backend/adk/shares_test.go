@@ -0,0 +1,74 @@
+package adk
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestNormalizeShares(t *testing.T) {
+	tests := []struct {
+		name       string
+		shares     []float64
+		wantShares []float64
+		wantOthers float64
+	}{
+		{"over 100 scales down", []float64{60, 50, 40}, []float64{40, 33.33, 26.67}, 0},
+		{"under 100 leaves others", []float64{25.5, 18.2, 12.8}, []float64{25.5, 18.2, 12.8}, 43.5},
+		{"rounding drift absorbed", []float64{1, 1, 1, 100}, []float64{0.97, 0.97, 0.97, 97.09}, 0},
+		{"all zero", []float64{0, 0}, []float64{0, 0}, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := make([]CompetitorData, len(tt.shares))
+			for i, share := range tt.shares {
+				data[i] = CompetitorData{MarketShare: share}
+			}
+
+			others := normalizeShares(data)
+			if others != tt.wantOthers {
+				t.Errorf("Expected others %v, got %v", tt.wantOthers, others)
+			}
+
+			total := others
+			for i, competitor := range data {
+				if competitor.NormalizedShare != tt.wantShares[i] {
+					t.Errorf("Share %d: expected %v, got %v", i, tt.wantShares[i], competitor.NormalizedShare)
+				}
+				if competitor.MarketShare != tt.shares[i] {
+					t.Errorf("Share %d: expected original %v preserved, got %v", i, tt.shares[i], competitor.MarketShare)
+				}
+				total += competitor.NormalizedShare
+			}
+			if math.Abs(total-100) > 1e-9 {
+				t.Errorf("Expected shares plus others to sum to 100, got %v", total)
+			}
+		})
+	}
+}
+
+func TestRun_ShareNormalization(t *testing.T) {
+	source := taggedSource{records: []CompetitorData{
+		{Name: "A", MarketShare: 70},
+		{Name: "B", MarketShare: 30},
+		{Name: "C", MarketShare: 50},
+	}}
+	agent := NewCompetitorIntelligenceAgent(WithShareNormalization(), WithDataSource(source))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	var total float64
+	for _, analysis := range report.Competitors {
+		total += analysis.NormalizedShare
+	}
+	if math.Abs(total+report.OthersShare-100) > 1e-9 {
+		t.Errorf("Expected normalized shares to sum to 100, got %v", total+report.OthersShare)
+	}
+	if report.Competitors[0].MarketShare != 70 {
+		t.Errorf("Expected original share 70 preserved, got %v", report.Competitors[0].MarketShare)
+	}
+}
@@ -0,0 +1,49 @@
+package adk
+
+import "math"
+
+// shareSample is one source's market share for a competitor
+type shareSample struct {
+	source string
+	share  float64
+	weight float64
+}
+
+// shareWeight returns a source's reliability weight (1 when unlisted)
+func (m *MultiDataSource) shareWeight(source string) float64 {
+	if weight, ok := m.ShareWeights[source]; ok {
+		return weight
+	}
+	return 1
+}
+
+// weightedShare sets the competitor's market share to the weighted mean of
+// the samples and records their weighted standard deviation as uncertainty.
+// It leaves the precedence-merged share alone when no sample carries weight.
+func weightedShare(competitor *CompetitorData, samples []shareSample) {
+	var total, sum float64
+	var sources string
+	for _, sample := range samples {
+		if sample.weight <= 0 {
+			continue
+		}
+		total += sample.weight
+		sum += sample.weight * sample.share
+		sources = joinSources(sources, sample.source)
+	}
+	if total == 0 {
+		return
+	}
+
+	mean := sum / total
+	var variance float64
+	for _, sample := range samples {
+		if sample.weight > 0 {
+			variance += sample.weight * (sample.share - mean) * (sample.share - mean)
+		}
+	}
+
+	competitor.MarketShare = round2(mean)
+	competitor.ShareUncertainty = round2(math.Sqrt(variance / total))
+	competitor.FieldSources["market_share"] = sources
+}
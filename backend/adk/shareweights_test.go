@@ -0,0 +1,64 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestMultiDataSource_ShareWeights(t *testing.T) {
+	analyst := staticSource{name: "analyst", records: []CompetitorData{{Name: "Acme", MarketShare: 20}}}
+	crawler := staticSource{name: "crawler", records: []CompetitorData{
+		{Name: "Acme", MarketShare: 40},
+		{Name: "Globex", MarketShare: 8},
+	}}
+
+	tests := []struct {
+		name            string
+		weights         map[string]float64
+		wantShare       float64
+		wantUncertainty float64
+		wantSources     string
+	}{
+		{name: "Precedence without weights", wantShare: 20, wantSources: "analyst"},
+		{name: "Weighted mean", weights: map[string]float64{"analyst": 3, "crawler": 1}, wantShare: 25, wantUncertainty: 8.66, wantSources: "analyst,crawler"},
+		{name: "Unlisted sources weigh one", weights: map[string]float64{}, wantShare: 30, wantUncertainty: 10, wantSources: "analyst,crawler"},
+		{name: "Zero weight excludes a source", weights: map[string]float64{"analyst": 0}, wantShare: 40, wantSources: "crawler"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := NewMultiDataSource(analyst, crawler)
+			source.ShareWeights = tt.weights
+
+			merged, err := source.FetchCompetitors(context.Background(), "TestCorp", "SaaS")
+			if err != nil {
+				t.Fatalf("FetchCompetitors() error = %v", err)
+			}
+
+			acme := merged[0]
+			if acme.MarketShare != tt.wantShare {
+				t.Errorf("Expected market share %v, got %v", tt.wantShare, acme.MarketShare)
+			}
+			if acme.ShareUncertainty != tt.wantUncertainty {
+				t.Errorf("Expected uncertainty %v, got %v", tt.wantUncertainty, acme.ShareUncertainty)
+			}
+			if acme.FieldSources["market_share"] != tt.wantSources {
+				t.Errorf("Expected market share from %q, got %q", tt.wantSources, acme.FieldSources["market_share"])
+			}
+
+			// A single-source competitor keeps its share with no spread
+			if merged[1].MarketShare != 8 || merged[1].ShareUncertainty != 0 {
+				t.Errorf("Expected Globex at 8%% with no uncertainty, got %+v", merged[1])
+			}
+		})
+	}
+}
+
+func TestMarkdown_ShareUncertainty(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{{CompetitorName: "Acme", MarketShare: 25, ShareUncertainty: 8.66}}}
+
+	if md := report.ToMarkdown(FormatOptions{}); !strings.Contains(md, "25% (±8.66)") {
+		t.Errorf("Expected share with uncertainty in markdown, got:\n%s", md)
+	}
+}
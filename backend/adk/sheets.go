@@ -0,0 +1,95 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+)
+
+// sheetsColumn is one typed column of the spreadsheet export
+type sheetsColumn struct {
+	header string
+	value  func(CompetitorAnalysis) string
+}
+
+// sheetsColumns lays out one column per analysis field. Headers name the
+// unit so numeric columns import as numbers, and lists stay in one cell,
+// one item per line.
+var sheetsColumns = []sheetsColumn{
+	{"Competitor", func(a CompetitorAnalysis) string { return a.CompetitorName }},
+	{"Rank", func(a CompetitorAnalysis) string { return strconv.Itoa(a.Rank) }},
+	{"Threat Level", func(a CompetitorAnalysis) string { return a.ThreatLevel }},
+	{"Threat Score", func(a CompetitorAnalysis) string { return formatFloat(a.ThreatScore) }},
+	{"Emerging Threat", func(a CompetitorAnalysis) string { return sheetsBool(a.EmergingThreat) }},
+	{"Market Share (%)", func(a CompetitorAnalysis) string { return formatFloat(a.MarketShare) }},
+	{"Funding (USD M)", func(a CompetitorAnalysis) string { return formatFloat(a.Funding) }},
+	{"Momentum", func(a CompetitorAnalysis) string { return a.Momentum }},
+	{"Positioning", func(a CompetitorAnalysis) string { return a.Positioning }},
+	{"Completeness (0-1)", func(a CompetitorAnalysis) string { return formatFloat(a.Completeness) }},
+	{"Confidence (0-1)", func(a CompetitorAnalysis) string { return formatFloat(a.Confidence) }},
+	{"Key Differentiators", func(a CompetitorAnalysis) string { return sheetsList(a.KeyDifferentiators) }},
+	{"Weaknesses", func(a CompetitorAnalysis) string { return sheetsList(a.Weaknesses) }},
+	{"Opportunities", func(a CompetitorAnalysis) string { return sheetsList(a.Opportunities) }},
+	{"Risks", func(a CompetitorAnalysis) string { return sheetsList(a.Risks) }},
+	{"Action Plan", func(a CompetitorAnalysis) string { return sheetsList(a.ActionPlan) }},
+	{"Tech Stack", func(a CompetitorAnalysis) string { return sheetsList(a.TechStack) }},
+	{"Industries", func(a CompetitorAnalysis) string { return sheetsList(a.Industries) }},
+	{"Notes", func(a CompetitorAnalysis) string { return sheetsList(a.Notes) }},
+}
+
+// ToSheets renders a CSV laid out for spreadsheet import: a single header
+// row (so it can be frozen) and one row per competitor. Text that a
+// spreadsheet would evaluate as a formula is escaped.
+func (r *CompetitorReport) ToSheets(opts FormatOptions) ([]byte, error) {
+	r = r.Truncated(opts.MaxFieldLength)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := make([]string, len(sheetsColumns))
+	for i, column := range sheetsColumns {
+		header[i] = column.header
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, analysis := range r.Competitors {
+		row := make([]string, len(sheetsColumns))
+		for i, column := range sheetsColumns {
+			row[i] = sheetsText(column.value(analysis))
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// sheetsList joins items one per line so they stay in a single cell
+func sheetsList(items []string) string {
+	return strings.Join(items, "\n")
+}
+
+// sheetsBool renders booleans the way spreadsheets parse them
+func sheetsBool(v bool) string {
+	if v {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// sheetsText stops text starting with a formula trigger from being
+// evaluated; numbers, including negative ones, are left alone
+func sheetsText(value string) string {
+	if value == "" || !strings.ContainsRune("=+-@", rune(value[0])) {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return "'" + value
+}
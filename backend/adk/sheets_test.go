@@ -0,0 +1,76 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+func TestToSheets(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{
+			CompetitorName:     "Acme, Inc.",
+			Rank:               1,
+			ThreatLevel:        ThreatHigh,
+			ThreatScore:        72.5,
+			EmergingThreat:     true,
+			MarketShare:        25.5,
+			KeyDifferentiators: []string{`Says "fast"`, "Brand"},
+			Notes:              []string{"=HYPERLINK(\"http://evil\")", "-5 points on NPS"},
+		},
+		{CompetitorName: "Beta", Rank: 2, ThreatLevel: ThreatLow, Funding: -1},
+	}}
+
+	out, err := report.ToSheets(FormatOptions{})
+	if err != nil {
+		t.Fatalf("ToSheets() error = %v", err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("Expected well-formed CSV, got %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got %d", len(rows))
+	}
+
+	header := rows[0]
+	if len(header) != len(sheetsColumns) || header[0] != "Competitor" || header[5] != "Market Share (%)" {
+		t.Errorf("Unexpected header %v", header)
+	}
+
+	cell := func(row []string, name string) string {
+		for i, h := range header {
+			if h == name {
+				return row[i]
+			}
+		}
+		t.Fatalf("Missing column %q", name)
+		return ""
+	}
+
+	acme := rows[1]
+	if len(acme) != len(header) {
+		t.Fatalf("Expected %d cells, got %d", len(header), len(acme))
+	}
+	tests := []struct {
+		column string
+		want   string
+	}{
+		{"Competitor", "Acme, Inc."},
+		{"Threat Score", "72.5"},
+		{"Emerging Threat", "TRUE"},
+		{"Market Share (%)", "25.5"},
+		{"Key Differentiators", "Says \"fast\"\nBrand"},
+		{"Notes", "'=HYPERLINK(\"http://evil\")\n-5 points on NPS"},
+	}
+	for _, tt := range tests {
+		if got := cell(acme, tt.column); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.column, tt.want, got)
+		}
+	}
+
+	if got := cell(rows[2], "Funding (USD M)"); got != "-1" {
+		t.Errorf("Expected negative numbers left unescaped, got %q", got)
+	}
+}
@@ -0,0 +1,57 @@
+package adk
+
+import (
+	"sort"
+	"time"
+)
+
+// defaultTrendRuns is how many recent runs a threat trend covers by default
+const defaultTrendRuns = 10
+
+// TrendPoint is one run's threat score for a competitor
+type TrendPoint struct {
+	At    time.Time `json:"at"`
+	Score float64   `json:"score"`
+}
+
+// ThreatTrend is a competitor's threat score over recent runs, oldest first,
+// compact enough to draw as a sparkline
+type ThreatTrend struct {
+	CompetitorName string       `json:"competitor_name"`
+	Points         []TrendPoint `json:"points"`
+}
+
+// ThreatTrends builds a threat score series per competitor from the last
+// runs reports of history (defaultTrendRuns when runs is not positive).
+// Competitors contribute a point only for the runs they appear in; series
+// are ordered by competitor name.
+func ThreatTrends(history []*CompetitorReport, runs int) []ThreatTrend {
+	if runs <= 0 {
+		runs = defaultTrendRuns
+	}
+	ordered := append([]*CompetitorReport(nil), history...)
+	sortReports(ordered)
+	if len(ordered) > runs {
+		ordered = ordered[len(ordered)-runs:]
+	}
+
+	index := make(map[string]int)
+	trends := []ThreatTrend{}
+	for _, report := range ordered {
+		for _, analysis := range report.Competitors {
+			key := nameKey(analysis.CompetitorName)
+			i, ok := index[key]
+			if !ok {
+				i = len(trends)
+				index[key] = i
+				trends = append(trends, ThreatTrend{CompetitorName: analysis.CompetitorName})
+			}
+			trends[i].Points = append(trends[i].Points, TrendPoint{At: report.GeneratedAt, Score: analysis.ThreatScore})
+		}
+	}
+
+	sort.SliceStable(trends, func(i, j int) bool {
+		return nameKey(trends[i].CompetitorName) < nameKey(trends[j].CompetitorName)
+	})
+	return trends
+}
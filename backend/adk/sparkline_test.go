@@ -0,0 +1,49 @@
+package adk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThreatTrends(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := func(day int, scores map[string]float64) *CompetitorReport {
+		r := &CompetitorReport{GeneratedAt: base.AddDate(0, 0, day)}
+		for name, score := range scores {
+			r.Competitors = append(r.Competitors, CompetitorAnalysis{CompetitorName: name, ThreatScore: score})
+		}
+		return r
+	}
+
+	// Stored out of order; Beta misses the second run
+	history := []*CompetitorReport{
+		report(3, map[string]float64{"Acme": 40, "Beta": 22}),
+		report(1, map[string]float64{"Acme": 30, "Beta": 20}),
+		report(0, map[string]float64{"Acme": 10, "Beta": 18}),
+		report(2, map[string]float64{"Acme": 35}),
+	}
+
+	trends := ThreatTrends(history, 3)
+	if len(trends) != 2 || trends[0].CompetitorName != "Acme" || trends[1].CompetitorName != "Beta" {
+		t.Fatalf("Expected Acme and Beta series, got %+v", trends)
+	}
+
+	acme := trends[0].Points
+	wantScores := []float64{30, 35, 40}
+	if len(acme) != len(wantScores) {
+		t.Fatalf("Expected the last 3 runs for Acme, got %+v", acme)
+	}
+	for i, point := range acme {
+		if point.Score != wantScores[i] || !point.At.Equal(base.AddDate(0, 0, i+1)) {
+			t.Errorf("Point %d: expected %v on day %d, got %+v", i, wantScores[i], i+1, point)
+		}
+	}
+
+	if beta := trends[1].Points; len(beta) != 2 || beta[0].Score != 20 || beta[1].Score != 22 {
+		t.Errorf("Expected Beta points only for runs it appears in, got %+v", beta)
+	}
+
+	if all := ThreatTrends(history, 0); len(all[0].Points) != 4 {
+		t.Errorf("Expected the default cap to cover all 4 runs, got %d", len(all[0].Points))
+	}
+}
@@ -0,0 +1,315 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// ErrReportNotFound is returned when a stored report does not exist
+var ErrReportNotFound = errors.New("report not found")
+
+// ReportStore persists generated reports
+type ReportStore interface {
+	// Save stores a report, assigning an ID if it has none
+	Save(ctx context.Context, report *CompetitorReport) error
+	// Get loads a report by ID
+	Get(ctx context.Context, id string) (*CompetitorReport, error)
+	// List returns stored reports for a company (all companies when empty),
+	// oldest first
+	List(ctx context.Context, companyName string) ([]*CompetitorReport, error)
+	// Delete removes a report by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// MemoryReportStore keeps reports in process memory
+type MemoryReportStore struct {
+	mu      sync.RWMutex
+	reports map[string]*CompetitorReport
+
+	// maxReports caps how many reports are kept, evicting the oldest
+	// (0 keeps every report)
+	maxReports int
+}
+
+// MemoryStoreOption configures a MemoryReportStore
+type MemoryStoreOption func(*MemoryReportStore)
+
+// WithMaxReports keeps at most n reports, evicting the oldest generated
+// report when a save goes over the limit. n <= 0 keeps every report.
+func WithMaxReports(n int) MemoryStoreOption {
+	return func(s *MemoryReportStore) {
+		s.maxReports = n
+	}
+}
+
+// NewMemoryReportStore creates an empty in-memory store
+func NewMemoryReportStore(opts ...MemoryStoreOption) *MemoryReportStore {
+	store := &MemoryReportStore{
+		reports: make(map[string]*CompetitorReport),
+	}
+	for _, opt := range opts {
+		opt(store)
+	}
+	return store
+}
+
+// Save stores a deep copy of the report, evicting the oldest reports beyond the
+// store's limit
+func (s *MemoryReportStore) Save(ctx context.Context, report *CompetitorReport) error {
+	if report.ID == "" {
+		report.ID = uuid.NewString()
+	}
+
+	stored, err := cloneReport(report)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reports[report.ID] = stored
+	for s.maxReports > 0 && len(s.reports) > s.maxReports {
+		s.evictOldest()
+	}
+	return nil
+}
+
+// evictOldest removes the earliest generated report; callers hold the lock
+func (s *MemoryReportStore) evictOldest() {
+	var oldest *CompetitorReport
+	for _, report := range s.reports {
+		if oldest == nil || sortsBefore(report, oldest) {
+			oldest = report
+		}
+	}
+	delete(s.reports, oldest.ID)
+}
+
+// Get returns a deep copy of the stored report
+func (s *MemoryReportStore) Get(ctx context.Context, id string) (*CompetitorReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	report, ok := s.reports[id]
+	if !ok {
+		return nil, ErrReportNotFound
+	}
+	return cloneReport(report)
+}
+
+// List returns deep copies of stored reports matching the company, oldest first
+func (s *MemoryReportStore) List(ctx context.Context, companyName string) ([]*CompetitorReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var reports []*CompetitorReport
+	for _, report := range s.reports {
+		if matchesCompany(report, companyName) {
+			found, err := cloneReport(report)
+			if err != nil {
+				return nil, err
+			}
+			reports = append(reports, found)
+		}
+	}
+	sortReports(reports)
+	return reports, nil
+}
+
+// Delete removes a stored report
+func (s *MemoryReportStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.reports[id]; !ok {
+		return ErrReportNotFound
+	}
+	delete(s.reports, id)
+	return nil
+}
+
+// cloneReport deep-copies a report through its JSON encoding, so the memory
+// store keeps exactly what a file store would and shares nothing with callers
+func cloneReport(report *CompetitorReport) (*CompetitorReport, error) {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy report: %w", err)
+	}
+	var clone CompetitorReport
+	if err := json.Unmarshal(data, &clone); err != nil {
+		return nil, fmt.Errorf("failed to copy report: %w", err)
+	}
+	return &clone, nil
+}
+
+// FileReportStore keeps one JSON file per report in a directory
+type FileReportStore struct {
+	mu  sync.RWMutex
+	dir string
+
+	// compress gzips reports as they are saved
+	compress bool
+}
+
+// NewFileReportStore creates a store rooted at dir, creating it if needed
+func NewFileReportStore(dir string, opts ...FileStoreOption) (*FileReportStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create report directory: %w", err)
+	}
+	store := &FileReportStore{dir: dir}
+	for _, opt := range opts {
+		opt(store)
+	}
+	return store, nil
+}
+
+// Save writes the report to <dir>/<id>.json, or <dir>/<id>.json.gz when
+// compression is enabled, replacing any copy in the other form
+func (s *FileReportStore) Save(ctx context.Context, report *CompetitorReport) error {
+	if report.ID == "" {
+		report.ID = uuid.NewString()
+	}
+
+	data, err := report.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	path, stale := s.path(report.ID), s.path(report.ID)+compressedExt
+	if s.compress {
+		if data, err = compressReport(data); err != nil {
+			return fmt.Errorf("failed to compress report: %w", err)
+		}
+		path, stale = stale, path
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return err
+	}
+	if err := os.Remove(stale); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Get reads a report by ID
+func (s *FileReportStore) Get(ctx context.Context, id string) (*CompetitorReport, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return nil, ErrReportNotFound
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	report, err := s.load(s.path(id) + compressedExt)
+	if errors.Is(err, ErrReportNotFound) {
+		return s.load(s.path(id))
+	}
+	return report, err
+}
+
+// List reads every stored report matching the company, oldest first
+func (s *FileReportStore) List(ctx context.Context, companyName string) ([]*CompetitorReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := filepath.Glob(filepath.Join(s.dir, "*.json"+compressedExt))
+	if err != nil {
+		return nil, err
+	}
+	paths = append(paths, compressed...)
+
+	var reports []*CompetitorReport
+	for _, path := range paths {
+		report, err := s.load(path)
+		if err != nil {
+			return nil, err
+		}
+		if matchesCompany(report, companyName) {
+			reports = append(reports, report)
+		}
+	}
+	sortReports(reports)
+	return reports, nil
+}
+
+// Delete removes a report's file in either form
+func (s *FileReportStore) Delete(ctx context.Context, id string) error {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return ErrReportNotFound
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed := false
+	for _, path := range []string{s.path(id), s.path(id) + compressedExt} {
+		err := os.Remove(path)
+		if err == nil {
+			removed = true
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	if !removed {
+		return ErrReportNotFound
+	}
+	return nil
+}
+
+// path returns the file backing a report ID
+func (s *FileReportStore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+// load decodes a single report file
+func (s *FileReportStore) load(path string) (*CompetitorReport, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrReportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data, err = decompressReport(data); err != nil {
+		return nil, fmt.Errorf("failed to decompress report %s: %w", filepath.Base(path), err)
+	}
+
+	report := new(CompetitorReport)
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf("failed to decode report %s: %w", filepath.Base(path), err)
+	}
+	return report, nil
+}
+
+// matchesCompany reports whether a report targets the given company
+func matchesCompany(report *CompetitorReport, companyName string) bool {
+	return companyName == "" || strings.EqualFold(report.TargetCompany, companyName)
+}
+
+// sortReports orders reports oldest first, breaking ties by ID
+func sortReports(reports []*CompetitorReport) {
+	sort.SliceStable(reports, func(i, j int) bool {
+		return sortsBefore(reports[i], reports[j])
+	})
+}
+
+// sortsBefore orders reports by generation time, then ID
+func sortsBefore(a, b *CompetitorReport) bool {
+	if !a.GeneratedAt.Equal(b.GeneratedAt) {
+		return a.GeneratedAt.Before(b.GeneratedAt)
+	}
+	return a.ID < b.ID
+}
@@ -0,0 +1,146 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestReportStores tests save, get and list across store implementations
+func TestReportStores(t *testing.T) {
+	fileStore, err := NewFileReportStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileReportStore() error = %v", err)
+	}
+
+	stores := map[string]ReportStore{
+		"memory": NewMemoryReportStore(),
+		"file":   fileStore,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			base := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+			newer := &CompetitorReport{GeneratedAt: base.Add(time.Hour), TargetCompany: "TestCorp"}
+			older := &CompetitorReport{GeneratedAt: base, TargetCompany: "testcorp"}
+			other := &CompetitorReport{GeneratedAt: base, TargetCompany: "OtherCorp"}
+
+			for _, report := range []*CompetitorReport{newer, older, other} {
+				if err := store.Save(ctx, report); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+				if report.ID == "" {
+					t.Fatal("Expected Save to assign an ID")
+				}
+			}
+
+			got, err := store.Get(ctx, newer.ID)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if got.TargetCompany != "TestCorp" || !got.GeneratedAt.Equal(newer.GeneratedAt) {
+				t.Errorf("Get() returned %+v", got)
+			}
+
+			if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrReportNotFound) {
+				t.Errorf("Expected ErrReportNotFound, got %v", err)
+			}
+
+			listed, err := store.List(ctx, "TestCorp")
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if len(listed) != 2 {
+				t.Fatalf("Expected 2 reports for TestCorp, got %d", len(listed))
+			}
+			if listed[0].ID != older.ID || listed[1].ID != newer.ID {
+				t.Error("Expected reports listed oldest first")
+			}
+
+			all, err := store.List(ctx, "")
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if len(all) != 3 {
+				t.Errorf("Expected 3 reports in total, got %d", len(all))
+			}
+		})
+	}
+}
+
+// TestMemoryReportStore_MaxReports tests that the oldest reports are evicted
+// beyond the limit
+func TestMemoryReportStore_MaxReports(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	store := NewMemoryReportStore(WithMaxReports(2))
+
+	// Saved out of order: eviction follows generation time, not save order
+	reports := []*CompetitorReport{
+		{GeneratedAt: base.Add(time.Hour), TargetCompany: "TestCorp"},
+		{GeneratedAt: base, TargetCompany: "TestCorp"},
+		{GeneratedAt: base.Add(2 * time.Hour), TargetCompany: "OtherCorp"},
+	}
+	for _, report := range reports {
+		if err := store.Save(ctx, report); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	all, err := store.List(ctx, "")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(all) != 2 || all[0].ID != reports[0].ID || all[1].ID != reports[2].ID {
+		t.Errorf("Expected the two newest reports kept, got %d reports", len(all))
+	}
+	if _, err := store.Get(ctx, reports[1].ID); !errors.Is(err, ErrReportNotFound) {
+		t.Errorf("Expected the oldest report evicted, got %v", err)
+	}
+}
+
+// TestMemoryReportStore_Isolation tests that mutating saved or returned
+// reports leaves stored history untouched
+func TestMemoryReportStore_Isolation(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryReportStore()
+
+	report := &CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors: []CompetitorAnalysis{
+			{CompetitorName: "Acme", ScoreBreakdown: map[string]float64{ScoreComponentFunding: 5}, Leadership: []Person{{Name: "Jane Doe", Role: "CEO"}}},
+		},
+		Recommendations: []string{"Watch Acme"},
+	}
+	if err := store.Save(ctx, report); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	report.Competitors[0].CompetitorName = "Changed"
+
+	got, err := store.Get(ctx, report.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	got.Competitors[0].ScoreBreakdown[ScoreComponentFunding] = 0
+	got.Competitors[0].Leadership[0].Name = "[redacted]"
+	got.Recommendations[0] = "Changed"
+
+	listed, err := store.List(ctx, "TestCorp")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	listed[0].Competitors = nil
+
+	again, err := store.Get(ctx, report.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	analysis := again.Competitors[0]
+	if analysis.CompetitorName != "Acme" || analysis.ScoreBreakdown[ScoreComponentFunding] != 5 ||
+		analysis.Leadership[0].Name != "Jane Doe" || again.Recommendations[0] != "Watch Acme" {
+		t.Errorf("Expected the stored report unchanged, got %+v", again)
+	}
+}
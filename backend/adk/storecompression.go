@@ -0,0 +1,51 @@
+package adk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+)
+
+// compressedExt is appended to report files saved with compression
+const compressedExt = ".gz"
+
+// gzipMagic starts every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// FileStoreOption configures a FileReportStore
+type FileStoreOption func(*FileReportStore)
+
+// WithCompression gzips reports as <id>.json.gz when they are saved.
+// Existing uncompressed reports stay readable either way.
+func WithCompression() FileStoreOption {
+	return func(s *FileReportStore) {
+		s.compress = true
+	}
+}
+
+// compressReport gzips encoded report JSON
+func compressReport(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressReport returns report JSON, inflating it when the file starts
+// with the gzip magic bytes regardless of its name
+func decompressReport(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
@@ -0,0 +1,81 @@
+package adk
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestFileReportStore_Compression tests round-tripping reports through a
+// compressed store alongside legacy uncompressed files
+func TestFileReportStore_Compression(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	legacyStore, err := NewFileReportStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileReportStore() error = %v", err)
+	}
+	legacy := &CompetitorReport{GeneratedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), TargetCompany: "TestCorp"}
+	if err := legacyStore.Save(ctx, legacy); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	store, err := NewFileReportStore(dir, WithCompression())
+	if err != nil {
+		t.Fatalf("NewFileReportStore() error = %v", err)
+	}
+	report := &CompetitorReport{
+		GeneratedAt:   legacy.GeneratedAt.Add(time.Hour),
+		TargetCompany: "TestCorp",
+		Competitors:   []CompetitorAnalysis{{CompetitorName: "Rival", MarketShare: 25, ThreatLevel: ThreatHigh}},
+	}
+	if err := store.Save(ctx, report); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, report.ID+".json.gz"))
+	if err != nil {
+		t.Fatalf("Expected a .json.gz file, got %v", err)
+	}
+	if !bytes.HasPrefix(raw, gzipMagic) {
+		t.Error("Expected the stored file to be gzip compressed")
+	}
+
+	got, err := store.Get(ctx, report.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(got.Competitors) != 1 || got.Competitors[0].CompetitorName != "Rival" || got.Competitors[0].MarketShare != 25 {
+		t.Errorf("Expected the report to round-trip, got %+v", got.Competitors)
+	}
+
+	if _, err := store.Get(ctx, legacy.ID); err != nil {
+		t.Errorf("Expected legacy reports to stay readable, got %v", err)
+	}
+	if _, err := legacyStore.Get(ctx, report.ID); err != nil {
+		t.Errorf("Expected uncompressed stores to read compressed reports, got %v", err)
+	}
+
+	listed, err := store.List(ctx, "TestCorp")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(listed) != 2 || listed[0].ID != legacy.ID || listed[1].ID != report.ID {
+		t.Errorf("Expected both reports listed oldest first, got %d", len(listed))
+	}
+
+	// Re-saving a legacy report compresses it and drops the plain copy
+	if err := store.Save(ctx, legacy); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, legacy.ID+".json")); !os.IsNotExist(err) {
+		t.Errorf("Expected the uncompressed copy to be removed, got %v", err)
+	}
+	if listed, _ := store.List(ctx, ""); len(listed) != 2 {
+		t.Errorf("Expected 2 reports after re-saving, got %d", len(listed))
+	}
+}
@@ -0,0 +1,74 @@
+package adk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SWOT holds the four quadrants for one competitor. Strengths and Weaknesses
+// are the competitor's own; Opportunities and Threats are what they mean
+// for the target company.
+type SWOT struct {
+	Competitor    string   `json:"competitor"`
+	Strengths     []string `json:"strengths"`
+	Weaknesses    []string `json:"weaknesses"`
+	Opportunities []string `json:"opportunities"`
+	Threats       []string `json:"threats"`
+}
+
+// SWOTExport is the structured SWOT view of a report
+type SWOTExport struct {
+	TargetCompany string `json:"target_company"`
+	Competitors   []SWOT `json:"competitors"`
+}
+
+// SWOT derives a SWOT per competitor, framed relative to the target company
+func (r *CompetitorReport) SWOT() *SWOTExport {
+	export := &SWOTExport{
+		TargetCompany: r.TargetCompany,
+		Competitors:   make([]SWOT, 0, len(r.Competitors)),
+	}
+	for _, analysis := range r.Competitors {
+		export.Competitors = append(export.Competitors, swotFor(r.TargetCompany, analysis))
+	}
+	return export
+}
+
+// swotFor builds one competitor's quadrants; lists are never nil so every
+// quadrant renders as an array
+func swotFor(target string, analysis CompetitorAnalysis) SWOT {
+	swot := SWOT{
+		Competitor:    analysis.CompetitorName,
+		Strengths:     append([]string{}, analysis.KeyDifferentiators...),
+		Weaknesses:    append([]string{}, analysis.Weaknesses...),
+		Opportunities: append([]string{}, analysis.Opportunities...),
+		Threats:       append([]string{}, analysis.Risks...),
+	}
+
+	// Market position and trajectory round out what the competitor means
+	// for the target
+	if target == "" {
+		target = "the target"
+	}
+	switch analysis.ThreatLevel {
+	case ThreatHigh:
+		swot.Threats = append(swot.Threats, fmt.Sprintf("%s holds %s%% market share, a high threat to %s", analysis.CompetitorName, formatFloat(analysis.MarketShare), target))
+	case ThreatLow:
+		swot.Opportunities = append(swot.Opportunities, fmt.Sprintf("%s can outgrow %s's %s%% market share", target, analysis.CompetitorName, formatFloat(analysis.MarketShare)))
+	}
+	if analysis.EmergingThreat {
+		swot.Threats = append(swot.Threats, fmt.Sprintf("%s is growing fast enough to be an emerging threat", analysis.CompetitorName))
+	}
+	switch analysis.Momentum {
+	case MomentumRising:
+		swot.Threats = append(swot.Threats, fmt.Sprintf("%s is gaining momentum", analysis.CompetitorName))
+	case MomentumFalling:
+		swot.Opportunities = append(swot.Opportunities, fmt.Sprintf("%s can win customers from %s as it loses momentum", target, analysis.CompetitorName))
+	}
+	return swot
+}
+
+// swotJSON renders the report's SWOT export
+func swotJSON(report *CompetitorReport) ([]byte, error) {
+	return json.MarshalIndent(report.SWOT(), "", "  ")
+}
@@ -0,0 +1,115 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSWOT(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{{
+		Name:        "Rival",
+		MarketShare: 30,
+		Strengths:   []string{"Strong brand"},
+		Weaknesses:  []string{"Slow support"},
+	}})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	report := &CompetitorReport{TargetCompany: "TestCorp", Competitors: analyses}
+
+	export := report.SWOT()
+	if export.TargetCompany != "TestCorp" || len(export.Competitors) != 1 {
+		t.Fatalf("Expected one SWOT for TestCorp, got %+v", export)
+	}
+	swot := export.Competitors[0]
+
+	quadrants := map[string][]string{
+		"strengths":     swot.Strengths,
+		"weaknesses":    swot.Weaknesses,
+		"opportunities": swot.Opportunities,
+		"threats":       swot.Threats,
+	}
+	for name, items := range quadrants {
+		if len(items) == 0 {
+			t.Errorf("Expected %s to be populated", name)
+		}
+	}
+
+	if swot.Strengths[0] != "Strong brand" || swot.Weaknesses[0] != "Slow support" {
+		t.Errorf("Expected strengths and weaknesses from the competitor, got %v / %v", swot.Strengths, swot.Weaknesses)
+	}
+	if swot.Opportunities[0] != analyses[0].Opportunities[0] {
+		t.Errorf("Expected opportunities derived from weaknesses, got %v", swot.Opportunities)
+	}
+	if swot.Threats[0] != analyses[0].Risks[0] {
+		t.Errorf("Expected threats derived from strengths, got %v", swot.Threats)
+	}
+	if !strings.Contains(swot.Threats[len(swot.Threats)-1], "high threat to TestCorp") {
+		t.Errorf("Expected a high share to be framed as a threat to the target, got %v", swot.Threats)
+	}
+}
+
+func TestSWOT_Signals(t *testing.T) {
+	tests := []struct {
+		name     string
+		analysis CompetitorAnalysis
+		wantOpp  string
+		wantThr  string
+	}{
+		{
+			name:     "Low share and falling",
+			analysis: CompetitorAnalysis{CompetitorName: "Small", ThreatLevel: ThreatLow, MarketShare: 4, Momentum: MomentumFalling},
+			wantOpp:  "TestCorp can win customers from Small",
+		},
+		{
+			name:     "Emerging and rising",
+			analysis: CompetitorAnalysis{CompetitorName: "Upstart", ThreatLevel: ThreatMedium, EmergingThreat: true, Momentum: MomentumRising},
+			wantThr:  "Upstart is gaining momentum",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swot := swotFor("TestCorp", tt.analysis)
+			if tt.wantOpp != "" && !mentionsText(swot.Opportunities, tt.wantOpp) {
+				t.Errorf("Expected opportunity %q, got %v", tt.wantOpp, swot.Opportunities)
+			}
+			if tt.wantThr != "" && !mentionsText(swot.Threats, tt.wantThr) {
+				t.Errorf("Expected threat %q, got %v", tt.wantThr, swot.Threats)
+			}
+		})
+	}
+}
+
+func TestExport_SWOT(t *testing.T) {
+	report := &CompetitorReport{TargetCompany: "TestCorp", Competitors: []CompetitorAnalysis{{CompetitorName: "Bare", ThreatLevel: ThreatMedium}}}
+
+	data, err := NewCompetitorIntelligenceAgent().Export(report, FormatSWOT)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to parse SWOT export: %v", err)
+	}
+	competitor := decoded["competitors"].([]any)[0].(map[string]any)
+	for _, quadrant := range []string{"strengths", "weaknesses", "opportunities", "threats"} {
+		if _, ok := competitor[quadrant].([]any); !ok {
+			t.Errorf("Expected %s to render as an array, got %v", quadrant, competitor[quadrant])
+		}
+	}
+}
+
+// mentionsText reports whether any value contains text
+func mentionsText(values []string, text string) bool {
+	for _, value := range values {
+		if strings.Contains(value, text) {
+			return true
+		}
+	}
+	return false
+}
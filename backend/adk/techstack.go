@@ -0,0 +1,61 @@
+package adk
+
+import "strings"
+
+// techUsage counts how many competitors use each technology, keyed
+// case-insensitively
+func techUsage(data []CompetitorData) map[string]int {
+	usage := make(map[string]int)
+	for _, competitor := range data {
+		seen := make(map[string]bool)
+		for _, tech := range competitor.TechStack {
+			key := strings.ToLower(strings.TrimSpace(tech))
+			if key != "" && !seen[key] {
+				seen[key] = true
+				usage[key]++
+			}
+		}
+	}
+	return usage
+}
+
+// uniqueTech returns the technologies in stack no other competitor uses.
+// With a single competitor nothing is unique, as there is nothing to compare.
+func uniqueTech(stack []string, usage map[string]int, competitors int) []string {
+	if competitors < 2 {
+		return nil
+	}
+	var unique []string
+	for _, tech := range stack {
+		if usage[strings.ToLower(strings.TrimSpace(tech))] == 1 {
+			unique = appendUnique(unique, tech)
+		}
+	}
+	return unique
+}
+
+// techDifferentiator describes a technology only this competitor uses
+func techDifferentiator(tech string) string {
+	return "Unique technology: " + tech
+}
+
+// sharedTech returns the technologies every analysis uses; these are table
+// stakes rather than differentiators. It needs at least two competitors.
+func sharedTech(analyses []CompetitorAnalysis) []string {
+	if len(analyses) < 2 {
+		return nil
+	}
+	data := make([]CompetitorData, len(analyses))
+	for i, analysis := range analyses {
+		data[i].TechStack = analysis.TechStack
+	}
+	usage := techUsage(data)
+
+	var shared []string
+	for _, tech := range analyses[0].TechStack {
+		if usage[strings.ToLower(strings.TrimSpace(tech))] == len(analyses) {
+			shared = appendUnique(shared, tech)
+		}
+	}
+	return shared
+}
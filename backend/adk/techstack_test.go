@@ -0,0 +1,59 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze_TechStack(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "A", MarketShare: 20, TechStack: []string{"Go", "Kubernetes"}},
+		{Name: "B", MarketShare: 15, TechStack: []string{"Rust", "kubernetes", "React"}},
+		{Name: "C", MarketShare: 5, TechStack: []string{"Kubernetes", "React"}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	want := map[string][]string{
+		"A": {techDifferentiator("Go")},
+		"B": {techDifferentiator("Rust")},
+		"C": nil,
+	}
+	for _, analysis := range analyses {
+		for _, differentiator := range analysis.KeyDifferentiators {
+			if strings.Contains(strings.ToLower(differentiator), "kubernetes") || strings.Contains(differentiator, "React") {
+				t.Errorf("%s: expected shared technology not to be a differentiator, got %q", analysis.CompetitorName, differentiator)
+			}
+		}
+		if strings.Join(analysis.KeyDifferentiators, "|") != strings.Join(want[analysis.CompetitorName], "|") {
+			t.Errorf("%s: expected differentiators %v, got %v", analysis.CompetitorName, want[analysis.CompetitorName], analysis.KeyDifferentiators)
+		}
+	}
+
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+	if !strings.Contains(report.MarketInsights, "Technology overlap: every competitor uses Kubernetes") {
+		t.Errorf("Expected a technology overlap note, got %q", report.MarketInsights)
+	}
+}
+
+func TestAnalyze_TechStackSingleCompetitor(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+	analyses, err := agent.Analyze(context.Background(), []CompetitorData{
+		{Name: "Solo", TechStack: []string{"Go"}},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if len(analyses[0].KeyDifferentiators) != 0 {
+		t.Errorf("Expected no technology differentiators without peers, got %v", analyses[0].KeyDifferentiators)
+	}
+	if stats := computeInsightStats("TestCorp", analyses); stats.TechOverlap != "" {
+		t.Errorf("Expected no overlap note for one competitor, got %q", stats.TechOverlap)
+	}
+}
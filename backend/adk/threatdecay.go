@@ -0,0 +1,58 @@
+package adk
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ScoreComponentStaleness is the threat score discount for stale data
+const ScoreComponentStaleness = "staleness"
+
+// defaultThreatDecayHalfLife is how much staleness past the decay threshold
+// halves a threat score
+const defaultThreatDecayHalfLife = 180 * 24 * time.Hour
+
+// threatDecayHalfLife returns the configured threat decay half-life
+func (a *CompetitorIntelligenceAgent) threatDecayHalfLife() time.Duration {
+	if a.Config.ThreatDecayHalfLife > 0 {
+		return a.Config.ThreatDecayHalfLife
+	}
+	return defaultThreatDecayHalfLife
+}
+
+// threatDecayFactor is the share of a threat score kept for data retrieved
+// at retrievedAt: 1 up to the decay threshold, then halving every half-life.
+// Undated data and a disabled decay are not discounted.
+func (a *CompetitorIntelligenceAgent) threatDecayFactor(retrievedAt, now time.Time) float64 {
+	if a.Config.ThreatDecayAfter <= 0 || retrievedAt.IsZero() {
+		return 1
+	}
+	overdue := now.Sub(retrievedAt) - a.Config.ThreatDecayAfter
+	if overdue <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(overdue)/float64(a.threatDecayHalfLife()))
+}
+
+// decayStaleThreat discounts the breakdown's total for stale data, adding a
+// staleness component so the breakdown still sums to the score. It returns
+// the points removed.
+func (a *CompetitorIntelligenceAgent) decayStaleThreat(breakdown map[string]float64, retrievedAt, now time.Time) float64 {
+	factor := a.threatDecayFactor(retrievedAt, now)
+	if factor >= 1 {
+		return 0
+	}
+	discount := round2(sumBreakdown(breakdown) * (1 - factor))
+	if discount <= 0 {
+		return 0
+	}
+	breakdown[ScoreComponentStaleness] = -discount
+	return discount
+}
+
+// stalenessWarning notes a threat score discount for stale data
+func stalenessWarning(discount float64, retrievedAt, now time.Time) string {
+	days := int(now.Sub(retrievedAt).Hours() / 24)
+	return fmt.Sprintf("Threat score discounted by %s points because the data is %d days old", formatFloat(discount), days)
+}
@@ -0,0 +1,66 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAnalyze_ThreatDecay(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), analysisTimeKey{}, now)
+	const day = 24 * time.Hour
+
+	competitor := func(age time.Duration) CompetitorData {
+		return CompetitorData{Name: "Acme", MarketShare: 30, Strengths: []string{"Brand"}, RetrievedAt: now.Add(-age)}
+	}
+	fresh, err := NewCompetitorIntelligenceAgent().Analyze(ctx, []CompetitorData{competitor(0)})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	freshScore := fresh[0].ThreatScore
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		data      CompetitorData
+		wantScore float64
+	}{
+		{name: "Disabled", data: competitor(400 * day), wantScore: freshScore},
+		{name: "Within threshold", opts: []Option{WithThreatDecay(90*day, 180*day)}, data: competitor(60 * day), wantScore: freshScore},
+		{name: "Undated", opts: []Option{WithThreatDecay(90*day, 180*day)}, data: CompetitorData{Name: "Acme", MarketShare: 30, Strengths: []string{"Brand"}}, wantScore: freshScore},
+		{name: "One half-life past", opts: []Option{WithThreatDecay(90*day, 180*day)}, data: competitor(270 * day), wantScore: round2(freshScore / 2)},
+		{name: "Default half-life", opts: []Option{WithThreatDecay(90*day, 0)}, data: competitor(450 * day), wantScore: round2(freshScore / 4)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(append(tt.opts, WithScoreBreakdown(true))...)
+			analyses, err := agent.Analyze(ctx, []CompetitorData{tt.data})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			analysis := analyses[0]
+
+			if analysis.ThreatScore != tt.wantScore {
+				t.Errorf("Expected threat score %v, got %v", tt.wantScore, analysis.ThreatScore)
+			}
+			if got := sumBreakdown(analysis.ScoreBreakdown); got != analysis.ThreatScore {
+				t.Errorf("Expected the breakdown to sum to %v, got %v", analysis.ThreatScore, got)
+			}
+
+			discounted := tt.wantScore < freshScore
+			if discounted != (analysis.StalenessDiscount > 0) {
+				t.Errorf("Expected staleness discount %v, got %v", discounted, analysis.StalenessDiscount)
+			}
+			noted := false
+			for _, warning := range analysis.DataWarnings {
+				noted = noted || strings.Contains(warning, "Threat score discounted")
+			}
+			if noted != discounted {
+				t.Errorf("Expected adjustment noted = %v, got %v", discounted, analysis.DataWarnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Threat levels assigned during analysis, from least to most severe
+const (
+	ThreatLow    = "Low"
+	ThreatMedium = "Medium"
+	ThreatHigh   = "High"
+)
+
+// threatSeverity orders threat levels for threshold comparisons
+var threatSeverity = map[string]int{
+	ThreatLow:    1,
+	ThreatMedium: 2,
+	ThreatHigh:   3,
+}
+
+// Default market shares (percent) above which a competitor is a High or
+// Medium threat
+const (
+	defaultHighThreatShare   = 20.0
+	defaultMediumThreatShare = 10.0
+)
+
+// threatShares returns the configured High and Medium share thresholds
+func (a *CompetitorIntelligenceAgent) threatShares() (high, medium float64) {
+	high, medium = defaultHighThreatShare, defaultMediumThreatShare
+	if a.Config.HighThreatShare > 0 {
+		high = a.Config.HighThreatShare
+	}
+	if a.Config.MediumThreatShare > 0 {
+		medium = a.Config.MediumThreatShare
+	}
+	return high, medium
+}
+
+// threatLevelFor classifies a market share against the threat thresholds
+func (a *CompetitorIntelligenceAgent) threatLevelFor(share float64) string {
+	high, medium := a.threatShares()
+	switch {
+	case share > high:
+		return ThreatHigh
+	case share > medium:
+		return ThreatMedium
+	default:
+		return ThreatLow
+	}
+}
+
+// ValidateThreatShares checks High and Medium share thresholds, where zero
+// keeps the default for that level
+func ValidateThreatShares(high, medium float64) error {
+	if high < 0 || high > 100 || medium < 0 || medium > 100 {
+		return fmt.Errorf("%w: threat share thresholds must be 0-100", ErrInvalidInput)
+	}
+	if high == 0 {
+		high = defaultHighThreatShare
+	}
+	if medium == 0 {
+		medium = defaultMediumThreatShare
+	}
+	if medium >= high {
+		return fmt.Errorf("%w: medium threat share %s must be below high threat share %s", ErrInvalidInput, formatFloat(medium), formatFloat(high))
+	}
+	return nil
+}
+
+// ParseThreatLevel validates a threat level name case-insensitively and
+// returns its canonical form
+func ParseThreatLevel(name string) (string, error) {
+	for level := range threatSeverity {
+		if strings.EqualFold(level, name) {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown threat level %q (want Low, Medium or High)", ErrInvalidInput, name)
+}
+
+// filterByThreat keeps analyses at or above minLevel and reports how many
+// were dropped; an empty minLevel keeps everything
+func filterByThreat(analyses []CompetitorAnalysis, minLevel string) ([]CompetitorAnalysis, int) {
+	if minLevel == "" {
+		return analyses, 0
+	}
+	threshold := threatSeverity[minLevel]
+
+	kept := make([]CompetitorAnalysis, 0, len(analyses))
+	for _, analysis := range analyses {
+		if analysis.Highlighted || threatSeverity[analysis.ThreatLevel] >= threshold {
+			kept = append(kept, analysis)
+		}
+	}
+	return kept, len(analyses) - len(kept)
+}
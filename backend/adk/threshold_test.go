@@ -0,0 +1,137 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMinThreatLevel(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithMinThreatLevel("High"))
+
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if len(report.Competitors) != 1 {
+		t.Fatalf("Expected 1 High-threat competitor, got %d", len(report.Competitors))
+	}
+	for _, analysis := range report.Competitors {
+		if analysis.ThreatLevel != ThreatHigh {
+			t.Errorf("Expected only High threats, got %s for %s", analysis.ThreatLevel, analysis.CompetitorName)
+		}
+		if analysis.Rank != 1 {
+			t.Errorf("Expected ranks over the reported set, got %d", analysis.Rank)
+		}
+	}
+
+	if report.OmittedCompetitors != 2 {
+		t.Errorf("Expected 2 omitted competitors, got %d", report.OmittedCompetitors)
+	}
+	if !strings.Contains(report.MarketInsights, "1 major players") {
+		t.Errorf("Expected insights to count only reported competitors, got %q", report.MarketInsights)
+	}
+	if !strings.Contains(report.MarketInsights, "2 lower-threat competitors were omitted") {
+		t.Errorf("Expected insights to note omitted competitors, got %q", report.MarketInsights)
+	}
+}
+
+func TestParseThreatLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"high", ThreatHigh, false},
+		{"Medium", ThreatMedium, false},
+		{"LOW", ThreatLow, false},
+		{"severe", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseThreatLevel(tt.input)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("ParseThreatLevel(%q) expected ErrInvalidInput, got %v", tt.input, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseThreatLevel(%q) = %q, %v, want %q", tt.input, got, err, tt.want)
+		}
+	}
+}
+
+// TestAnalyze_ThreatShares tests configurable threat level share thresholds
+func TestAnalyze_ThreatShares(t *testing.T) {
+	data := []CompetitorData{{Name: "A", MarketShare: 25}, {Name: "B", MarketShare: 16}, {Name: "C", MarketShare: 12}}
+
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{name: "Defaults", want: []string{ThreatHigh, ThreatMedium, ThreatMedium}},
+		{name: "Lower high", opts: []Option{WithThreatShares(15, 0)}, want: []string{ThreatHigh, ThreatHigh, ThreatMedium}},
+		{name: "Raise medium", opts: []Option{WithThreatShares(0, 14)}, want: []string{ThreatHigh, ThreatMedium, ThreatLow}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyses, err := NewCompetitorIntelligenceAgent(tt.opts...).Analyze(context.Background(), data)
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			for i, analysis := range analyses {
+				if analysis.ThreatLevel != tt.want[i] {
+					t.Errorf("%s threat = %s, want %s", analysis.CompetitorName, analysis.ThreatLevel, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestValidateThreatShares tests threshold bounds and ordering
+func TestValidateThreatShares(t *testing.T) {
+	tests := []struct {
+		name    string
+		high    float64
+		medium  float64
+		wantErr bool
+	}{
+		{name: "Defaults"},
+		{name: "Custom", high: 15, medium: 5},
+		{name: "High below default medium", high: 8, wantErr: true},
+		{name: "Equal", high: 12, medium: 12, wantErr: true},
+		{name: "Out of range", high: 101, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateThreatShares(tt.high, tt.medium)
+			if tt.wantErr != errors.Is(err, ErrInvalidInput) {
+				t.Errorf("ValidateThreatShares(%v, %v) = %v, wantErr %v", tt.high, tt.medium, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestWithOverrides tests that overrides apply to a detached copy only
+func TestWithOverrides(t *testing.T) {
+	store := NewMemoryReportStore()
+	agent := NewCompetitorIntelligenceAgent(WithReportStore(store), WithAnalysisCache(4), WithMaxFieldLength(FormatJSON, 10))
+
+	whatIf := agent.WithOverrides(WithThreatShares(15, 0), WithMaxFieldLength(FormatJSON, 50))
+
+	if whatIf.Store() != nil || whatIf.analysisCache != nil {
+		t.Error("Expected what-if copies not to persist or cache")
+	}
+	if whatIf.Config.HighThreatShare != 15 || agent.Config.HighThreatShare != 0 {
+		t.Errorf("Expected override on the copy only, got %v and %v", whatIf.Config.HighThreatShare, agent.Config.HighThreatShare)
+	}
+	if agent.FormatOptions(FormatJSON).MaxFieldLength != 10 {
+		t.Errorf("Expected original format options intact, got %+v", agent.FormatOptions(FormatJSON))
+	}
+}
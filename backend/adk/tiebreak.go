@@ -0,0 +1,91 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TieBreak names a rule deciding between competitors with equal threat scores
+type TieBreak string
+
+// Supported tie-break rules
+const (
+	// TieBreakShare puts the larger market share first
+	TieBreakShare TieBreak = "share"
+	// TieBreakName puts competitors in case-insensitive alphabetical order
+	TieBreakName TieBreak = "name"
+)
+
+// tieBreaks is the set of valid tie-break rules
+var tieBreaks = []TieBreak{TieBreakShare, TieBreakName}
+
+// DefaultTieBreaks is applied when no chain is configured: market share,
+// then name
+var DefaultTieBreaks = []TieBreak{TieBreakShare, TieBreakName}
+
+// ParseTieBreaks validates a comma-separated tie-break chain such as
+// "share,name"; empty selects DefaultTieBreaks
+func ParseTieBreaks(spec string) ([]TieBreak, error) {
+	if strings.TrimSpace(spec) == "" {
+		return DefaultTieBreaks, nil
+	}
+
+	var chain []TieBreak
+	for _, part := range strings.Split(spec, ",") {
+		name := TieBreak(strings.ToLower(strings.TrimSpace(part)))
+		if !validTieBreak(name) {
+			return nil, fmt.Errorf("%w: unknown tie-break %q (want one of %s)", ErrInvalidInput, part, tieBreakNames())
+		}
+		chain = append(chain, name)
+	}
+	return chain, nil
+}
+
+// validTieBreak reports whether name is a supported rule
+func validTieBreak(name TieBreak) bool {
+	for _, rule := range tieBreaks {
+		if rule == name {
+			return true
+		}
+	}
+	return false
+}
+
+// tieBreakNames lists valid rules for error messages
+func tieBreakNames() string {
+	names := make([]string, len(tieBreaks))
+	for i, rule := range tieBreaks {
+		names[i] = string(rule)
+	}
+	return strings.Join(names, ", ")
+}
+
+// tieBreakChain returns the configured chain, defaulting to DefaultTieBreaks
+func (a *CompetitorIntelligenceAgent) tieBreakChain() []TieBreak {
+	if len(a.Config.TieBreaks) > 0 {
+		return a.Config.TieBreaks
+	}
+	return DefaultTieBreaks
+}
+
+// threatLess orders x before y by descending threat score, settling equal
+// scores with chain. Competitors tied on every rule keep their input order
+// under a stable sort.
+func threatLess(x, y CompetitorAnalysis, chain []TieBreak) bool {
+	if x.ThreatScore != y.ThreatScore {
+		return x.ThreatScore > y.ThreatScore
+	}
+	for _, rule := range chain {
+		switch rule {
+		case TieBreakShare:
+			if x.MarketShare != y.MarketShare {
+				return x.MarketShare > y.MarketShare
+			}
+		case TieBreakName:
+			if xn, yn := strings.ToLower(x.CompetitorName), strings.ToLower(y.CompetitorName); xn != yn {
+				return xn < yn
+			}
+		}
+	}
+	return false
+}
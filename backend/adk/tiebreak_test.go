@@ -0,0 +1,92 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateReport_TieBreaks(t *testing.T) {
+	// All three tie on threat score; Beta and gamma also tie on share
+	tied := []CompetitorAnalysis{
+		{CompetitorName: "gamma", ThreatScore: 50, MarketShare: 10},
+		{CompetitorName: "Beta", ThreatScore: 50, MarketShare: 10},
+		{CompetitorName: "Alpha", ThreatScore: 50, MarketShare: 5},
+		{CompetitorName: "Leader", ThreatScore: 90, MarketShare: 1},
+	}
+
+	tests := []struct {
+		name  string
+		chain []TieBreak
+		want  string
+	}{
+		{"default share then name", nil, "Leader,Beta,gamma,Alpha"},
+		{"name only", []TieBreak{TieBreakName}, "Leader,Alpha,Beta,gamma"},
+		{"share only keeps input order", []TieBreak{TieBreakShare}, "Leader,gamma,Beta,Alpha"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(WithTieBreaks(tt.chain...))
+
+			// Repeated runs over shuffled input must rank identically when
+			// the chain fully separates ties
+			for run := 0; run < 5; run++ {
+				input := append([]CompetitorAnalysis(nil), tied...)
+				if tt.chain == nil || tt.chain[0] == TieBreakName {
+					input[run%len(input)], input[0] = input[0], input[run%len(input)]
+				}
+				report, err := agent.GenerateReport(context.Background(), "TestCorp", input)
+				if err != nil {
+					t.Fatalf("GenerateReport() error = %v", err)
+				}
+
+				names := make([]string, len(report.Competitors))
+				for i, analysis := range report.Competitors {
+					names[i] = analysis.CompetitorName
+					if analysis.Rank != i+1 {
+						t.Errorf("Expected %s at rank %d, got %d", analysis.CompetitorName, i+1, analysis.Rank)
+					}
+				}
+				if got := strings.Join(names, ","); got != tt.want {
+					t.Errorf("Run %d: expected order %s, got %s", run, tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseTieBreaks(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    string
+		wantErr bool
+	}{
+		{"", "share,name", false},
+		{"name", "name", false},
+		{" Name , share ", "name,share", false},
+		{"share,revenue", "", true},
+	}
+
+	for _, tt := range tests {
+		chain, err := ParseTieBreaks(tt.spec)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("ParseTieBreaks(%q): expected ErrInvalidInput, got %v", tt.spec, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseTieBreaks(%q) error = %v", tt.spec, err)
+		}
+
+		names := make([]string, len(chain))
+		for i, rule := range chain {
+			names[i] = string(rule)
+		}
+		if got := strings.Join(names, ","); got != tt.want {
+			t.Errorf("ParseTieBreaks(%q): expected %s, got %s", tt.spec, tt.want, got)
+		}
+	}
+}
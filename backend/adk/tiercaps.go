@@ -0,0 +1,90 @@
+package adk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseTierCaps parses "level=n,..." entries such as "High=3,Medium=2",
+// capping how many competitors each threat level lists in reports. Levels
+// without an entry are not capped.
+func ParseTierCaps(spec string) (map[string]int, error) {
+	caps := make(map[string]int)
+	for _, entry := range strings.Split(spec, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: tier cap %q must be level=n", ErrInvalidInput, entry)
+		}
+		level, err := ParseThreatLevel(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("%w: tier cap for %s must be a positive integer", ErrInvalidInput, level)
+		}
+		caps[level] = n
+	}
+	return caps, nil
+}
+
+// capByTier keeps at most caps[level] analyses per threat level, choosing
+// the highest threat scores (settled by chain) and returning how many were
+// dropped per level. Highlighted competitors are always kept and take their
+// tier's slots first. Kept analyses stay in input order.
+func capByTier(analyses []CompetitorAnalysis, caps map[string]int, chain []TieBreak) ([]CompetitorAnalysis, map[string]int) {
+	if len(caps) == 0 {
+		return analyses, nil
+	}
+
+	order := make([]int, len(analyses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		x, y := analyses[order[i]], analyses[order[j]]
+		if x.Highlighted != y.Highlighted {
+			return x.Highlighted
+		}
+		return threatLess(x, y, chain)
+	})
+
+	keep := make([]bool, len(analyses))
+	listed := make(map[string]int)
+	var omitted map[string]int
+	for _, i := range order {
+		level := analyses[i].ThreatLevel
+		limit, capped := caps[level]
+		if !capped || listed[level] < limit || analyses[i].Highlighted {
+			keep[i] = true
+			listed[level]++
+			continue
+		}
+		if omitted == nil {
+			omitted = make(map[string]int)
+		}
+		omitted[level]++
+	}
+
+	kept := make([]CompetitorAnalysis, 0, len(analyses))
+	for i, analysis := range analyses {
+		if keep[i] {
+			kept = append(kept, analysis)
+		}
+	}
+	return kept, omitted
+}
+
+// cappedCount totals the competitors dropped by tier caps
+func cappedCount(omitted map[string]int) int {
+	total := 0
+	for _, n := range omitted {
+		total += n
+	}
+	return total
+}
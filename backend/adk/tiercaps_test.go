@@ -0,0 +1,132 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseTierCaps(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    map[string]int
+		wantErr bool
+	}{
+		{name: "Two tiers", spec: "High=3, medium=2", want: map[string]int{ThreatHigh: 3, ThreatMedium: 2}},
+		{name: "Empty", spec: "", want: map[string]int{}},
+		{name: "Unknown level", spec: "Critical=1", wantErr: true},
+		{name: "Missing count", spec: "High", wantErr: true},
+		{name: "Zero cap", spec: "Low=0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTierCaps(tt.spec)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInput) {
+					t.Errorf("Expected ErrInvalidInput, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTierCaps() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			for level, n := range tt.want {
+				if got[level] != n {
+					t.Errorf("Expected %s capped at %d, got %d", level, n, got[level])
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateReport_TierCaps(t *testing.T) {
+	analyses := []CompetitorAnalysis{
+		{CompetitorName: "H1", ThreatLevel: ThreatHigh, ThreatScore: 70},
+		{CompetitorName: "M1", ThreatLevel: ThreatMedium, ThreatScore: 40},
+		{CompetitorName: "H2", ThreatLevel: ThreatHigh, ThreatScore: 95},
+		{CompetitorName: "H3", ThreatLevel: ThreatHigh, ThreatScore: 60},
+		{CompetitorName: "M2", ThreatLevel: ThreatMedium, ThreatScore: 45},
+		{CompetitorName: "H4", ThreatLevel: ThreatHigh, ThreatScore: 80},
+		{CompetitorName: "M3", ThreatLevel: ThreatMedium, ThreatScore: 30},
+		{CompetitorName: "L1", ThreatLevel: ThreatLow, ThreatScore: 10},
+		{CompetitorName: "L2", ThreatLevel: ThreatLow, ThreatScore: 5},
+	}
+
+	agent := NewCompetitorIntelligenceAgent(WithTierCaps(map[string]int{ThreatHigh: 3, ThreatMedium: 2}))
+	report, err := agent.GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	var names []string
+	for _, analysis := range report.Competitors {
+		names = append(names, analysis.CompetitorName)
+	}
+	want := "H2,H4,H1,M2,M1,L1,L2"
+	if got := strings.Join(names, ","); got != want {
+		t.Errorf("Expected competitors %s, got %s", want, got)
+	}
+	for i, analysis := range report.Competitors {
+		if analysis.Rank != i+1 {
+			t.Errorf("Expected %s ranked %d, got %d", analysis.CompetitorName, i+1, analysis.Rank)
+		}
+	}
+
+	if report.TierOmissions[ThreatHigh] != 1 || report.TierOmissions[ThreatMedium] != 1 || len(report.TierOmissions) != 2 {
+		t.Errorf("Expected one High and one Medium omission, got %v", report.TierOmissions)
+	}
+	if !strings.Contains(report.MarketInsights, "2 competitors beyond the per-tier limits were omitted") {
+		t.Errorf("Expected insights to note the omissions, got %q", report.MarketInsights)
+	}
+}
+
+func TestCapByTier(t *testing.T) {
+	analyses := []CompetitorAnalysis{
+		{CompetitorName: "Big", ThreatLevel: ThreatHigh, ThreatScore: 90},
+		{CompetitorName: "Pinned", ThreatLevel: ThreatHigh, ThreatScore: 50, Highlighted: true},
+		{CompetitorName: "Tied B", ThreatLevel: ThreatHigh, ThreatScore: 70, MarketShare: 10},
+		{CompetitorName: "Tied A", ThreatLevel: ThreatHigh, ThreatScore: 70, MarketShare: 30},
+	}
+
+	tests := []struct {
+		name     string
+		caps     map[string]int
+		want     string
+		omitted  int
+		nilOmits bool
+	}{
+		{name: "Highlighted takes a slot first", caps: map[string]int{ThreatHigh: 2}, want: "Big,Pinned", omitted: 2},
+		{name: "Ties settled by share", caps: map[string]int{ThreatHigh: 3}, want: "Big,Pinned,Tied A", omitted: 1},
+		{name: "Uncapped tier", caps: map[string]int{ThreatLow: 1}, want: "Big,Pinned,Tied B,Tied A", nilOmits: true},
+		{name: "No caps", want: "Big,Pinned,Tied B,Tied A", nilOmits: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept, omitted := capByTier(analyses, tt.caps, DefaultTieBreaks)
+
+			var names []string
+			for _, analysis := range kept {
+				names = append(names, analysis.CompetitorName)
+			}
+			if got := strings.Join(names, ","); got != tt.want {
+				t.Errorf("Expected %s kept, got %s", tt.want, got)
+			}
+			if tt.nilOmits {
+				if omitted != nil {
+					t.Errorf("Expected no omissions, got %v", omitted)
+				}
+				return
+			}
+			if omitted[ThreatHigh] != tt.omitted {
+				t.Errorf("Expected %d High omissions, got %v", tt.omitted, omitted)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package adk
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseTimezone loads an IANA zone name such as "Europe/Berlin"
+func ParseTimezone(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidInput, name)
+	}
+	return loc, nil
+}
+
+// location returns the zone report timestamps are rendered in (UTC by default)
+func (a *CompetitorIntelligenceAgent) location() *time.Location {
+	if a.timezone == nil {
+		return time.UTC
+	}
+	return a.timezone
+}
+
+// reportTime returns the current instant in the configured report zone
+func (a *CompetitorIntelligenceAgent) reportTime() time.Time {
+	return time.Now().In(a.location())
+}
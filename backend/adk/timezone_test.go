@@ -0,0 +1,77 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReportTimezone(t *testing.T) {
+	tests := []struct {
+		name   string
+		zone   string
+		offset string
+	}{
+		{"default UTC", "", "Z"},
+		{"Kolkata", "Asia/Kolkata", "+05:30"},
+		{"New York", "America/New_York", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Option
+			offset := tt.offset
+			if tt.zone != "" {
+				loc, err := ParseTimezone(tt.zone)
+				if err != nil {
+					t.Fatalf("ParseTimezone() error = %v", err)
+				}
+				opts = append(opts, WithTimezone(loc))
+				if offset == "" {
+					offset = time.Now().In(loc).Format("-07:00")
+				}
+			}
+
+			before := time.Now()
+			report, err := NewCompetitorIntelligenceAgent(opts...).Run(context.Background(), "TestCorp", "SaaS")
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			out, err := json.Marshal(report)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var raw struct {
+				GeneratedAt string `json:"generated_at"`
+			}
+			if err := json.Unmarshal(out, &raw); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !strings.HasSuffix(raw.GeneratedAt, offset) {
+				t.Errorf("Expected generated_at with offset %s, got %s", offset, raw.GeneratedAt)
+			}
+
+			// The instant itself must be unaffected by the zone
+			if report.GeneratedAt.Before(before.Add(-time.Second)) || report.GeneratedAt.After(time.Now()) {
+				t.Errorf("Expected GeneratedAt near now, got %v", report.GeneratedAt)
+			}
+		})
+	}
+}
+
+func TestParseTimezone(t *testing.T) {
+	if _, err := ParseTimezone("Mars/Olympus_Mons"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for unknown zone, got %v", err)
+	}
+}
+
+func TestWithTimezone_Nil(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithTimezone(nil))
+	if agent.location() != time.UTC || agent.Config.Timezone != "UTC" {
+		t.Errorf("Expected a nil zone to mean UTC, got %v (%q)", agent.location(), agent.Config.Timezone)
+	}
+}
@@ -0,0 +1,49 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tone controls how forcefully generated recommendations are phrased
+type Tone string
+
+// Supported tones
+const (
+	// ToneNeutral states recommendations plainly (the default)
+	ToneNeutral Tone = "neutral"
+	// ToneDirective phrases recommendations as firm instructions
+	ToneDirective Tone = "directive"
+	// ToneAdvisory phrases recommendations as hedged suggestions
+	ToneAdvisory Tone = "advisory"
+)
+
+// tonePrefixes lead recommendations in each non-neutral tone
+var tonePrefixes = map[Tone]string{
+	ToneDirective: "You must ",
+	ToneAdvisory:  "You may want to ",
+}
+
+// ParseTone validates a tone name; empty selects ToneNeutral
+func ParseTone(name string) (Tone, error) {
+	switch tone := Tone(strings.ToLower(name)); tone {
+	case "":
+		return ToneNeutral, nil
+	case ToneNeutral, ToneDirective, ToneAdvisory:
+		return tone, nil
+	default:
+		return "", fmt.Errorf("%w: unknown tone %q (want directive, advisory or neutral)", ErrInvalidInput, name)
+	}
+}
+
+// applyTone rephrases an imperative recommendation in the given tone
+func applyTone(tone Tone, text string) string {
+	prefix, ok := tonePrefixes[tone]
+	if !ok || text == "" {
+		return text
+	}
+	first, size := utf8.DecodeRuneInString(text)
+	return prefix + string(unicode.ToLower(first)) + text[size:]
+}
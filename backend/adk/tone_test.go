@@ -0,0 +1,56 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRecommendationTone(t *testing.T) {
+	tests := []struct {
+		tone Tone
+		want string
+	}{
+		{"", "Monitor competitor pricing and adjust strategy quarterly"},
+		{ToneNeutral, "Monitor competitor pricing and adjust strategy quarterly"},
+		{ToneDirective, "You must monitor competitor pricing and adjust strategy quarterly"},
+		{ToneAdvisory, "You may want to monitor competitor pricing and adjust strategy quarterly"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.tone), func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(WithTone(tt.tone), WithDefaultRecommendations("Review with legal"))
+
+			report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			found := false
+			for _, rec := range report.Recommendations {
+				if rec == tt.want {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Expected recommendation %q, got %v", tt.want, report.Recommendations)
+			}
+
+			if last := report.Recommendations[len(report.Recommendations)-1]; last != "Review with legal" {
+				t.Errorf("Expected default recommendations verbatim, got %q", last)
+			}
+		})
+	}
+}
+
+func TestParseTone(t *testing.T) {
+	if tone, err := ParseTone("Directive"); err != nil || tone != ToneDirective {
+		t.Errorf("Expected directive tone, got %q, %v", tone, err)
+	}
+	if tone, err := ParseTone(""); err != nil || tone != ToneNeutral {
+		t.Errorf("Expected neutral tone for empty input, got %q, %v", tone, err)
+	}
+	if _, err := ParseTone("sarcastic"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput for unknown tone, got %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+package adk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// MaxSuppliedCompetitors caps how many competitors a caller may supply
+const MaxSuppliedCompetitors = 100
+
+// FieldError is a validation problem located by a JSON-path-style locator
+// such as competitors[2].market_share
+type FieldError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string {
+	return e.Path + " " + e.Message
+}
+
+// ValidationErrors aggregates every problem found in a payload
+type ValidationErrors []FieldError
+
+// Error implements the error interface, listing every problem
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return "invalid competitor data: " + strings.Join(messages, "; ")
+}
+
+// Unwrap marks validation errors as invalid input
+func (e ValidationErrors) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// add records a problem at path
+func (e *ValidationErrors) add(path, format string, args ...interface{}) {
+	*e = append(*e, FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// ValidateCompetitors checks caller-supplied competitor data, reporting all
+// problems at once rather than stopping at the first
+func ValidateCompetitors(data []CompetitorData) error {
+	var errs ValidationErrors
+	var totalShare float64
+
+	if len(data) > MaxSuppliedCompetitors {
+		errs.add("competitors", "must contain at most %d competitors", MaxSuppliedCompetitors)
+		return errs
+	}
+
+	for i, competitor := range data {
+		path := fmt.Sprintf("competitors[%d]", i)
+
+		if strings.TrimSpace(competitor.Name) == "" {
+			errs.add(path+".name", "is required")
+		}
+		if competitor.Website != "" && !isHTTPURL(competitor.Website) {
+			errs.add(path+".website", "must be an absolute http(s) URL")
+		} else if competitor.Website != "" && isInternalHost(competitor.Website) {
+			errs.add(path+".website", "must not point to a local or private address")
+		}
+		if competitor.MarketShare < 0 || competitor.MarketShare > 100 {
+			errs.add(path+".market_share", "must be 0-100")
+		}
+		totalShare += competitor.MarketShare
+		if competitor.Funding < 0 {
+			errs.add(path+".funding", "must not be negative")
+		}
+		if competitor.Customers < 0 {
+			errs.add(path+".customers", "must not be negative")
+		}
+		if competitor.GrowthRate < -100 {
+			errs.add(path+".growth_rate", "must not be below -100")
+		}
+		if competitor.Price < 0 {
+			errs.add(path+".price", "must not be negative")
+		}
+		if competitor.PriceMax != 0 && competitor.PriceMax < competitor.Price {
+			errs.add(path+".price_max", "must not be below price")
+		}
+		if competitor.Confidence < 0 || competitor.Confidence > 1 {
+			errs.add(path+".confidence", "must be 0-1")
+		}
+
+		validateStrings(&errs, path+".products", competitor.Products)
+		validateStrings(&errs, path+".strengths", competitor.Strengths)
+		validateStrings(&errs, path+".weaknesses", competitor.Weaknesses)
+		validateStrings(&errs, path+".tech_stack", competitor.TechStack)
+		validateStrings(&errs, path+".notes", competitor.Notes)
+
+		for j, rel := range competitor.Relationships {
+			relPath := fmt.Sprintf("%s.relationships[%d]", path, j)
+			if !relationTypes[strings.ToLower(rel.Type)] {
+				errs.add(relPath+".type", "must be one of partner, parent, subsidiary, acquired")
+			}
+			if strings.TrimSpace(rel.Target) == "" {
+				errs.add(relPath+".target", "is required")
+			}
+		}
+
+		for j, person := range competitor.KeyPeople {
+			personPath := fmt.Sprintf("%s.key_people[%d]", path, j)
+			if strings.TrimSpace(person.Name) == "" {
+				errs.add(personPath+".name", "is required")
+			}
+			if person.YearsExperience < 0 {
+				errs.add(personPath+".years_experience", "must not be negative")
+			}
+		}
+	}
+
+	if totalShare > 100 {
+		errs.add("competitors", "market shares must not sum to more than 100 (got %s)", formatFloat(round2(totalShare)))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateStrings rejects blank entries in a list field
+func validateStrings(errs *ValidationErrors, path string, values []string) {
+	for i, value := range values {
+		if strings.TrimSpace(value) == "" {
+			errs.add(fmt.Sprintf("%s[%d]", path, i), "must not be empty")
+		}
+	}
+}
+
+// isHTTPURL reports whether raw is an absolute http or https URL
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// isInternalHost reports whether the URL's host is localhost or a loopback,
+// private, link-local or unspecified IP address. Names resolving to such
+// addresses are refused when dialing instead.
+func isInternalHost(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && isInternalIP(ip)
+}
+
+// isInternalIP reports whether ip is loopback, private, link-local,
+// multicast or unspecified, which outbound probes must not reach
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// invalidWebsiteWarning ends the data warning for a malformed website
+const invalidWebsiteWarning = "is not an absolute http(s) URL"
+
+// websiteWarnings flags a malformed website from a data source, which is
+// kept as-is rather than rejected like supplied data
+func websiteWarnings(competitor CompetitorData) []string {
+	if competitor.Website == "" || isHTTPURL(competitor.Website) {
+		return nil
+	}
+	return []string{fmt.Sprintf("Website %q %s", competitor.Website, invalidWebsiteWarning)}
+}
+
+// suppliedSource serves competitor data provided with a request
+type suppliedSource struct {
+	data []CompetitorData
+}
+
+// Name identifies the source
+func (suppliedSource) Name() string {
+	return "request"
+}
+
+// FetchCompetitors returns a copy of the supplied data
+func (s suppliedSource) FetchCompetitors(ctx context.Context, companyName string, industry string) ([]CompetitorData, error) {
+	return append([]CompetitorData(nil), s.data...), nil
+}
+
+// suppliedKey is the context key carrying caller-supplied competitor data
+type suppliedKey struct{}
+
+// WithCompetitorData returns a context that makes Run analyze data instead
+// of researching competitors. Run validates data before starting.
+func WithCompetitorData(ctx context.Context, data []CompetitorData) context.Context {
+	return context.WithValue(ctx, suppliedKey{}, data)
+}
+
+// suppliedCompetitors returns caller-supplied data carried by ctx, if any
+func suppliedCompetitors(ctx context.Context) ([]CompetitorData, bool) {
+	data, ok := ctx.Value(suppliedKey{}).([]CompetitorData)
+	return data, ok
+}
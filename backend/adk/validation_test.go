@@ -0,0 +1,172 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateCompetitors(t *testing.T) {
+	data := []CompetitorData{
+		{Name: "Valid", MarketShare: 20, Website: "https://valid.example"},
+		{Name: "", MarketShare: 10},
+		{Name: "Bad Numbers", MarketShare: 120, Funding: -5, Confidence: 1.5, Website: "valid.example"},
+		{Name: "Bad Lists", Strengths: []string{"Brand", " "}, KeyPeople: []Person{{Role: "CEO", YearsExperience: -1}}},
+	}
+
+	err := ValidateCompetitors(data)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+
+	var invalid ValidationErrors
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Expected ValidationErrors, got %T", err)
+	}
+
+	want := map[string]string{
+		"competitors[1].name":                           "is required",
+		"competitors[2].market_share":                   "must be 0-100",
+		"competitors[2].funding":                        "must not be negative",
+		"competitors[2].confidence":                     "must be 0-1",
+		"competitors[2].website":                        "must be an absolute http(s) URL",
+		"competitors[3].strengths[1]":                   "must not be empty",
+		"competitors[3].key_people[0].name":             "is required",
+		"competitors[3].key_people[0].years_experience": "must not be negative",
+		"competitors":                                   "market shares must not sum to more than 100 (got 150)",
+	}
+	if len(invalid) != len(want) {
+		t.Errorf("Expected %d problems, got %d: %v", len(want), len(invalid), invalid)
+	}
+	for _, fieldErr := range invalid {
+		if message, ok := want[fieldErr.Path]; !ok || message != fieldErr.Message {
+			t.Errorf("Unexpected problem %q: %q", fieldErr.Path, fieldErr.Message)
+		}
+	}
+}
+
+func TestValidateCompetitors_Valid(t *testing.T) {
+	data := []CompetitorData{{Name: "Acme", MarketShare: 40, Price: 10, PriceMax: 20}}
+	if err := ValidateCompetitors(data); err != nil {
+		t.Errorf("Expected valid data to pass, got %v", err)
+	}
+}
+
+func TestValidateCompetitors_InternalWebsites(t *testing.T) {
+	tests := []struct {
+		website string
+		want    bool
+	}{
+		{website: "https://acme.example"},
+		{website: "http://93.184.216.34/"},
+		{website: "http://localhost:6379", want: true},
+		{website: "http://api.localhost/", want: true},
+		{website: "http://127.0.0.1/", want: true},
+		{website: "http://169.254.169.254/latest/meta-data/", want: true},
+		{website: "http://10.0.0.5/", want: true},
+		{website: "http://192.168.1.1/", want: true},
+		{website: "http://[::1]:8080/", want: true},
+		{website: "http://0.0.0.0/", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.website, func(t *testing.T) {
+			err := ValidateCompetitors([]CompetitorData{{Name: "Acme", Website: tt.website}})
+			if got := err != nil; got != tt.want {
+				t.Errorf("Expected rejected = %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidateCompetitors_TooMany(t *testing.T) {
+	data := make([]CompetitorData, MaxSuppliedCompetitors+1)
+	for i := range data {
+		data[i].Name = fmt.Sprintf("Competitor %d", i)
+	}
+
+	var invalid ValidationErrors
+	if err := ValidateCompetitors(data); !errors.As(err, &invalid) || len(invalid) != 1 || invalid[0].Path != "competitors" {
+		t.Errorf("Expected a single competitors error, got %v", err)
+	}
+	if err := ValidateCompetitors(data[:MaxSuppliedCompetitors]); err != nil {
+		t.Errorf("Expected %d competitors to pass, got %v", MaxSuppliedCompetitors, err)
+	}
+}
+
+func TestIsHTTPURL(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{name: "https", raw: "https://acme.example", want: true},
+		{name: "http with path", raw: "http://acme.example/pricing", want: true},
+		{name: "scheme-less", raw: "acme.example", want: false},
+		{name: "other scheme", raw: "ftp://acme.example", want: false},
+		{name: "no host", raw: "https://", want: false},
+		{name: "garbage", raw: "not a url", want: false},
+		{name: "unparseable", raw: "http://[::1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHTTPURL(tt.raw); got != tt.want {
+				t.Errorf("Expected %v for %q, got %v", tt.want, tt.raw, got)
+			}
+		})
+	}
+}
+
+func TestAnalyze_MalformedWebsite(t *testing.T) {
+	tests := []struct {
+		name        string
+		website     string
+		wantWarning bool
+	}{
+		{name: "Valid", website: "https://acme.example"},
+		{name: "Missing", website: ""},
+		{name: "Scheme-less", website: "acme.example", wantWarning: true},
+		{name: "Garbage", website: "not a url", wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []CompetitorData{{Name: "Acme", MarketShare: 12, Website: tt.website}}
+			analyses, err := NewCompetitorIntelligenceAgent().Analyze(context.Background(), data)
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+
+			warned := false
+			for _, warning := range analyses[0].DataWarnings {
+				if strings.Contains(warning, "not an absolute http(s) URL") {
+					warned = true
+				}
+			}
+			if warned != tt.wantWarning {
+				t.Errorf("Expected website warning %v, got %v", tt.wantWarning, analyses[0].DataWarnings)
+			}
+		})
+	}
+}
+
+func TestRun_SuppliedCompetitors(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent()
+
+	supplied := []CompetitorData{{Name: "Acme", MarketShare: 40, Pricing: "Premium"}}
+	report, err := agent.Run(WithCompetitorData(context.Background(), supplied), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(report.Competitors) != 1 || report.Competitors[0].CompetitorName != "Acme" {
+		t.Errorf("Expected the supplied competitor only, got %+v", report.Competitors)
+	}
+
+	bad := []CompetitorData{{Name: "Acme", MarketShare: 400}}
+	if _, err := agent.Run(WithCompetitorData(context.Background(), bad), "TestCorp", "SaaS"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected supplied data to be validated, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package adk
+
+import "strings"
+
+// Value positions placing a competitor's price against what it offers
+const (
+	ValueOverpriced = "overpriced"
+	ValueBargain    = "bargain"
+	ValueFair       = "fair"
+)
+
+// ValueThresholds tunes price-to-value positioning. Zero values use the
+// defaults: at most 1 strength is few, at least 3 is many, Premium and
+// Enterprise are premium tiers and Budget is the budget tier.
+type ValueThresholds struct {
+	FewStrengths  int      `json:"few_strengths,omitempty"`
+	ManyStrengths int      `json:"many_strengths,omitempty"`
+	PremiumTiers  []string `json:"premium_tiers,omitempty"`
+	BudgetTiers   []string `json:"budget_tiers,omitempty"`
+}
+
+// Default value thresholds
+const (
+	defaultFewStrengths  = 1
+	defaultManyStrengths = 3
+)
+
+var (
+	defaultPremiumTiers = []string{"Premium", "Enterprise"}
+	defaultBudgetTiers  = []string{"Budget"}
+)
+
+// withDefaults fills unset thresholds
+func (t ValueThresholds) withDefaults() ValueThresholds {
+	if t.FewStrengths <= 0 {
+		t.FewStrengths = defaultFewStrengths
+	}
+	if t.ManyStrengths <= 0 {
+		t.ManyStrengths = defaultManyStrengths
+	}
+	if len(t.PremiumTiers) == 0 {
+		t.PremiumTiers = defaultPremiumTiers
+	}
+	if len(t.BudgetTiers) == 0 {
+		t.BudgetTiers = defaultBudgetTiers
+	}
+	return t
+}
+
+// valuePosition combines the pricing tier with the strength count: premium
+// with few strengths is overpriced, budget with many is a bargain, and any
+// other priced competitor is fair. Unknown pricing has no value position.
+func (a *CompetitorIntelligenceAgent) valuePosition(competitor CompetitorData) string {
+	if competitor.Pricing == "" {
+		return ""
+	}
+	t := a.Config.ValueThresholds.withDefaults()
+	strengths := len(competitor.Strengths)
+
+	switch {
+	case containsFold(t.PremiumTiers, competitor.Pricing) && strengths <= t.FewStrengths:
+		return ValueOverpriced
+	case containsFold(t.BudgetTiers, competitor.Pricing) && strengths >= t.ManyStrengths:
+		return ValueBargain
+	default:
+		return ValueFair
+	}
+}
+
+// containsFold reports whether values holds value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
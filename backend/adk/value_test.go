@@ -0,0 +1,61 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze_ValuePosition(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		data   CompetitorData
+		wantVP string
+	}{
+		{name: "Premium with few strengths", data: CompetitorData{Name: "A", Pricing: "Premium", Strengths: []string{"Brand"}}, wantVP: ValueOverpriced},
+		{name: "Enterprise with none", data: CompetitorData{Name: "B", Pricing: "Enterprise"}, wantVP: ValueOverpriced},
+		{name: "Premium with many strengths", data: CompetitorData{Name: "C", Pricing: "Premium", Strengths: []string{"Brand", "Support", "Scale"}}, wantVP: ValueFair},
+		{name: "Budget with many strengths", data: CompetitorData{Name: "D", Pricing: "Budget", Strengths: []string{"Speed", "Support", "Integrations"}}, wantVP: ValueBargain},
+		{name: "Numeric budget price", data: CompetitorData{Name: "E", Price: 9, Strengths: []string{"Speed", "Support", "Integrations"}}, wantVP: ValueBargain},
+		{name: "Mid-range is fair", data: CompetitorData{Name: "F", Pricing: "Mid-range"}, wantVP: ValueFair},
+		{name: "Unknown pricing", data: CompetitorData{Name: "G", Strengths: []string{"Brand"}}, wantVP: ""},
+		{
+			name:   "Configured thresholds",
+			opts:   []Option{WithValueThresholds(ValueThresholds{FewStrengths: 3, BudgetTiers: []string{"Mid-range"}, ManyStrengths: 2})},
+			data:   CompetitorData{Name: "H", Pricing: "Premium", Strengths: []string{"Brand", "Support", "Scale"}},
+			wantVP: ValueOverpriced,
+		},
+		{
+			name:   "Configured budget tier",
+			opts:   []Option{WithValueThresholds(ValueThresholds{BudgetTiers: []string{"Mid-range"}, ManyStrengths: 2})},
+			data:   CompetitorData{Name: "I", Pricing: "Mid-range", Strengths: []string{"Speed", "Support"}},
+			wantVP: ValueBargain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewCompetitorIntelligenceAgent(tt.opts...)
+			analyses, err := agent.Analyze(context.Background(), []CompetitorData{tt.data})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			if analyses[0].ValuePosition != tt.wantVP {
+				t.Errorf("Expected value position %q, got %q", tt.wantVP, analyses[0].ValuePosition)
+			}
+		})
+	}
+}
+
+func TestMarkdown_ValuePosition(t *testing.T) {
+	report := &CompetitorReport{Competitors: []CompetitorAnalysis{
+		{CompetitorName: "Pricey", Positioning: "Premium market leader", ValuePosition: ValueOverpriced},
+		{CompetitorName: "Even", Positioning: "Value-focused challenger", ValuePosition: ValueFair},
+	}}
+
+	md := report.ToMarkdown(FormatOptions{})
+	if strings.Count(md, "- **Value:**") != 1 || !strings.Contains(md, "- **Value:** overpriced") {
+		t.Errorf("Expected only the mispriced competitor flagged, got:\n%s", md)
+	}
+}
@@ -0,0 +1,76 @@
+package adk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Verbosity controls how opportunities and risks are phrased
+type Verbosity int
+
+const (
+	// VerbosityTerse emits short template phrases (the default)
+	VerbosityTerse Verbosity = iota
+	// VerbosityDetailed expands phrases into sentences with suggested tactics
+	VerbosityDetailed
+)
+
+// tactic pairs a keyword found in a strength/weakness with a suggested response
+type tactic struct {
+	keywords []string
+	response string
+}
+
+// opportunityTactics suggest how to exploit a competitor weakness
+var opportunityTactics = []tactic{
+	{[]string{"support"}, "advertising your SLA guarantees and response times"},
+	{[]string{"price", "expensive", "cost"}, "leading with transparent, lower-cost pricing"},
+	{[]string{"feature"}, "highlighting the breadth of your feature set"},
+	{[]string{"integration"}, "showcasing your integration ecosystem"},
+	{[]string{"setup", "complex", "learning"}, "emphasizing fast onboarding and ease of use"},
+	{[]string{"presence", "newer", "brand"}, "stressing your track record and customer references"},
+}
+
+// riskTactics suggest how to defend against a competitor strength
+var riskTactics = []tactic{
+	{[]string{"brand"}, "investing in thought leadership and customer advocacy"},
+	{[]string{"customer base", "market"}, "targeting segments they underserve"},
+	{[]string{"innovation", "feature"}, "shortening your release cycle on high-demand capabilities"},
+	{[]string{"afford", "price"}, "competing on total value rather than list price"},
+	{[]string{"ux", "usab"}, "prioritizing design polish in your core workflows"},
+	{[]string{"growth"}, "locking in key accounts with longer-term agreements"},
+	{[]string{"security", "compliance", "enterprise"}, "pursuing certifications that neutralize the gap"},
+}
+
+// opportunityText phrases an opportunity derived from a competitor weakness
+func (a *CompetitorIntelligenceAgent) opportunityText(weakness string) string {
+	if a.Config.Verbosity < VerbosityDetailed {
+		return fmt.Sprintf("Capitalize on %s weakness", weakness)
+	}
+	return fmt.Sprintf("Capitalize on their %s by %s",
+		strings.ToLower(weakness),
+		matchTactic(weakness, opportunityTactics, "positioning your offering directly against this gap"))
+}
+
+// riskText phrases a risk derived from a competitor strength
+func (a *CompetitorIntelligenceAgent) riskText(strength string) string {
+	if a.Config.Verbosity < VerbosityDetailed {
+		return fmt.Sprintf("Competitor's %s advantage", strength)
+	}
+	return fmt.Sprintf("Competitor's %s advantage could erode your position; counter it by %s",
+		strings.ToLower(strength),
+		matchTactic(strength, riskTactics, "reinforcing your own differentiation and monitoring them closely"))
+}
+
+// matchTactic returns the first tactic whose keyword appears in text
+func matchTactic(text string, tactics []tactic, fallback string) string {
+	lower := strings.ToLower(text)
+	for _, t := range tactics {
+		for _, keyword := range t.keywords {
+			if strings.Contains(lower, keyword) {
+				return t.response
+			}
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,54 @@
+package adk
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestAnalyze_Verbosity tests terse and detailed opportunity/risk phrasing
+func TestAnalyze_Verbosity(t *testing.T) {
+	data := []CompetitorData{
+		{
+			Name:        "Competitor A",
+			MarketShare: 25,
+			Strengths:   []string{"Strong brand"},
+			Weaknesses:  []string{"Slow support", "Odd quirk"},
+		},
+	}
+
+	terse, err := NewCompetitorIntelligenceAgent().Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	detailed, err := NewCompetitorIntelligenceAgent(WithVerbosity(VerbosityDetailed)).Analyze(context.Background(), data)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if terse[0].Opportunities[0] != "Capitalize on Slow support weakness" {
+		t.Errorf("Expected terse default phrasing, got %q", terse[0].Opportunities[0])
+	}
+
+	want := "Capitalize on their slow support by advertising your SLA guarantees and response times"
+	if detailed[0].Opportunities[0] != want {
+		t.Errorf("Opportunity = %q, want %q", detailed[0].Opportunities[0], want)
+	}
+
+	for i := range data[0].Weaknesses {
+		if len(detailed[0].Opportunities[i]) <= len(terse[0].Opportunities[i]) {
+			t.Errorf("Expected detailed opportunity %d to be longer than terse form", i)
+		}
+		if !strings.Contains(detailed[0].Opportunities[i], " by ") {
+			t.Errorf("Expected detailed opportunity %d to carry a tactic, got %q", i, detailed[0].Opportunities[i])
+		}
+	}
+
+	if !strings.Contains(detailed[0].Risks[0], "thought leadership") {
+		t.Errorf("Expected brand tactic in risk, got %q", detailed[0].Risks[0])
+	}
+	if len(detailed[0].Risks[0]) <= len(terse[0].Risks[0]) {
+		t.Error("Expected detailed risk to be longer than terse form")
+	}
+}
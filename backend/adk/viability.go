@@ -0,0 +1,73 @@
+package adk
+
+import "fmt"
+
+// Default thresholds above which a market is too concentrated to enter:
+// an HHI of 2500 marks a highly concentrated market, and a top threat
+// holding half the market is a dominant incumbent
+const (
+	defaultViabilityHHI      = 2500.0
+	defaultViabilityTopShare = 50.0
+)
+
+// MarketViability assesses whether the market is worth entering at all
+type MarketViability struct {
+	// ReconsiderEntry is set when both concentration and the top threat's
+	// share exceed their thresholds
+	ReconsiderEntry bool    `json:"reconsider_entry"`
+	HHI             float64 `json:"hhi"`
+	TopThreat       string  `json:"top_threat"`
+	TopThreatShare  float64 `json:"top_threat_share"`
+	// Advisory explains a ReconsiderEntry verdict
+	Advisory string `json:"advisory,omitempty"`
+}
+
+// viabilityThresholds returns the configured HHI and top threat share
+// thresholds, falling back to the defaults
+func (a *CompetitorIntelligenceAgent) viabilityThresholds() (hhi, topShare float64) {
+	hhi, topShare = defaultViabilityHHI, defaultViabilityTopShare
+	if a.Config.ViabilityHHI > 0 {
+		hhi = a.Config.ViabilityHHI
+	}
+	if a.Config.ViabilityTopShare > 0 {
+		topShare = a.Config.ViabilityTopShare
+	}
+	return hhi, topShare
+}
+
+// ValidateViabilityThresholds checks the HHI (0-10000) and top threat share
+// (0-100) thresholds, where zero keeps the default
+func ValidateViabilityThresholds(hhi, topShare float64) error {
+	if hhi < 0 || hhi > 10000 {
+		return fmt.Errorf("%w: viability HHI threshold must be 0-10000", ErrInvalidInput)
+	}
+	if topShare < 0 || topShare > 100 {
+		return fmt.Errorf("%w: viability top threat share must be 0-100", ErrInvalidInput)
+	}
+	return nil
+}
+
+// marketViability judges the landscape from the insight stats, advising
+// against entry when a dominant top threat sits in a concentrated market.
+// It returns nil when there are no competitors to judge.
+func (a *CompetitorIntelligenceAgent) marketViability(stats InsightStats, analyses []CompetitorAnalysis) *MarketViability {
+	if len(analyses) == 0 {
+		return nil
+	}
+
+	viability := &MarketViability{HHI: stats.HHI, TopThreat: stats.TopThreat}
+	for _, analysis := range analyses {
+		if analysis.CompetitorName == stats.TopThreat {
+			viability.TopThreatShare = analysis.MarketShare
+			break
+		}
+	}
+
+	hhi, topShare := a.viabilityThresholds()
+	if viability.HHI > hhi && viability.TopThreatShare > topShare {
+		viability.ReconsiderEntry = true
+		viability.Advisory = fmt.Sprintf("Reconsider market entry: %s holds %s%% of a highly concentrated market (HHI %s).",
+			viability.TopThreat, formatFloat(viability.TopThreatShare), formatFloat(viability.HHI))
+	}
+	return viability
+}
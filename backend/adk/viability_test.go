@@ -0,0 +1,124 @@
+package adk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateReport_MarketViability(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []Option
+		analyses       []CompetitorAnalysis
+		wantReconsider bool
+	}{
+		{
+			name: "Dominant incumbent",
+			analyses: []CompetitorAnalysis{
+				{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 90, MarketShare: 65},
+				{CompetitorName: "Beta", ThreatLevel: ThreatMedium, ThreatScore: 30, MarketShare: 15},
+			},
+			wantReconsider: true,
+		},
+		{
+			name: "Fragmented market",
+			analyses: []CompetitorAnalysis{
+				{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 50, MarketShare: 25.5},
+				{CompetitorName: "Beta", ThreatLevel: ThreatMedium, ThreatScore: 40, MarketShare: 18.2},
+				{CompetitorName: "Gamma", ThreatLevel: ThreatMedium, ThreatScore: 30, MarketShare: 12.8},
+			},
+		},
+		{
+			name: "Concentrated but top threat below share threshold",
+			opts: []Option{WithViabilityThresholds(0, 70)},
+			analyses: []CompetitorAnalysis{
+				{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 90, MarketShare: 65},
+			},
+		},
+		{
+			name: "Lowered thresholds",
+			opts: []Option{WithViabilityThresholds(500, 20)},
+			analyses: []CompetitorAnalysis{
+				{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 50, MarketShare: 25.5},
+				{CompetitorName: "Beta", ThreatLevel: ThreatMedium, ThreatScore: 40, MarketShare: 18.2},
+			},
+			wantReconsider: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := NewCompetitorIntelligenceAgent(tt.opts...).GenerateReport(context.Background(), "TestCorp", tt.analyses)
+			if err != nil {
+				t.Fatalf("GenerateReport() error = %v", err)
+			}
+
+			viability := report.MarketViability
+			if viability == nil {
+				t.Fatal("Expected a market viability assessment")
+			}
+			if viability.ReconsiderEntry != tt.wantReconsider {
+				t.Errorf("Expected reconsider entry %v, got %+v", tt.wantReconsider, viability)
+			}
+			if viability.TopThreat != tt.analyses[0].CompetitorName || viability.TopThreatShare != tt.analyses[0].MarketShare {
+				t.Errorf("Expected %s as top threat, got %+v", tt.analyses[0].CompetitorName, viability)
+			}
+
+			advised := strings.HasPrefix(report.ExecutiveSummary, "Reconsider market entry:")
+			if advised != tt.wantReconsider {
+				t.Errorf("Expected advisory leading the summary %v, got %q", tt.wantReconsider, report.ExecutiveSummary)
+			}
+		})
+	}
+}
+
+func TestGenerateReport_MarketViabilityAdvisory(t *testing.T) {
+	analyses := []CompetitorAnalysis{
+		{CompetitorName: "Acme", ThreatLevel: ThreatHigh, ThreatScore: 90, MarketShare: 70},
+	}
+
+	report, err := NewCompetitorIntelligenceAgent().GenerateReport(context.Background(), "TestCorp", analyses)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+
+	want := "Reconsider market entry: Acme holds 70% of a highly concentrated market (HHI 4900)."
+	if report.MarketViability.Advisory != want {
+		t.Errorf("Expected advisory %q, got %q", want, report.MarketViability.Advisory)
+	}
+}
+
+func TestGenerateReport_NoCompetitorsViability(t *testing.T) {
+	report, err := NewCompetitorIntelligenceAgent().GenerateReport(context.Background(), "TestCorp", nil)
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+	if report.MarketViability != nil {
+		t.Errorf("Expected no assessment without competitors, got %+v", report.MarketViability)
+	}
+}
+
+func TestValidateViabilityThresholds(t *testing.T) {
+	tests := []struct {
+		name     string
+		hhi      float64
+		topShare float64
+		wantErr  bool
+	}{
+		{name: "Defaults", hhi: 0, topShare: 0},
+		{name: "Custom", hhi: 1800, topShare: 40},
+		{name: "HHI too high", hhi: 12000, topShare: 40, wantErr: true},
+		{name: "Negative share", hhi: 1800, topShare: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateViabilityThresholds(tt.hhi, tt.topShare)
+			if tt.wantErr != errors.Is(err, ErrInvalidInput) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
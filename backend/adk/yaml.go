@@ -0,0 +1,67 @@
+package adk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ToYAML converts the report to block-style YAML with the same field names
+// and order as its JSON encoding
+func (r *CompetitorReport) ToYAML() ([]byte, error) {
+	encoded, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return jsonToYAML(encoded)
+}
+
+// ReportFromYAML decodes a report written by ToYAML
+func ReportFromYAML(data []byte) (*CompetitorReport, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	var report CompetitorReport
+	if err := json.Unmarshal(encoded, &report); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return &report, nil
+}
+
+// jsonToYAML re-encodes a JSON document as YAML. JSON is valid flow-style
+// YAML, so parsing it keeps key order; clearing the styles switches the
+// output to block style, quoting only strings that would otherwise read as
+// another type.
+func jsonToYAML(encoded []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(encoded, &doc); err != nil {
+		return nil, err
+	}
+	blockStyle(&doc)
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// blockStyle clears the flow and quoting styles of node and its children
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
@@ -0,0 +1,65 @@
+package adk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestToYAML_RoundTrip(t *testing.T) {
+	agent := NewCompetitorIntelligenceAgent(WithRecommendationRationale(), WithScoreBreakdown(true))
+	report, err := agent.Run(context.Background(), "TestCorp", "SaaS")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	// Strings that read as other YAML types must survive as strings
+	report.Competitors[0].Notes = []string{"true", "123", "null", "a: b", "- dash"}
+
+	body, err := report.ToYAML()
+	if err != nil {
+		t.Fatalf("ToYAML() error = %v", err)
+	}
+	out := string(body)
+	for _, key := range []string{"target_company: TestCorp\n", "competitors:\n", "recommendation_details:\n", "threat_level: "} {
+		if !strings.Contains(out, key) {
+			t.Errorf("Expected YAML to contain %q, got:\n%s", key, out)
+		}
+	}
+	if strings.HasPrefix(out, "{") {
+		t.Errorf("Expected block-style YAML, got:\n%s", out)
+	}
+
+	decoded, err := ReportFromYAML(body)
+	if err != nil {
+		t.Fatalf("ReportFromYAML() error = %v", err)
+	}
+	want, _ := json.Marshal(report)
+	got, _ := json.Marshal(decoded)
+	if string(got) != string(want) {
+		t.Errorf("Expected the YAML to round-trip\nwant: %s\ngot:  %s", want, got)
+	}
+}
+
+func TestReportFromYAML_Invalid(t *testing.T) {
+	if _, err := ReportFromYAML([]byte("competitors: [")); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestExport_YAML(t *testing.T) {
+	report := &CompetitorReport{TargetCompany: "TestCorp", Competitors: []CompetitorAnalysis{{CompetitorName: "Acme", MarketShare: 30}}}
+
+	body, err := NewCompetitorIntelligenceAgent().Export(report, FormatYAML)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	decoded, err := ReportFromYAML(body)
+	if err != nil {
+		t.Fatalf("ReportFromYAML() error = %v", err)
+	}
+	if decoded.TargetCompany != "TestCorp" || len(decoded.Competitors) != 1 || decoded.Competitors[0].MarketShare != 30 {
+		t.Errorf("Unexpected YAML export:\n%s", body)
+	}
+}
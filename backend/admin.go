@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// redacted replaces secret values in configuration output
+const redacted = "[REDACTED]"
+
+// requireAPIKey admits requests carrying the configured API key in
+// X-API-Key or an Authorization bearer token. Admin routes stay closed
+// when no key is configured.
+func (s *server) requireAPIKey(c *fiber.Ctx) error {
+	if s.cfg.APIKey == "" {
+		return sendError(c, fiber.StatusForbidden, adk.MsgAdminDisabled)
+	}
+
+	if !s.validAPIKey(c) {
+		return sendError(c, fiber.StatusUnauthorized, adk.MsgInvalidAPIKey)
+	}
+	return c.Next()
+}
+
+// validAPIKey reports whether the request carries the configured API key
+// in X-API-Key or an Authorization bearer token
+func (s *server) validAPIKey(c *fiber.Ctx) bool {
+	if s.cfg.APIKey == "" {
+		return false
+	}
+	key := c.Get("X-API-Key")
+	if key == "" {
+		key = strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	}
+	return subtle.ConstantTimeCompare([]byte(key), []byte(s.cfg.APIKey)) == 1
+}
+
+// authorizedKey is the context key marking requests with a valid API key
+type authorizedKey struct{}
+
+// identifyCaller marks the request context when it carries a valid API key,
+// so handlers can disclose privileged details without rejecting others
+func (s *server) identifyCaller(c *fiber.Ctx) error {
+	if s.validAPIKey(c) {
+		c.SetUserContext(context.WithValue(c.UserContext(), authorizedKey{}, true))
+	}
+	return c.Next()
+}
+
+// isAuthorized reports whether identifyCaller accepted the request's API key
+func isAuthorized(ctx context.Context) bool {
+	authorized, _ := ctx.Value(authorizedKey{}).(bool)
+	return authorized
+}
+
+// effectiveConfig reports how the running agent and server are configured
+func (s *server) effectiveConfig(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"agent":       s.agent.Config,
+		"data_source": s.agent.DataSource().Name(),
+		"server":      s.cfg.view(),
+	})
+}
+
+// CacheInvalidateRequest narrows cache invalidation to one company and/or
+// industry; an empty request clears the whole analysis cache
+type CacheInvalidateRequest struct {
+	Company  string `json:"company,omitempty"`
+	Industry string `json:"industry,omitempty"`
+}
+
+// invalidateCache evicts cached analyses after a data refresh, reporting
+// how many entries were evicted. The body is optional.
+func (s *server) invalidateCache(c *fiber.Ctx) error {
+	req := new(CacheInvalidateRequest)
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(req); err != nil {
+			return sendError(c, fiber.StatusBadRequest, adk.MsgInvalidRequestBody)
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"evicted": s.agent.InvalidateAnalysisCache(req.Company, req.Industry),
+	})
+}
+
+// view returns the configuration keyed by setting, with secrets redacted
+func (cfg serverConfig) view() map[string]any {
+	view := make(map[string]any, len(configSettings))
+	for _, setting := range configSettings {
+		view[setting.key] = setting.value(&cfg)
+	}
+	return view
+}
+
+// redactSecret masks a configured secret, leaving an unset one empty
+func redactSecret(value string) string {
+	if value == "" {
+		return ""
+	}
+	return redacted
+}
+
+// redactURL masks any password embedded in a URL's user info and every
+// query value, which often carry tokens. URLs that do not parse are masked
+// entirely.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redacted
+	}
+	if u.RawQuery != "" {
+		query := u.Query()
+		pairs := make([]string, 0, len(query))
+		for key := range query {
+			pairs = append(pairs, url.QueryEscape(key)+"="+redacted)
+		}
+		sort.Strings(pairs)
+		u.RawQuery = strings.Join(pairs, "&")
+	}
+	return u.Redacted()
+}
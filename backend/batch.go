@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+const (
+	// mimeNDJSON is the newline-delimited JSON content type for streamed batches
+	mimeNDJSON = "application/x-ndjson"
+	// maxBatchItems bounds how many analyses one batch request may contain
+	maxBatchItems = 50
+	// batchWorkers is how many batch items are analyzed concurrently unless
+	// the request asks for a different pool size
+	batchWorkers = 4
+)
+
+// BatchItem is one analysis in a batch request. CorrelationID is echoed in
+// the matching result; one is generated when the client omits it.
+type BatchItem struct {
+	CorrelationID string `json:"correlation_id,omitempty"`
+	AnalyzeRequest
+}
+
+// BatchRequest is the body accepted by the batch analyze endpoint
+type BatchRequest struct {
+	Items []BatchItem `json:"items"`
+
+	// Concurrency overrides the worker pool size for this batch, up to the
+	// server's BatchMaxConcurrency (0 keeps the default)
+	Concurrency int `json:"concurrency,omitempty"`
+}
+
+// BatchResult is the outcome of one batch item, in input order
+type BatchResult struct {
+	CorrelationID string                `json:"correlation_id"`
+	Status        string                `json:"status"`
+	Report        *adk.CompetitorReport `json:"report,omitempty"`
+	Error         string                `json:"error,omitempty"`
+
+	// Errors lists every invalid field of an item that failed validation
+	Errors adk.ValidationErrors `json:"errors,omitempty"`
+}
+
+// Batch result statuses
+const (
+	batchStatusOK    = "ok"
+	batchStatusError = "error"
+)
+
+// analyzeBatch runs several analyses concurrently and returns one result
+// per item, each tagged with its correlation id. Clients accepting
+// application/x-ndjson get results streamed as they complete.
+func (s *server) analyzeBatch(c *fiber.Ctx) error {
+	req := new(BatchRequest)
+	if err := c.BodyParser(req); err != nil {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgInvalidRequestBody)
+	}
+	if len(req.Items) > maxBatchItems {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgBatchSize, maxBatchItems)
+	}
+
+	if errs := s.validateBatch(req); len(errs) > 0 {
+		return sendValidationErrors(c, errs)
+	}
+	workers := min(s.batchWorkers(req.Concurrency), len(req.Items))
+
+	assignCorrelationIDs(req.Items)
+
+	// Each worker holds one analysis slot for as long as the batch runs,
+	// which for a stream is beyond this handler's return. The pool shrinks
+	// to the free slots so batches never exceed MAX_CONCURRENT_ANALYSES.
+	workers = s.acquireAnalyses(workers)
+	if workers == 0 {
+		return tooManyAnalyses(c)
+	}
+	if c.Accepts(fiber.MIMEApplicationJSON, mimeNDJSON) == mimeNDJSON {
+		return s.streamBatch(c, req.Items, workers)
+	}
+	defer s.releaseAnalyses(workers)
+
+	results := s.runBatch(c.UserContext(), req.Items, workers)
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == batchStatusError {
+			failed++
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"results": results,
+		"failed":  failed,
+	})
+}
+
+// maxBatchConcurrency is the largest worker pool a batch may request
+func (s *server) maxBatchConcurrency() int {
+	if s.cfg.BatchMaxConcurrency > 0 {
+		return s.cfg.BatchMaxConcurrency
+	}
+	return batchWorkers
+}
+
+// validateBatch checks batch-level options; items are validated one by one
+// as they run
+func (s *server) validateBatch(req *BatchRequest) adk.ValidationErrors {
+	var errs adk.ValidationErrors
+	if len(req.Items) == 0 {
+		errs = append(errs, adk.FieldError{Path: "items", Message: "must contain at least 1 item"})
+	}
+	if limit := s.maxBatchConcurrency(); req.Concurrency < 0 || req.Concurrency > limit {
+		errs = append(errs, adk.FieldError{
+			Path:    "concurrency",
+			Message: fmt.Sprintf("must be between 0 and %d (0 uses the default)", limit),
+		})
+	}
+	return errs
+}
+
+// batchWorkers returns the pool size for a batch requesting concurrency,
+// where 0 means the default bounded by the server maximum
+func (s *server) batchWorkers(concurrency int) int {
+	if concurrency > 0 {
+		return concurrency
+	}
+	return min(batchWorkers, s.maxBatchConcurrency())
+}
+
+// assignCorrelationIDs generates ids for items the client left unlabeled
+func assignCorrelationIDs(items []BatchItem) {
+	for i := range items {
+		if items[i].CorrelationID == "" {
+			items[i].CorrelationID = uuid.NewString()
+		}
+	}
+}
+
+// runBatch analyzes items and returns results aligned with their inputs
+func (s *server) runBatch(ctx context.Context, items []BatchItem, workers int) []BatchResult {
+	results := make([]BatchResult, len(items))
+	s.eachBatchResult(ctx, items, workers, func(i int, result BatchResult) {
+		results[i] = result
+	})
+	return results
+}
+
+// eachBatchResult analyzes items with a pool of workers, calling emit
+// with each item's index and result as it completes. emit may be called
+// concurrently, but never twice for the same index. Callers hold one
+// analysis slot per worker, so a batch counts against
+// MAX_CONCURRENT_ANALYSES exactly as many single analyses would.
+func (s *server) eachBatchResult(ctx context.Context, items []BatchItem, workers int, emit func(int, BatchResult)) {
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(items)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				// Items left once the batch is cancelled fail without running
+				if err := ctx.Err(); err != nil {
+					emit(i, BatchResult{
+						CorrelationID: items[i].CorrelationID,
+						Status:        batchStatusError,
+						Error:         fmt.Sprintf("item %s: %v", items[i].CorrelationID, err),
+					})
+					continue
+				}
+				emit(i, s.runBatchItem(ctx, &items[i]))
+			}
+		}()
+	}
+
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// runBatchItem analyzes a single item, logging failures with its correlation id
+func (s *server) runBatchItem(ctx context.Context, item *BatchItem) BatchResult {
+	result := BatchResult{CorrelationID: item.CorrelationID}
+
+	// Items are validated like single analyses but fail on their own
+	if errs := item.validate(); len(errs) > 0 {
+		result.Status = batchStatusError
+		result.Error = fmt.Sprintf("item %s: %s", item.CorrelationID, adk.Message(adk.DefaultLanguage, adk.MsgInvalidInput, fieldErrorDetail(errs)))
+		result.Errors = errs
+		return result
+	}
+
+	report, err := s.runAnalysis(ctx, &item.AnalyzeRequest)
+	if report != nil {
+		// Batch results are serialized directly, so redact for the default
+		// profile, withholding reports that cannot be redacted
+		redacted, rerr := s.agent.Redacted(report, s.agent.Config.OutputProfile)
+		if rerr != nil {
+			log.Printf("batch item %s redaction failed: %v", item.CorrelationID, rerr)
+			result.Status = batchStatusError
+			result.Error = fmt.Sprintf("item %s: %s", item.CorrelationID, adk.Message(adk.DefaultLanguage, adk.MsgInternalError, rerr.Error()))
+			return result
+		}
+		report = redacted
+	}
+	if err != nil {
+		log.Printf("batch item %s failed: %v", item.CorrelationID, err)
+		result.Status = batchStatusError
+		result.Error = fmt.Sprintf("item %s: %v", item.CorrelationID, err)
+		// Keep whatever stages completed when partial results are enabled
+		result.Report = report
+		return result
+	}
+
+	result.Status = batchStatusOK
+	result.Report = report
+	return result
+}
+
+// batchLine is one NDJSON line of a streamed batch; Type is "result" or "error"
+type batchLine struct {
+	Type  string `json:"type"`
+	Index int    `json:"index"`
+	BatchResult
+}
+
+// streamBatch writes one NDJSON line per item as soon as it completes,
+// releasing the workers' analysis slots once every item is written
+func (s *server) streamBatch(c *fiber.Ctx, items []BatchItem, workers int) error {
+	ctx := c.UserContext()
+	c.Set(fiber.HeaderContentType, mimeNDJSON)
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer s.releaseAnalyses(workers)
+
+		// Cancelled when the client goes away, so remaining items are skipped
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		lines := make(chan batchLine)
+		go func() {
+			s.eachBatchResult(ctx, items, workers, func(i int, result BatchResult) {
+				lineType := "result"
+				if result.Status == batchStatusError {
+					lineType = "error"
+				}
+				lines <- batchLine{Type: lineType, Index: i, BatchResult: result}
+			})
+			close(lines)
+		}()
+
+		writeBatchLines(w, lines, cancel)
+	})
+	return nil
+}
+
+// writeBatchLines writes each line as NDJSON, flushing as it goes. On the
+// first write or flush error it cancels the batch and drains the remaining
+// lines so the workers can finish.
+func writeBatchLines(w *bufio.Writer, lines <-chan batchLine, cancel context.CancelFunc) {
+	encoder := json.NewEncoder(w)
+	for line := range lines {
+		err := encoder.Encode(line)
+		if err == nil {
+			// Flush per line so clients can process results incrementally
+			err = w.Flush()
+		}
+		if err != nil {
+			log.Printf("batch stream stopped at item %s: %v", line.CorrelationID, err)
+			cancel()
+			for range lines {
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,444 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// TestAnalyzeBatch_CorrelationIDs tests that every result carries its input's correlation id
+func TestAnalyzeBatch_CorrelationIDs(t *testing.T) {
+	app := setupTestApp()
+
+	body := `{"items":[
+		{"correlation_id":"req-1","company_name":"Alpha","industry":"SaaS"},
+		{"correlation_id":"req-2","company_name":"Beta","industry":"Fintech","order_by":"bogus"},
+		{"company_name":"Gamma","industry":"Healthcare"},
+		{"correlation_id":"req-4","company_name":"Delta","industry":"SaaS"},
+		{"correlation_id":"req-5","company_name":"Epsilon","industry":"SaaS"}
+	]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze/batch", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test batch endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var got struct {
+		Results []BatchResult `json:"results"`
+		Failed  int           `json:"failed"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("Failed to decode batch response: %v", err)
+	}
+
+	companies := []string{"Alpha", "Beta", "Gamma", "Delta", "Epsilon"}
+	if len(got.Results) != len(companies) {
+		t.Fatalf("Expected %d results, got %d", len(companies), len(got.Results))
+	}
+
+	for i, result := range got.Results {
+		switch i {
+		case 1:
+			if result.CorrelationID != "req-2" || result.Status != batchStatusError {
+				t.Errorf("Expected req-2 to fail, got %+v", result)
+			}
+			if !strings.Contains(result.Error, "req-2") {
+				t.Errorf("Expected error to name its correlation id, got %q", result.Error)
+			}
+		case 2:
+			if result.CorrelationID == "" {
+				t.Error("Expected a generated correlation id")
+			}
+		default:
+			if want := "req-" + string(rune('1'+i)); result.CorrelationID != want {
+				t.Errorf("Result %d: expected correlation id %s, got %s", i, want, result.CorrelationID)
+			}
+		}
+
+		if result.Status == batchStatusOK && result.Report.TargetCompany != companies[i] {
+			t.Errorf("Result %s: expected report for %s, got %s", result.CorrelationID, companies[i], result.Report.TargetCompany)
+		}
+	}
+
+	if got.Failed != 1 {
+		t.Errorf("Expected 1 failed item, got %d", got.Failed)
+	}
+}
+
+// TestRunBatchItem_Redaction tests that batch reports are redacted for the
+// default output profile and withheld when redaction fails
+func TestRunBatchItem_Redaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    string
+		wantStatus string
+	}{
+		{name: "Public", profile: adk.ProfilePublic, wantStatus: batchStatusOK},
+		{name: "Unknown", profile: "partner", wantStatus: batchStatusError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServer(adk.NewCompetitorIntelligenceAgent(adk.WithOutputProfile(tt.profile)), serverConfig{})
+			item := &BatchItem{CorrelationID: "req-1", AnalyzeRequest: AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS"}}
+
+			result := s.runBatchItem(context.Background(), item)
+			if result.Status != tt.wantStatus {
+				t.Fatalf("Expected status %s, got %+v", tt.wantStatus, result)
+			}
+			if tt.wantStatus == batchStatusError {
+				if result.Report != nil {
+					t.Errorf("Expected no report when redaction fails, got %+v", result.Report)
+				}
+				return
+			}
+			for _, analysis := range result.Report.Competitors {
+				if analysis.Funding != 0 {
+					t.Errorf("Expected funding redacted for %s, got %v", analysis.CompetitorName, analysis.Funding)
+				}
+			}
+		})
+	}
+}
+
+// TestAnalyzeBatch_Validation tests batch size limits
+func TestAnalyzeBatch_Validation(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		name       string
+		items      int
+		wantStatus int
+	}{
+		{name: "empty", items: 0, wantStatus: http.StatusUnprocessableEntity},
+		{name: "too many", items: maxBatchItems + 1, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]string, tt.items)
+			for i := range items {
+				items[i] = `{"company_name":"TestCorp","industry":"SaaS"}`
+			}
+			body := `{"items":[` + strings.Join(items, ",") + `]}`
+
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze/batch", bytes.NewReader([]byte(body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test batch endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+// TestAnalyzeBatch_Concurrency tests that a batch may override its pool size
+// up to the server maximum
+func TestAnalyzeBatch_Concurrency(t *testing.T) {
+	app := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{BatchMaxConcurrency: 3}).routes()
+
+	tests := []struct {
+		name        string
+		concurrency int
+		wantStatus  int
+	}{
+		{name: "default", concurrency: 0, wantStatus: http.StatusOK},
+		{name: "at cap", concurrency: 3, wantStatus: http.StatusOK},
+		{name: "beyond cap", concurrency: 4, wantStatus: http.StatusUnprocessableEntity},
+		{name: "negative", concurrency: -1, wantStatus: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(fiber.Map{
+				"concurrency": tt.concurrency,
+				"items":       []fiber.Map{{"company_name": "TestCorp", "industry": "SaaS"}},
+			})
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze/batch", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test batch endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if tt.wantStatus != http.StatusUnprocessableEntity {
+				return
+			}
+
+			var got validationResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("Failed to decode validation errors: %v", err)
+			}
+			want := "must be between 0 and 3 (0 uses the default)"
+			if len(got.Errors) != 1 || got.Errors[0].Message != want {
+				t.Errorf("Expected concurrency error %q, got %+v", want, got.Errors)
+			}
+		})
+	}
+}
+
+// TestBatchWorkers tests pool size resolution against the server maximum
+func TestBatchWorkers(t *testing.T) {
+	tests := []struct {
+		name        string
+		max         int
+		concurrency int
+		want        int
+	}{
+		{name: "default", max: 16, concurrency: 0, want: batchWorkers},
+		{name: "override", max: 16, concurrency: 12, want: 12},
+		{name: "default above cap", max: 2, concurrency: 0, want: 2},
+		{name: "no cap configured", max: 0, concurrency: 0, want: batchWorkers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &server{cfg: serverConfig{BatchMaxConcurrency: tt.max}}
+			if got := s.batchWorkers(tt.concurrency); got != tt.want {
+				t.Errorf("Expected %d workers, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestEachBatchResult_Workers tests that the requested pool size bounds how
+// many items are processed at once
+func TestEachBatchResult_Workers(t *testing.T) {
+	s := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{})
+
+	// Invalid items fail validation without running an analysis
+	items := make([]BatchItem, 9)
+	for i := range items {
+		items[i] = BatchItem{AnalyzeRequest: AnalyzeRequest{CompanyName: "TestCorp", OrderBy: "bogus"}}
+	}
+
+	var mu sync.Mutex
+	active, peak := 0, 0
+	s.eachBatchResult(context.Background(), items, 3, func(int, BatchResult) {
+		mu.Lock()
+		active++
+		peak = max(peak, active)
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+	})
+
+	if peak != 3 {
+		t.Errorf("Expected 3 items in flight, got %d", peak)
+	}
+}
+
+// TestAnalyzeBatch_NDJSONStream tests that streamed batches emit one line per input
+func TestAnalyzeBatch_NDJSONStream(t *testing.T) {
+	app := setupTestApp()
+
+	body := `{"items":[
+		{"correlation_id":"a","company_name":"Alpha","industry":"SaaS"},
+		{"correlation_id":"b","company_name":"Beta","industry":"SaaS","order_by":"bogus"},
+		{"correlation_id":"c","company_name":"Gamma","industry":"SaaS"}
+	]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze/batch", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", mimeNDJSON)
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test batch endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), mimeNDJSON) {
+		t.Errorf("Expected NDJSON content type, got %s", resp.Header.Get("Content-Type"))
+	}
+
+	seen := make(map[string]batchLine)
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		var line batchLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("Failed to decode NDJSON line %q: %v", scanner.Text(), err)
+		}
+		seen[line.CorrelationID] = line
+	}
+
+	if len(seen) != 3 {
+		t.Fatalf("Expected 3 lines, got %d", len(seen))
+	}
+	if seen["b"].Type != "error" || seen["b"].Error == "" {
+		t.Errorf("Expected an error line for b, got %+v", seen["b"])
+	}
+	for _, id := range []string{"a", "c"} {
+		if seen[id].Type != "result" || seen[id].Report == nil {
+			t.Errorf("Expected a result line with a report for %s, got %+v", id, seen[id])
+		}
+	}
+	if seen["c"].Index != 2 {
+		t.Errorf("Expected c at index 2, got %d", seen["c"].Index)
+	}
+}
+
+// failingWriter rejects every write, like a disconnected client
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+// TestWriteBatchLines_ClientGone tests that a failed write cancels the batch
+// and drains the remaining lines
+func TestWriteBatchLines_ClientGone(t *testing.T) {
+	lines := make(chan batchLine)
+	go func() {
+		for i := range 5 {
+			lines <- batchLine{Type: "result", Index: i}
+		}
+		close(lines)
+	}()
+
+	cancelled := 0
+	writeBatchLines(bufio.NewWriter(failingWriter{}), lines, func() { cancelled++ })
+
+	if cancelled != 1 {
+		t.Errorf("Expected the batch cancelled once, got %d", cancelled)
+	}
+	if _, open := <-lines; open {
+		t.Error("Expected every line drained")
+	}
+}
+
+// TestEachBatchResult_Cancelled tests that items are not analyzed once the
+// batch is cancelled, while every index still gets a result
+func TestEachBatchResult_Cancelled(t *testing.T) {
+	source := &peakSource{}
+	s := newServer(adk.NewCompetitorIntelligenceAgent(adk.WithDataSource(source)), serverConfig{})
+
+	items := make([]BatchItem, 4)
+	for i := range items {
+		items[i] = BatchItem{CorrelationID: fmt.Sprintf("req-%d", i), AnalyzeRequest: AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS"}}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var mu sync.Mutex
+	emitted := 0
+	s.eachBatchResult(ctx, items, 2, func(i int, result BatchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		emitted++
+		if result.Status != batchStatusError || result.CorrelationID != items[i].CorrelationID {
+			t.Errorf("Expected a cancelled error for %s, got %+v", items[i].CorrelationID, result)
+		}
+	})
+
+	if emitted != len(items) {
+		t.Errorf("Expected %d results, got %d", len(items), emitted)
+	}
+	if source.peak != 0 {
+		t.Errorf("Expected no analyses after cancellation, got %d in flight", source.peak)
+	}
+}
+
+// peakSource is a slow data source recording how many fetches overlap
+type peakSource struct {
+	mu           sync.Mutex
+	active, peak int
+}
+
+func (p *peakSource) Name() string { return "peak" }
+
+func (p *peakSource) FetchCompetitors(ctx context.Context, companyName, industry string) ([]adk.CompetitorData, error) {
+	p.mu.Lock()
+	p.active++
+	p.peak = max(p.peak, p.active)
+	p.mu.Unlock()
+
+	time.Sleep(20 * time.Millisecond)
+
+	p.mu.Lock()
+	p.active--
+	p.mu.Unlock()
+	return []adk.CompetitorData{{Name: "Rival", MarketShare: 20}}, nil
+}
+
+// TestAnalyzeBatch_GlobalLimit tests that batch workers share the server's
+// concurrent analysis limit rather than bypassing it
+func TestAnalyzeBatch_GlobalLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		busy       int
+		wantStatus int
+		wantPeak   int
+	}{
+		{name: "pool shrunk to free slots", busy: 1, wantStatus: http.StatusOK, wantPeak: 2},
+		{name: "no free slots", busy: 3, wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &peakSource{}
+			s := newServer(adk.NewCompetitorIntelligenceAgent(adk.WithDataSource(source)), serverConfig{MaxConcurrentAnalyses: 3, BatchMaxConcurrency: 8})
+			app := s.routes()
+
+			// Other requests already hold some of the slots
+			for range tt.busy {
+				s.acquireAnalysis()
+			}
+
+			items := make([]fiber.Map, 8)
+			for i := range items {
+				items[i] = fiber.Map{"company_name": "TestCorp", "industry": "SaaS"}
+			}
+			body, _ := json.Marshal(fiber.Map{"concurrency": 8, "items": items})
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze/batch", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req, 5000)
+			if err != nil {
+				t.Fatalf("Failed to test batch endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if source.peak != tt.wantPeak {
+				t.Errorf("Expected at most %d analyses at once, got %d", tt.wantPeak, source.peak)
+			}
+			if held := len(s.analyses); held != tt.busy {
+				t.Errorf("Expected the batch to release its slots, %d still held", held-tt.busy)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import "runtime/debug"
+
+// Build metadata, injected at build time with
+//
+//	go build -ldflags "-X main.version=1.2.0 -X main.commit=$(git rev-parse HEAD) -X main.buildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Commit and build time fall back to the VCS stamp Go embeds in binaries
+// built from a checkout.
+var (
+	version   = "1.0.0"
+	commit    = ""
+	buildTime = ""
+)
+
+// unknownBuildValue is reported for metadata that was neither injected nor stamped
+const unknownBuildValue = "unknown"
+
+// buildInfo describes the running binary
+type buildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+}
+
+// currentBuildInfo returns the injected build metadata, filling gaps from
+// the Go toolchain's VCS stamp
+func currentBuildInfo() buildInfo {
+	info := buildInfo{Version: version, Commit: commit, BuildTime: buildTime}
+
+	if stamp, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range stamp.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			}
+		}
+	}
+
+	if info.Commit == "" {
+		info.Commit = unknownBuildValue
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = unknownBuildValue
+	}
+	return info
+}
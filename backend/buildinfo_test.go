@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestHealthEndpoint_BuildInfo tests that /health reports build metadata,
+// using injected values when present
+func TestHealthEndpoint_BuildInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   string
+		commit    string
+		buildTime string
+	}{
+		{name: "defaults", version: version},
+		{name: "injected", version: "1.2.0", commit: "3f9c2d1", buildTime: "2024-03-01T12:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Simulate -ldflags -X by setting the variables directly
+			saved := [3]string{version, commit, buildTime}
+			version, commit, buildTime = tt.version, tt.commit, tt.buildTime
+			t.Cleanup(func() { version, commit, buildTime = saved[0], saved[1], saved[2] })
+
+			resp, err := setupTestApp().Test(httptest.NewRequest(http.MethodGet, "/health", nil))
+			if err != nil {
+				t.Fatalf("Failed to test health endpoint: %v", err)
+			}
+
+			var got map[string]string
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+
+			for _, field := range []string{"status", "service", "version", "commit", "build_time"} {
+				if got[field] == "" {
+					t.Errorf("Expected %s to be present, got %v", field, got)
+				}
+			}
+			if got["version"] != tt.version {
+				t.Errorf("Expected version %q, got %q", tt.version, got["version"])
+			}
+			if tt.commit != "" && got["commit"] != tt.commit {
+				t.Errorf("Expected commit %q, got %q", tt.commit, got["commit"])
+			}
+			if tt.buildTime != "" && got["build_time"] != tt.buildTime {
+				t.Errorf("Expected build time %q, got %q", tt.buildTime, got["build_time"])
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// requestLanguage picks the response language from the lang query parameter
+// or the Accept-Language header
+func requestLanguage(c *fiber.Ctx) string {
+	return adk.NegotiateLanguage(c.Query("lang"), c.Get(fiber.HeaderAcceptLanguage))
+}
+
+// sendError answers with a localized message and its stable code
+func sendError(c *fiber.Ctx, status int, code string, args ...any) error {
+	return c.Status(status).JSON(fiber.Map{
+		"error": adk.Message(requestLanguage(c), code, args...),
+		"code":  code,
+	})
+}
+
+// inputDetail strips the generic invalid input prefix from an error so the
+// localized message does not repeat it
+func inputDetail(err error) string {
+	if !errors.Is(err, adk.ErrInvalidInput) {
+		return err.Error()
+	}
+	return strings.TrimPrefix(err.Error(), adk.ErrInvalidInput.Error()+": ")
+}
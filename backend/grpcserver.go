@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+	competitorv1 "github.com/mk-knight23/ai-sdk-openai/proto/competitor/v1"
+)
+
+// analysisService serves the agent over gRPC, sharing the REST server's
+// agent and concurrency limit
+type analysisService struct {
+	competitorv1.UnimplementedCompetitorIntelligenceServiceServer
+	server *server
+}
+
+// grpcServer creates a gRPC server exposing the analysis service
+func (s *server) grpcServer() *grpc.Server {
+	g := grpc.NewServer()
+	competitorv1.RegisterCompetitorIntelligenceServiceServer(g, &analysisService{server: s})
+	return g
+}
+
+// Analyze runs the pipeline and returns the report. A partial report is
+// returned as-is, with Partial and MissingStages set.
+func (a *analysisService) Analyze(ctx context.Context, msg *competitorv1.AnalyzeRequest) (*competitorv1.CompetitorReport, error) {
+	report, err := a.run(ctx, msg)
+	if err != nil && (report == nil || !report.Partial) {
+		return nil, err
+	}
+	return report.ToProto(), nil
+}
+
+// AnalyzeStream sends an event as each pipeline stage starts, then the report
+func (a *analysisService) AnalyzeStream(msg *competitorv1.AnalyzeRequest, stream grpc.ServerStreamingServer[competitorv1.AnalyzeEvent]) error {
+	var sendErr error
+	ctx := adk.WithStageObserver(stream.Context(), func(stage string) {
+		if sendErr == nil {
+			sendErr = stream.Send(&competitorv1.AnalyzeEvent{Event: &competitorv1.AnalyzeEvent_Stage{Stage: stage}})
+		}
+	})
+
+	report, err := a.run(ctx, msg)
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil && (report == nil || !report.Partial) {
+		return err
+	}
+	return stream.Send(&competitorv1.AnalyzeEvent{Event: &competitorv1.AnalyzeEvent_Report{Report: report.ToProto()}})
+}
+
+// run validates the request and runs it within the concurrency limit,
+// redacting the report for the default output profile. Reports that cannot
+// be redacted are withheld.
+func (a *analysisService) run(ctx context.Context, msg *competitorv1.AnalyzeRequest) (*adk.CompetitorReport, error) {
+	req := &AnalyzeRequest{
+		CompanyName: msg.GetCompanyName(),
+		Industry:    msg.GetIndustry(),
+		Industries:  msg.GetIndustries(),
+		Persona:     msg.GetPersona(),
+		OrderBy:     msg.GetOrderBy(),
+	}
+	if errs := req.validate(); len(errs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, errs.Error())
+	}
+
+	if !a.server.acquireAnalysis() {
+		return nil, status.Error(codes.ResourceExhausted, adk.Message(adk.DefaultLanguage, adk.MsgTooManyAnalyses))
+	}
+	defer a.server.releaseAnalysis()
+
+	report, err := a.server.runAnalysis(ctx, req)
+	if report != nil {
+		redacted, rerr := a.server.agent.Redacted(report, a.server.agent.Config.OutputProfile)
+		if rerr != nil {
+			return nil, status.Error(codes.Internal, rerr.Error())
+		}
+		report = redacted
+	}
+	if err != nil {
+		return report, grpcError(err)
+	}
+	return report, nil
+}
+
+// grpcError maps a pipeline error to a gRPC status, mirroring stageStatus
+func grpcError(err error) error {
+	if errors.Is(err, adk.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	var stageErr *adk.StageError
+	if errors.As(err, &stageErr) && stageErr.Stage == adk.StageResearch {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+	competitorv1 "github.com/mk-knight23/ai-sdk-openai/proto/competitor/v1"
+)
+
+// setupGRPCClient serves s over an in-memory listener and returns a client
+func setupGRPCClient(t *testing.T, s *server) competitorv1.CompetitorIntelligenceServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	g := s.grpcServer()
+	go g.Serve(listener)
+	t.Cleanup(g.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial gRPC server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return competitorv1.NewCompetitorIntelligenceServiceClient(conn)
+}
+
+// TestGRPCAnalyze tests that the Analyze RPC returns a report with competitors
+func TestGRPCAnalyze(t *testing.T) {
+	client := setupGRPCClient(t, newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{}))
+
+	report, err := client.Analyze(context.Background(), &competitorv1.AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS"})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if report.GetTargetCompany() != "TestCorp" {
+		t.Errorf("Expected target company TestCorp, got %q", report.GetTargetCompany())
+	}
+	if len(report.GetCompetitors()) == 0 {
+		t.Fatal("Expected competitors in the report")
+	}
+	for _, competitor := range report.GetCompetitors() {
+		if competitor.GetCompetitorName() == "" || competitor.GetThreatLevel() == "" {
+			t.Errorf("Expected named competitors with threat levels, got %v", competitor)
+		}
+	}
+}
+
+// TestGRPCAnalyze_OutputProfile tests that reports are redacted for the
+// default output profile, withholding them when redaction fails
+func TestGRPCAnalyze_OutputProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     string
+		wantCode    codes.Code
+		wantFunding bool
+	}{
+		{name: "Unrestricted", wantCode: codes.OK, wantFunding: true},
+		{name: "Public", profile: adk.ProfilePublic, wantCode: codes.OK},
+		{name: "Unknown", profile: "partner", wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := adk.NewCompetitorIntelligenceAgent(adk.WithOutputProfile(tt.profile))
+			client := setupGRPCClient(t, newServer(agent, serverConfig{}))
+
+			report, err := client.Analyze(context.Background(), &competitorv1.AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS"})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("Expected %v, got %v", tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			funded := false
+			for _, competitor := range report.GetCompetitors() {
+				funded = funded || competitor.GetFunding() != 0
+				for _, person := range competitor.GetLeadership() {
+					if !tt.wantFunding && person.GetName() != "[redacted]" {
+						t.Errorf("Expected leader names masked, got %q", person.GetName())
+					}
+				}
+			}
+			if funded != tt.wantFunding {
+				t.Errorf("Expected funding present = %v, got %v", tt.wantFunding, funded)
+			}
+		})
+	}
+}
+
+// TestGRPCAnalyze_InvalidArgument tests that bad requests map to InvalidArgument
+func TestGRPCAnalyze_InvalidArgument(t *testing.T) {
+	client := setupGRPCClient(t, newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{}))
+
+	_, err := client.Analyze(context.Background(), &competitorv1.AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS", Persona: "auditor"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+}
+
+// TestGRPCAnalyze_ConcurrencyLimit tests that a saturated semaphore yields ResourceExhausted
+func TestGRPCAnalyze_ConcurrencyLimit(t *testing.T) {
+	s := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{MaxConcurrentAnalyses: 1})
+	client := setupGRPCClient(t, s)
+	s.analyses <- struct{}{}
+
+	_, err := client.Analyze(context.Background(), &competitorv1.AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected ResourceExhausted, got %v", err)
+	}
+}
+
+// TestGRPCAnalyzeStream tests that stage updates precede the final report
+func TestGRPCAnalyzeStream(t *testing.T) {
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(adk.NewMemoryReportStore()))
+	client := setupGRPCClient(t, newServer(agent, serverConfig{}))
+
+	stream, err := client.AnalyzeStream(context.Background(), &competitorv1.AnalyzeRequest{CompanyName: "TestCorp", Industry: "SaaS"})
+	if err != nil {
+		t.Fatalf("AnalyzeStream() error = %v", err)
+	}
+
+	var stages []string
+	var report *competitorv1.CompetitorReport
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if report != nil {
+			t.Fatalf("Expected the report to be the last event, got %v", event)
+		}
+		if stage := event.GetStage(); stage != "" {
+			stages = append(stages, stage)
+		}
+		report = event.GetReport()
+	}
+
+	if got := strings.Join(stages, ","); got != "research,analysis,report,persistence" {
+		t.Errorf("Expected every stage in order, got %s", got)
+	}
+	if report == nil || len(report.GetCompetitors()) == 0 {
+		t.Errorf("Expected a final report with competitors, got %v", report)
+	}
+}
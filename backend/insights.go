@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// InsightsResponse is the narrative-only view of an analysis
+type InsightsResponse struct {
+	Company          string `json:"company"`
+	Industry         string `json:"industry"`
+	MarketInsights   string `json:"market_insights"`
+	ExecutiveSummary string `json:"executive_summary,omitempty"`
+}
+
+// text renders the narrative as plain text, insights first
+func (r InsightsResponse) text() string {
+	parts := []string{r.MarketInsights}
+	if r.ExecutiveSummary != "" {
+		parts = append(parts, r.ExecutiveSummary)
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// analyzeInsights runs the analysis for the company and industry query
+// parameters and returns only its narrative, as JSON or plain text per the
+// Accept header
+func (s *server) analyzeInsights(c *fiber.Ctx) error {
+	req := &AnalyzeRequest{
+		CompanyName: c.Query("company"),
+		Industry:    c.Query("industry"),
+		Persona:     c.Query("persona"),
+	}
+	if req.CompanyName == "" {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgCompanyRequired)
+	}
+	if errs := req.validate(); len(errs) > 0 {
+		return sendValidationErrors(c, errs)
+	}
+
+	report, err := s.runAnalysis(c.UserContext(), req)
+	if err != nil {
+		return sendPipelineError(c, err)
+	}
+
+	resp := InsightsResponse{
+		Company:          report.TargetCompany,
+		Industry:         req.Industry,
+		MarketInsights:   report.MarketInsights,
+		ExecutiveSummary: report.ExecutiveSummary,
+	}
+	if c.Accepts(fiber.MIMEApplicationJSON, fiber.MIMETextPlain) == fiber.MIMETextPlain {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+		return c.SendString(resp.text())
+	}
+	return c.JSON(resp)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestAnalyzeInsightsEndpoint tests the narrative-only endpoint in both formats
+func TestAnalyzeInsightsEndpoint(t *testing.T) {
+	app := setupTestApp()
+
+	t.Run("JSON", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/analyze/insights?company=TestCorp&industry=SaaS&persona=investor", nil)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to test insights endpoint: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+		}
+
+		var body InsightsResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if body.Company != "TestCorp" || body.Industry != "SaaS" {
+			t.Errorf("Expected TestCorp in SaaS, got %+v", body)
+		}
+		if strings.TrimSpace(body.MarketInsights) == "" {
+			t.Error("Expected non-empty market insights")
+		}
+		if body.ExecutiveSummary == "" {
+			t.Error("Expected an executive summary for the investor persona")
+		}
+	})
+
+	t.Run("Plain text", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/analyze/insights?company=TestCorp&industry=SaaS", nil)
+		req.Header.Set("Accept", "text/plain")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to test insights endpoint: %v", err)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+			t.Errorf("Expected text/plain, got %q", ct)
+		}
+		text, _ := io.ReadAll(resp.Body)
+		if !strings.Contains(string(text), "TestCorp") {
+			t.Errorf("Expected narrative about TestCorp, got %q", text)
+		}
+	})
+
+	t.Run("Missing company", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/analyze/insights?industry=SaaS", nil))
+		if err != nil {
+			t.Fatalf("Failed to test insights endpoint: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+	})
+}
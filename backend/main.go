@@ -1,93 +1,409 @@
 /*
-OpenAI Backend Server
+MarketPulse Backend Server
 AI SDK: OpenAI
-Tech Stack: Go + Standard Library
+Tech Stack: Go + Fiber
 */
 
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"log"
-	"net/http"
+	"net"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	_ "time/tzdata" // REPORT_TIMEZONE must resolve in minimal images
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
 )
 
 // Response represents a standard API response
 type Response struct {
-	Status   string      `json:"status"`
-	Message  string      `json:"message,omitempty"`
-	Data     interface{} `json:"data,omitempty"`
+	Status  string      `json:"status"`
+	Message string      `json:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
 }
 
 // AIRequest represents an AI request
 type AIRequest struct {
-	Prompt      string                 `json:"prompt"`
+	Prompt     string                 `json:"prompt"`
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
 func main() {
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
-	}
-
-	// Health check endpoint
-	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		response := Response{
-			Status: "healthy",
-			Data: map[string]string{
-				"service": "openai-api",
-				"version": "1.0.0",
-			},
-		}
-		json.NewEncoder(w).Encode(response)
-	})
-
-	// Root endpoint
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		response := Response{
-			Status:  "success",
-			Message: fmt.Sprintf("Welcome to OpenAI API"),
-			Data: map[string]interface{}{
-				"version": "1.0.0",
-				"endpoints": map[string]string{
-					"health": "/health",
-					"api":    "/api/ai",
-				},
-			},
-		}
-		json.NewEncoder(w).Encode(response)
-	})
-
-	// AI endpoint (placeholder for now)
-	http.HandleFunc("/api/ai", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		var aiReq AIRequest
-		if err := json.NewDecoder(r.Body).Decode(&aiReq); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		response := Response{
-			Status:  "success",
-			Message: fmt.Sprintf("Mock AI response for: %s", aiReq.Prompt),
-			Data: map[string]string{
-				"framework": "OpenAI",
-			},
-		}
-		json.NewEncoder(w).Encode(response)
-	})
-
-	addr := fmt.Sprintf(":%s", port)
-	log.Printf("Server starting on %s", addr)
-	log.Fatal(http.ListenAndServe(addr, nil))
+	cfg := loadServerConfig()
+
+	// Initialize Google ADK agent
+	agent, err := buildAgent(cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure agent: %v", err)
+	}
+
+	srv := newServer(agent, cfg)
+	app := srv.routes()
+
+	// Stop background jobs and drain requests on SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	jobs := startReportCleanup(ctx, agent, cfg)
+	if err := startGRPC(ctx, srv, cfg, jobs); err != nil {
+		log.Fatalf("Failed to start gRPC server: %v", err)
+	}
+	go func() {
+		<-ctx.Done()
+		if err := app.Shutdown(); err != nil {
+			log.Printf("Server shutdown failed: %v", err)
+		}
+	}()
+
+	addr := ":" + cfg.Port
+	build := currentBuildInfo()
+	log.Printf("Server starting on %s (version %s, commit %s, built %s)", addr, build.Version, build.Commit, build.BuildTime)
+	log.Printf("Max concurrent analyses: %d", cfg.MaxConcurrentAnalyses)
+	if err := app.Listen(addr); err != nil {
+		log.Fatal(err)
+	}
+
+	stop()
+	jobs.Wait()
+	log.Printf("Server stopped")
+}
+
+// startGRPC serves the agent over gRPC on GRPCPort until ctx is done,
+// draining in-flight calls before jobs completes
+func startGRPC(ctx context.Context, srv *server, cfg serverConfig, jobs *sync.WaitGroup) error {
+	if cfg.GRPCPort == "" {
+		return nil
+	}
+	listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		return err
+	}
+
+	g := srv.grpcServer()
+	log.Printf("gRPC server starting on :%s", cfg.GRPCPort)
+	jobs.Add(1)
+	go func() {
+		defer jobs.Done()
+		if err := g.Serve(listener); err != nil {
+			log.Printf("gRPC server stopped: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		g.GracefulStop()
+	}()
+	return nil
+}
+
+// startReportCleanup runs the report expiry job until ctx is done when a
+// retention period is configured
+func startReportCleanup(ctx context.Context, agent *adk.CompetitorIntelligenceAgent, cfg serverConfig) *sync.WaitGroup {
+	var jobs sync.WaitGroup
+	if cfg.ReportRetention <= 0 {
+		return &jobs
+	}
+
+	log.Printf("Deleting reports older than %s every %s (dry run: %t)", cfg.ReportRetention, cfg.ReportCleanupInterval, cfg.ReportCleanupDryRun)
+	jobs.Add(1)
+	go func() {
+		defer jobs.Done()
+		adk.RunReportCleanup(ctx, agent.Store(), adk.RetentionPolicy{
+			MaxAge:   cfg.ReportRetention,
+			Interval: cfg.ReportCleanupInterval,
+			DryRun:   cfg.ReportCleanupDryRun,
+		})
+	}()
+	return &jobs
+}
+
+// buildAgent creates the agent with options derived from the server config
+func buildAgent(cfg serverConfig) (*adk.CompetitorIntelligenceAgent, error) {
+	// Persist reports on disk when configured, otherwise keep a bounded
+	// number of them in memory
+	var store adk.ReportStore = adk.NewMemoryReportStore(adk.WithMaxReports(cfg.MemoryStoreMaxReports))
+	if cfg.ReportStoreDir != "" {
+		var storeOpts []adk.FileStoreOption
+		if cfg.CompressReports {
+			storeOpts = append(storeOpts, adk.WithCompression())
+		}
+		fileStore, err := adk.NewFileReportStore(cfg.ReportStoreDir, storeOpts...)
+		if err != nil {
+			return nil, err
+		}
+		store = fileStore
+	}
+
+	opts := []adk.Option{adk.WithReportStore(store)}
+
+	if cfg.ScreenshotServiceURL != "" {
+		opts = append(opts, adk.WithEnricher(adk.NewScreenshotEnricher(cfg.ScreenshotServiceURL, cfg.ScreenshotTimeout)))
+	}
+
+	if cfg.CheckWebsites {
+		opts = append(opts, adk.WithEnricher(adk.NewReachabilityEnricher(cfg.WebsiteCheckTimeout)))
+	}
+
+	if cfg.NewsFeedURL != "" {
+		opts = append(opts, adk.WithEnricher(adk.NewNewsEnricher(cfg.NewsFeedURL, cfg.NewsTimeout, cfg.NewsLimit)))
+	}
+
+	if cfg.ValueFewStrengths > 0 || cfg.ValueManyStrengths > 0 {
+		opts = append(opts, adk.WithValueThresholds(adk.ValueThresholds{
+			FewStrengths:  cfg.ValueFewStrengths,
+			ManyStrengths: cfg.ValueManyStrengths,
+		}))
+	}
+
+	if cfg.LargeCustomerBase > 0 {
+		opts = append(opts, adk.WithLargeCustomerBase(cfg.LargeCustomerBase))
+	}
+
+	if cfg.ObservationHalfLife > 0 {
+		opts = append(opts, adk.WithObservationHalfLife(cfg.ObservationHalfLife))
+	}
+
+	if cfg.ThreatDecayAfter > 0 {
+		opts = append(opts, adk.WithThreatDecay(cfg.ThreatDecayAfter, cfg.ThreatDecayHalfLife))
+	}
+
+	if cfg.InferPositioning {
+		opts = append(opts, adk.WithInferredPositioning())
+	}
+
+	switch {
+	case cfg.InsightsTemplate != "":
+		tmpl, err := adk.ParseInsightsTemplate(cfg.InsightsTemplate)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithInsightsTemplate(tmpl))
+	case cfg.InsightsTemplateFile != "":
+		tmpl, err := adk.LoadInsightsTemplate(cfg.InsightsTemplateFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithInsightsTemplate(tmpl))
+	}
+
+	for format, maxLen := range cfg.maxFieldLengths() {
+		if maxLen > 0 {
+			opts = append(opts, adk.WithMaxFieldLength(format, maxLen))
+		}
+	}
+
+	if cfg.RadarAxes != "" {
+		axes, err := adk.ParseRadarAxes(cfg.RadarAxes)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithRadarAxes(axes...))
+	}
+
+	if cfg.Features != "" {
+		features, err := adk.ParseFeatures(cfg.Features)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithFeatures(features...))
+	}
+
+	if cfg.JSONEmptyLists {
+		opts = append(opts, adk.WithEmptyLists())
+	}
+
+	if cfg.JSONPayload != "" {
+		mode, err := adk.ParsePayloadMode(cfg.JSONPayload)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithPayload(mode))
+	}
+
+	if cfg.MarkdownCitations {
+		opts = append(opts, adk.WithCitations())
+	}
+
+	if cfg.AnalysisCacheSize > 0 {
+		opts = append(opts, adk.WithAnalysisCache(cfg.AnalysisCacheSize))
+	}
+
+	if cfg.TimeBudget > 0 {
+		opts = append(opts, adk.WithTimeBudget(cfg.TimeBudget))
+	}
+
+	if cfg.RunRetries > 0 {
+		opts = append(opts, adk.WithRunRetry(cfg.RunRetries, cfg.RunRetryBackoff))
+	}
+
+	if cfg.HighThreatShare != 0 || cfg.MediumThreatShare != 0 {
+		if err := adk.ValidateThreatShares(cfg.HighThreatShare, cfg.MediumThreatShare); err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithThreatShares(cfg.HighThreatShare, cfg.MediumThreatShare))
+	}
+
+	if cfg.ViabilityHHI != 0 || cfg.ViabilityTopShare != 0 {
+		if err := adk.ValidateViabilityThresholds(cfg.ViabilityHHI, cfg.ViabilityTopShare); err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithViabilityThresholds(cfg.ViabilityHHI, cfg.ViabilityTopShare))
+	}
+
+	if cfg.MinThreatLevel != "" {
+		level, err := adk.ParseThreatLevel(cfg.MinThreatLevel)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithMinThreatLevel(level))
+	}
+
+	if cfg.TierCaps != "" {
+		caps, err := adk.ParseTierCaps(cfg.TierCaps)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithTierCaps(caps))
+	}
+
+	if cfg.SampleSize > 0 {
+		method, err := adk.ParseSampleMethod(cfg.SampleMethod)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithSampling(method, cfg.SampleSize, int64(cfg.SampleSeed)))
+	}
+
+	if cfg.FuzzyMatchThreshold != 0 {
+		if err := adk.ValidateFuzzyMatchThreshold(cfg.FuzzyMatchThreshold); err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithFuzzyMatching(cfg.FuzzyMatchThreshold))
+	}
+
+	if cfg.TieBreaks != "" {
+		chain, err := adk.ParseTieBreaks(cfg.TieBreaks)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithTieBreaks(chain...))
+	}
+
+	if cfg.EmergingGrowthRate > 0 {
+		opts = append(opts, adk.WithEmergingGrowthRate(cfg.EmergingGrowthRate))
+	}
+
+	if cfg.EmergingMaxShare > 0 || cfg.EmergingMinStrengths > 0 {
+		opts = append(opts, adk.WithEmergingPlayerCriteria(cfg.EmergingMaxShare, cfg.EmergingMinStrengths))
+	}
+
+	if cfg.MinShareChange > 0 {
+		opts = append(opts, adk.WithChangeThreshold(cfg.MinShareChange))
+	}
+
+	if cfg.NormalizeShares {
+		opts = append(opts, adk.WithShareNormalization())
+	}
+
+	if cfg.Persona != "" {
+		persona, err := adk.ParsePersona(cfg.Persona)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithDefaultPersona(persona))
+	}
+
+	if cfg.Tone != "" {
+		tone, err := adk.ParseTone(cfg.Tone)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithTone(tone))
+	}
+
+	if cfg.Timezone != "" {
+		loc, err := adk.ParseTimezone(cfg.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithTimezone(loc))
+	}
+
+	if cfg.ShareFormat != "" {
+		format, err := adk.ParseShareFormat(cfg.ShareFormat)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithShareFormat(format))
+	}
+
+	if cfg.ScoreNormalization != "" {
+		mode, err := adk.ParseScoreNormalization(cfg.ScoreNormalization)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithScoreNormalization(mode))
+	}
+
+	if cfg.MinRecommendations > 0 {
+		opts = append(opts, adk.WithMinRecommendations(cfg.MinRecommendations))
+	}
+
+	if cfg.DefaultRecommendations != "" {
+		opts = append(opts, adk.WithDefaultRecommendations(strings.Split(cfg.DefaultRecommendations, "|")...))
+	}
+
+	if cfg.RecommendationRationale {
+		opts = append(opts, adk.WithRecommendationRationale())
+	}
+
+	if cfg.PartialResults {
+		opts = append(opts, adk.WithPartialResults())
+	}
+
+	if cfg.IndustryBenchmarks != "" {
+		benchmarks, err := adk.ParseIndustryBenchmarks(cfg.IndustryBenchmarks)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithIndustryBenchmarks(benchmarks))
+	}
+
+	if cfg.IndustryLookup != "" {
+		lookup, err := adk.ParseIndustryLookup(cfg.IndustryLookup)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithIndustryLookup(lookup))
+	}
+
+	if cfg.OpportunityWeights != "" {
+		weights, err := adk.ParseOpportunityWeights(cfg.OpportunityWeights)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithOpportunityWeights(weights))
+	}
+
+	rules := adk.DefaultRedactionRules
+	if cfg.RedactionRules != "" {
+		parsed, err := adk.ParseRedactionRules(cfg.RedactionRules)
+		if err != nil {
+			return nil, err
+		}
+		rules = parsed
+		opts = append(opts, adk.WithRedactionRules(rules))
+	}
+	if cfg.OutputProfile != "" {
+		if _, ok := rules[strings.ToLower(cfg.OutputProfile)]; !ok {
+			return nil, fmt.Errorf("%w: unknown output profile %q", adk.ErrInvalidInput, cfg.OutputProfile)
+		}
+		opts = append(opts, adk.WithOutputProfile(cfg.OutputProfile))
+	}
+
+	return adk.NewCompetitorIntelligenceAgent(opts...), nil
 }
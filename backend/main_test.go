@@ -11,62 +11,15 @@ import (
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
-	"marketpulse-api/adk"
+	"github.com/mk-knight23/ai-sdk-openai/adk"
 )
 
 // setupTestApp creates a Fiber app for testing
 func setupTestApp() *fiber.App {
-	app := fiber.New()
-
 	// Initialize Google ADK agent
 	agent := adk.NewCompetitorIntelligenceAgent()
 
-	// Health check endpoint
-	app.Get("/health", func(c *fiber.Ctx) error {
-		return c.JSON(fiber.Map{
-			"status":  "healthy",
-			"service": "marketpulse-api",
-			"version": "1.0.0",
-		})
-	})
-
-	// API routes
-	api := app.Group("/api")
-
-	// Competitor intelligence endpoint
-	api.Post("/analyze", func(c *fiber.Ctx) error {
-		type AnalyzeRequest struct {
-			CompanyName string `json:"company_name"`
-			Industry    string `json:"industry"`
-		}
-
-		req := new(AnalyzeRequest)
-		if err := c.BodyParser(req); err != nil {
-			return c.Status(400).JSON(fiber.Map{
-				"error": "Invalid request body",
-			})
-		}
-
-		// Run Google ADK competitor analysis
-		report, err := agent.Run(c.Context(), req.CompanyName, req.Industry)
-		if err != nil {
-			return c.Status(500).JSON(fiber.Map{
-				"error": err.Error(),
-			})
-		}
-
-		// Convert report to JSON
-		reportJSON, err := report.ToJSON()
-		if err != nil {
-			return c.Status(500).JSON(fiber.Map{
-				"error": "Failed to generate report",
-			})
-		}
-
-		return c.Send(reportJSON)
-	})
-
-	return app
+	return newServer(agent, serverConfig{}).routes()
 }
 
 // TestHealthEndpoint tests the /health endpoint
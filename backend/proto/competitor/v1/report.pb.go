@@ -0,0 +1,1745 @@
+// Protobuf mirror of adk.CompetitorReport for gRPC and other binary
+// consumers. Field names follow the JSON field names of the Go structs.
+// Regenerate with `buf generate` from the backend directory.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: competitor/v1/report.proto
+
+package competitorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CompetitorReport struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GeneratedAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	TargetCompany         string                 `protobuf:"bytes,3,opt,name=target_company,json=targetCompany,proto3" json:"target_company,omitempty"`
+	Competitors           []*CompetitorAnalysis  `protobuf:"bytes,4,rep,name=competitors,proto3" json:"competitors,omitempty"`
+	MarketInsights        string                 `protobuf:"bytes,5,opt,name=market_insights,json=marketInsights,proto3" json:"market_insights,omitempty"`
+	Recommendations       []string               `protobuf:"bytes,6,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	ExecutiveSummary      string                 `protobuf:"bytes,7,opt,name=executive_summary,json=executiveSummary,proto3" json:"executive_summary,omitempty"`
+	Persona               string                 `protobuf:"bytes,8,opt,name=persona,proto3" json:"persona,omitempty"`
+	RecommendationDetails []*Recommendation      `protobuf:"bytes,9,rep,name=recommendation_details,json=recommendationDetails,proto3" json:"recommendation_details,omitempty"`
+	ConfidenceNote        string                 `protobuf:"bytes,10,opt,name=confidence_note,json=confidenceNote,proto3" json:"confidence_note,omitempty"`
+	RecommendationGroups  []*RecommendationGroup `protobuf:"bytes,11,rep,name=recommendation_groups,json=recommendationGroups,proto3" json:"recommendation_groups,omitempty"`
+	CategoryShares        []*CategoryShare       `protobuf:"bytes,12,rep,name=category_shares,json=categoryShares,proto3" json:"category_shares,omitempty"`
+	OthersShare           float64                `protobuf:"fixed64,13,opt,name=others_share,json=othersShare,proto3" json:"others_share,omitempty"`
+	OmittedCompetitors    int32                  `protobuf:"varint,14,opt,name=omitted_competitors,json=omittedCompetitors,proto3" json:"omitted_competitors,omitempty"`
+	ExcludedByProduct     int32                  `protobuf:"varint,15,opt,name=excluded_by_product,json=excludedByProduct,proto3" json:"excluded_by_product,omitempty"`
+	Meta                  *ReportMeta            `protobuf:"bytes,16,opt,name=meta,proto3" json:"meta,omitempty"`
+	SkippedSteps          []string               `protobuf:"bytes,17,rep,name=skipped_steps,json=skippedSteps,proto3" json:"skipped_steps,omitempty"`
+	Sampling              *SamplingInfo          `protobuf:"bytes,18,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	Pseudonyms            map[string]string      `protobuf:"bytes,19,rep,name=pseudonyms,proto3" json:"pseudonyms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Partial               bool                   `protobuf:"varint,20,opt,name=partial,proto3" json:"partial,omitempty"`
+	MissingStages         []string               `protobuf:"bytes,21,rep,name=missing_stages,json=missingStages,proto3" json:"missing_stages,omitempty"`
+	ScoreNormalization    string                 `protobuf:"bytes,22,opt,name=score_normalization,json=scoreNormalization,proto3" json:"score_normalization,omitempty"`
+	MarketViability       *MarketViability       `protobuf:"bytes,23,opt,name=market_viability,json=marketViability,proto3" json:"market_viability,omitempty"`
+	TierOmissions         map[string]int32       `protobuf:"bytes,24,rep,name=tier_omissions,json=tierOmissions,proto3" json:"tier_omissions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	FeatureMatrix         *FeatureMatrix         `protobuf:"bytes,25,opt,name=feature_matrix,json=featureMatrix,proto3" json:"feature_matrix,omitempty"`
+	Industries            []string               `protobuf:"bytes,26,rep,name=industries,proto3" json:"industries,omitempty"`
+	IndustrySource        string                 `protobuf:"bytes,27,opt,name=industry_source,json=industrySource,proto3" json:"industry_source,omitempty"`
+	EmergingPlayers       []string               `protobuf:"bytes,28,rep,name=emerging_players,json=emergingPlayers,proto3" json:"emerging_players,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CompetitorReport) Reset() {
+	*x = CompetitorReport{}
+	mi := &file_competitor_v1_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompetitorReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompetitorReport) ProtoMessage() {}
+
+func (x *CompetitorReport) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompetitorReport.ProtoReflect.Descriptor instead.
+func (*CompetitorReport) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompetitorReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetTargetCompany() string {
+	if x != nil {
+		return x.TargetCompany
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetCompetitors() []*CompetitorAnalysis {
+	if x != nil {
+		return x.Competitors
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetMarketInsights() string {
+	if x != nil {
+		return x.MarketInsights
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetRecommendations() []string {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetExecutiveSummary() string {
+	if x != nil {
+		return x.ExecutiveSummary
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetRecommendationDetails() []*Recommendation {
+	if x != nil {
+		return x.RecommendationDetails
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetConfidenceNote() string {
+	if x != nil {
+		return x.ConfidenceNote
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetRecommendationGroups() []*RecommendationGroup {
+	if x != nil {
+		return x.RecommendationGroups
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetCategoryShares() []*CategoryShare {
+	if x != nil {
+		return x.CategoryShares
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetOthersShare() float64 {
+	if x != nil {
+		return x.OthersShare
+	}
+	return 0
+}
+
+func (x *CompetitorReport) GetOmittedCompetitors() int32 {
+	if x != nil {
+		return x.OmittedCompetitors
+	}
+	return 0
+}
+
+func (x *CompetitorReport) GetExcludedByProduct() int32 {
+	if x != nil {
+		return x.ExcludedByProduct
+	}
+	return 0
+}
+
+func (x *CompetitorReport) GetMeta() *ReportMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetSkippedSteps() []string {
+	if x != nil {
+		return x.SkippedSteps
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetSampling() *SamplingInfo {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetPseudonyms() map[string]string {
+	if x != nil {
+		return x.Pseudonyms
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *CompetitorReport) GetMissingStages() []string {
+	if x != nil {
+		return x.MissingStages
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetScoreNormalization() string {
+	if x != nil {
+		return x.ScoreNormalization
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetMarketViability() *MarketViability {
+	if x != nil {
+		return x.MarketViability
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetTierOmissions() map[string]int32 {
+	if x != nil {
+		return x.TierOmissions
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetFeatureMatrix() *FeatureMatrix {
+	if x != nil {
+		return x.FeatureMatrix
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetIndustries() []string {
+	if x != nil {
+		return x.Industries
+	}
+	return nil
+}
+
+func (x *CompetitorReport) GetIndustrySource() string {
+	if x != nil {
+		return x.IndustrySource
+	}
+	return ""
+}
+
+func (x *CompetitorReport) GetEmergingPlayers() []string {
+	if x != nil {
+		return x.EmergingPlayers
+	}
+	return nil
+}
+
+type CompetitorAnalysis struct {
+	state                 protoimpl.MessageState    `protogen:"open.v1"`
+	CompetitorName        string                    `protobuf:"bytes,1,opt,name=competitor_name,json=competitorName,proto3" json:"competitor_name,omitempty"`
+	ThreatLevel           string                    `protobuf:"bytes,2,opt,name=threat_level,json=threatLevel,proto3" json:"threat_level,omitempty"`
+	ThreatScore           float64                   `protobuf:"fixed64,3,opt,name=threat_score,json=threatScore,proto3" json:"threat_score,omitempty"`
+	EmergingThreat        bool                      `protobuf:"varint,4,opt,name=emerging_threat,json=emergingThreat,proto3" json:"emerging_threat,omitempty"`
+	Rank                  int32                     `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	ScoreBreakdown        map[string]float64        `protobuf:"bytes,6,rep,name=score_breakdown,json=scoreBreakdown,proto3" json:"score_breakdown,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	MarketShare           float64                   `protobuf:"fixed64,7,opt,name=market_share,json=marketShare,proto3" json:"market_share,omitempty"`
+	NormalizedShare       float64                   `protobuf:"fixed64,8,opt,name=normalized_share,json=normalizedShare,proto3" json:"normalized_share,omitempty"`
+	Funding               float64                   `protobuf:"fixed64,9,opt,name=funding,proto3" json:"funding,omitempty"`
+	Momentum              string                    `protobuf:"bytes,10,opt,name=momentum,proto3" json:"momentum,omitempty"`
+	Positioning           string                    `protobuf:"bytes,11,opt,name=positioning,proto3" json:"positioning,omitempty"`
+	KeyDifferentiators    []string                  `protobuf:"bytes,12,rep,name=key_differentiators,json=keyDifferentiators,proto3" json:"key_differentiators,omitempty"`
+	TechStack             []string                  `protobuf:"bytes,13,rep,name=tech_stack,json=techStack,proto3" json:"tech_stack,omitempty"`
+	Weaknesses            []string                  `protobuf:"bytes,14,rep,name=weaknesses,proto3" json:"weaknesses,omitempty"`
+	Opportunities         []string                  `protobuf:"bytes,15,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	Risks                 []string                  `protobuf:"bytes,16,rep,name=risks,proto3" json:"risks,omitempty"`
+	ScreenshotUrl         string                    `protobuf:"bytes,17,opt,name=screenshot_url,json=screenshotUrl,proto3" json:"screenshot_url,omitempty"`
+	WebsiteStatus         *WebsiteStatus            `protobuf:"bytes,18,opt,name=website_status,json=websiteStatus,proto3" json:"website_status,omitempty"`
+	Completeness          float64                   `protobuf:"fixed64,19,opt,name=completeness,proto3" json:"completeness,omitempty"`
+	Confidence            float64                   `protobuf:"fixed64,20,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Industries            []string                  `protobuf:"bytes,21,rep,name=industries,proto3" json:"industries,omitempty"`
+	Leadership            []*Person                 `protobuf:"bytes,22,rep,name=leadership,proto3" json:"leadership,omitempty"`
+	ActionPlan            []string                  `protobuf:"bytes,23,rep,name=action_plan,json=actionPlan,proto3" json:"action_plan,omitempty"`
+	Notes                 []string                  `protobuf:"bytes,24,rep,name=notes,proto3" json:"notes,omitempty"`
+	PositioningInferred   bool                      `protobuf:"varint,25,opt,name=positioning_inferred,json=positioningInferred,proto3" json:"positioning_inferred,omitempty"`
+	ShareUncertainty      float64                   `protobuf:"fixed64,26,opt,name=share_uncertainty,json=shareUncertainty,proto3" json:"share_uncertainty,omitempty"`
+	Relationships         []*Relationship           `protobuf:"bytes,27,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	ValuePosition         string                    `protobuf:"bytes,28,opt,name=value_position,json=valuePosition,proto3" json:"value_position,omitempty"`
+	Category              string                    `protobuf:"bytes,29,opt,name=category,proto3" json:"category,omitempty"`
+	Customers             int64                     `protobuf:"varint,30,opt,name=customers,proto3" json:"customers,omitempty"`
+	RecentNews            []*NewsItem               `protobuf:"bytes,31,rep,name=recent_news,json=recentNews,proto3" json:"recent_news,omitempty"`
+	BenchmarkNotes        []string                  `protobuf:"bytes,32,rep,name=benchmark_notes,json=benchmarkNotes,proto3" json:"benchmark_notes,omitempty"`
+	DataWarnings          []string                  `protobuf:"bytes,33,rep,name=data_warnings,json=dataWarnings,proto3" json:"data_warnings,omitempty"`
+	Highlighted           bool                      `protobuf:"varint,34,opt,name=highlighted,proto3" json:"highlighted,omitempty"`
+	FocusSummary          string                    `protobuf:"bytes,35,opt,name=focus_summary,json=focusSummary,proto3" json:"focus_summary,omitempty"`
+	OpportunityPriorities []*PrioritizedOpportunity `protobuf:"bytes,36,rep,name=opportunity_priorities,json=opportunityPriorities,proto3" json:"opportunity_priorities,omitempty"`
+	NormalizedThreatScore *float64                  `protobuf:"fixed64,37,opt,name=normalized_threat_score,json=normalizedThreatScore,proto3,oneof" json:"normalized_threat_score,omitempty"`
+	FeatureSupport        map[string]string         `protobuf:"bytes,38,rep,name=feature_support,json=featureSupport,proto3" json:"feature_support,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Provenance            map[string]string         `protobuf:"bytes,39,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PricingTier           string                    `protobuf:"bytes,40,opt,name=pricing_tier,json=pricingTier,proto3" json:"pricing_tier,omitempty"`
+	EmergingPlayer        bool                      `protobuf:"varint,41,opt,name=emerging_player,json=emergingPlayer,proto3" json:"emerging_player,omitempty"`
+	StalenessDiscount     float64                   `protobuf:"fixed64,42,opt,name=staleness_discount,json=stalenessDiscount,proto3" json:"staleness_discount,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CompetitorAnalysis) Reset() {
+	*x = CompetitorAnalysis{}
+	mi := &file_competitor_v1_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompetitorAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompetitorAnalysis) ProtoMessage() {}
+
+func (x *CompetitorAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompetitorAnalysis.ProtoReflect.Descriptor instead.
+func (*CompetitorAnalysis) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompetitorAnalysis) GetCompetitorName() string {
+	if x != nil {
+		return x.CompetitorName
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetThreatLevel() string {
+	if x != nil {
+		return x.ThreatLevel
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetThreatScore() float64 {
+	if x != nil {
+		return x.ThreatScore
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetEmergingThreat() bool {
+	if x != nil {
+		return x.EmergingThreat
+	}
+	return false
+}
+
+func (x *CompetitorAnalysis) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetScoreBreakdown() map[string]float64 {
+	if x != nil {
+		return x.ScoreBreakdown
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetMarketShare() float64 {
+	if x != nil {
+		return x.MarketShare
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetNormalizedShare() float64 {
+	if x != nil {
+		return x.NormalizedShare
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetFunding() float64 {
+	if x != nil {
+		return x.Funding
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetMomentum() string {
+	if x != nil {
+		return x.Momentum
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetPositioning() string {
+	if x != nil {
+		return x.Positioning
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetKeyDifferentiators() []string {
+	if x != nil {
+		return x.KeyDifferentiators
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetTechStack() []string {
+	if x != nil {
+		return x.TechStack
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetWeaknesses() []string {
+	if x != nil {
+		return x.Weaknesses
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetOpportunities() []string {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetRisks() []string {
+	if x != nil {
+		return x.Risks
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetScreenshotUrl() string {
+	if x != nil {
+		return x.ScreenshotUrl
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetWebsiteStatus() *WebsiteStatus {
+	if x != nil {
+		return x.WebsiteStatus
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetCompleteness() float64 {
+	if x != nil {
+		return x.Completeness
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetIndustries() []string {
+	if x != nil {
+		return x.Industries
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetLeadership() []*Person {
+	if x != nil {
+		return x.Leadership
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetActionPlan() []string {
+	if x != nil {
+		return x.ActionPlan
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetPositioningInferred() bool {
+	if x != nil {
+		return x.PositioningInferred
+	}
+	return false
+}
+
+func (x *CompetitorAnalysis) GetShareUncertainty() float64 {
+	if x != nil {
+		return x.ShareUncertainty
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetValuePosition() string {
+	if x != nil {
+		return x.ValuePosition
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetCustomers() int64 {
+	if x != nil {
+		return x.Customers
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetRecentNews() []*NewsItem {
+	if x != nil {
+		return x.RecentNews
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetBenchmarkNotes() []string {
+	if x != nil {
+		return x.BenchmarkNotes
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetDataWarnings() []string {
+	if x != nil {
+		return x.DataWarnings
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetHighlighted() bool {
+	if x != nil {
+		return x.Highlighted
+	}
+	return false
+}
+
+func (x *CompetitorAnalysis) GetFocusSummary() string {
+	if x != nil {
+		return x.FocusSummary
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetOpportunityPriorities() []*PrioritizedOpportunity {
+	if x != nil {
+		return x.OpportunityPriorities
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetNormalizedThreatScore() float64 {
+	if x != nil && x.NormalizedThreatScore != nil {
+		return *x.NormalizedThreatScore
+	}
+	return 0
+}
+
+func (x *CompetitorAnalysis) GetFeatureSupport() map[string]string {
+	if x != nil {
+		return x.FeatureSupport
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetProvenance() map[string]string {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *CompetitorAnalysis) GetPricingTier() string {
+	if x != nil {
+		return x.PricingTier
+	}
+	return ""
+}
+
+func (x *CompetitorAnalysis) GetEmergingPlayer() bool {
+	if x != nil {
+		return x.EmergingPlayer
+	}
+	return false
+}
+
+func (x *CompetitorAnalysis) GetStalenessDiscount() float64 {
+	if x != nil {
+		return x.StalenessDiscount
+	}
+	return 0
+}
+
+type WebsiteStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reachable     bool                   `protobuf:"varint,1,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsiteStatus) Reset() {
+	*x = WebsiteStatus{}
+	mi := &file_competitor_v1_report_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsiteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteStatus) ProtoMessage() {}
+
+func (x *WebsiteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteStatus.ProtoReflect.Descriptor instead.
+func (*WebsiteStatus) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebsiteStatus) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *WebsiteStatus) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebsiteStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebsiteStatus) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type Person struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role            string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	YearsExperience int32                  `protobuf:"varint,3,opt,name=years_experience,json=yearsExperience,proto3" json:"years_experience,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_competitor_v1_report_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Person) GetYearsExperience() int32 {
+	if x != nil {
+		return x.YearsExperience
+	}
+	return 0
+}
+
+type Relationship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_competitor_v1_report_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Relationship) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Relationship) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type NewsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Sentiment     string                 `protobuf:"bytes,4,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsItem) Reset() {
+	*x = NewsItem{}
+	mi := &file_competitor_v1_report_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsItem) ProtoMessage() {}
+
+func (x *NewsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsItem.ProtoReflect.Descriptor instead.
+func (*NewsItem) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NewsItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewsItem) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *NewsItem) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *NewsItem) GetSentiment() string {
+	if x != nil {
+		return x.Sentiment
+	}
+	return ""
+}
+
+type PrioritizedOpportunity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opportunity   string                 `protobuf:"bytes,1,opt,name=opportunity,proto3" json:"opportunity,omitempty"`
+	Weakness      string                 `protobuf:"bytes,2,opt,name=weakness,proto3" json:"weakness,omitempty"`
+	Priority      float64                `protobuf:"fixed64,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrioritizedOpportunity) Reset() {
+	*x = PrioritizedOpportunity{}
+	mi := &file_competitor_v1_report_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrioritizedOpportunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrioritizedOpportunity) ProtoMessage() {}
+
+func (x *PrioritizedOpportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrioritizedOpportunity.ProtoReflect.Descriptor instead.
+func (*PrioritizedOpportunity) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PrioritizedOpportunity) GetOpportunity() string {
+	if x != nil {
+		return x.Opportunity
+	}
+	return ""
+}
+
+func (x *PrioritizedOpportunity) GetWeakness() string {
+	if x != nil {
+		return x.Weakness
+	}
+	return ""
+}
+
+func (x *PrioritizedOpportunity) GetPriority() float64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type FeatureMatrix struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Features    []string               `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
+	Competitors []string               `protobuf:"bytes,2,rep,name=competitors,proto3" json:"competitors,omitempty"`
+	// support holds one row per feature, one entry per competitor
+	Support       []*FeatureSupportRow `protobuf:"bytes,3,rep,name=support,proto3" json:"support,omitempty"`
+	Gaps          []string             `protobuf:"bytes,4,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureMatrix) Reset() {
+	*x = FeatureMatrix{}
+	mi := &file_competitor_v1_report_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureMatrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureMatrix) ProtoMessage() {}
+
+func (x *FeatureMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureMatrix.ProtoReflect.Descriptor instead.
+func (*FeatureMatrix) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FeatureMatrix) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *FeatureMatrix) GetCompetitors() []string {
+	if x != nil {
+		return x.Competitors
+	}
+	return nil
+}
+
+func (x *FeatureMatrix) GetSupport() []*FeatureSupportRow {
+	if x != nil {
+		return x.Support
+	}
+	return nil
+}
+
+func (x *FeatureMatrix) GetGaps() []string {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+type FeatureSupportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Support       []string               `protobuf:"bytes,1,rep,name=support,proto3" json:"support,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureSupportRow) Reset() {
+	*x = FeatureSupportRow{}
+	mi := &file_competitor_v1_report_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureSupportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureSupportRow) ProtoMessage() {}
+
+func (x *FeatureSupportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureSupportRow.ProtoReflect.Descriptor instead.
+func (*FeatureSupportRow) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FeatureSupportRow) GetSupport() []string {
+	if x != nil {
+		return x.Support
+	}
+	return nil
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Sources       []string               `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
+	Default       bool                   `protobuf:"varint,6,opt,name=default,proto3" json:"default,omitempty"`
+	Rationale     string                 `protobuf:"bytes,7,opt,name=rationale,proto3" json:"rationale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_competitor_v1_report_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Recommendation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Recommendation) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Recommendation) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Recommendation) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Recommendation) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *Recommendation) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+func (x *Recommendation) GetRationale() string {
+	if x != nil {
+		return x.Rationale
+	}
+	return ""
+}
+
+type RecommendationGroup struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Category        string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Recommendations []string               `protobuf:"bytes,2,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecommendationGroup) Reset() {
+	*x = RecommendationGroup{}
+	mi := &file_competitor_v1_report_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationGroup) ProtoMessage() {}
+
+func (x *RecommendationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationGroup.ProtoReflect.Descriptor instead.
+func (*RecommendationGroup) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecommendationGroup) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *RecommendationGroup) GetRecommendations() []string {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type CategoryShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Share         float64                `protobuf:"fixed64,2,opt,name=share,proto3" json:"share,omitempty"`
+	Competitors   int32                  `protobuf:"varint,3,opt,name=competitors,proto3" json:"competitors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryShare) Reset() {
+	*x = CategoryShare{}
+	mi := &file_competitor_v1_report_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryShare) ProtoMessage() {}
+
+func (x *CategoryShare) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryShare.ProtoReflect.Descriptor instead.
+func (*CategoryShare) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CategoryShare) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryShare) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *CategoryShare) GetCompetitors() int32 {
+	if x != nil {
+		return x.Competitors
+	}
+	return 0
+}
+
+type MarketViability struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReconsiderEntry bool                   `protobuf:"varint,1,opt,name=reconsider_entry,json=reconsiderEntry,proto3" json:"reconsider_entry,omitempty"`
+	Hhi             float64                `protobuf:"fixed64,2,opt,name=hhi,proto3" json:"hhi,omitempty"`
+	TopThreat       string                 `protobuf:"bytes,3,opt,name=top_threat,json=topThreat,proto3" json:"top_threat,omitempty"`
+	TopThreatShare  float64                `protobuf:"fixed64,4,opt,name=top_threat_share,json=topThreatShare,proto3" json:"top_threat_share,omitempty"`
+	Advisory        string                 `protobuf:"bytes,5,opt,name=advisory,proto3" json:"advisory,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarketViability) Reset() {
+	*x = MarketViability{}
+	mi := &file_competitor_v1_report_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketViability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketViability) ProtoMessage() {}
+
+func (x *MarketViability) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketViability.ProtoReflect.Descriptor instead.
+func (*MarketViability) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarketViability) GetReconsiderEntry() bool {
+	if x != nil {
+		return x.ReconsiderEntry
+	}
+	return false
+}
+
+func (x *MarketViability) GetHhi() float64 {
+	if x != nil {
+		return x.Hhi
+	}
+	return 0
+}
+
+func (x *MarketViability) GetTopThreat() string {
+	if x != nil {
+		return x.TopThreat
+	}
+	return ""
+}
+
+func (x *MarketViability) GetTopThreatShare() float64 {
+	if x != nil {
+		return x.TopThreatShare
+	}
+	return 0
+}
+
+func (x *MarketViability) GetAdvisory() string {
+	if x != nil {
+		return x.Advisory
+	}
+	return ""
+}
+
+type ReportMeta struct {
+	state              protoimpl.MessageState            `protogen:"open.v1"`
+	SourcesLastUpdated map[string]*timestamppb.Timestamp `protobuf:"bytes,1,rep,name=sources_last_updated,json=sourcesLastUpdated,proto3" json:"sources_last_updated,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReportMeta) Reset() {
+	*x = ReportMeta{}
+	mi := &file_competitor_v1_report_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMeta) ProtoMessage() {}
+
+func (x *ReportMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMeta.ProtoReflect.Descriptor instead.
+func (*ReportMeta) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReportMeta) GetSourcesLastUpdated() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.SourcesLastUpdated
+	}
+	return nil
+}
+
+type SamplingInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	OriginalCount int32                  `protobuf:"varint,2,opt,name=original_count,json=originalCount,proto3" json:"original_count,omitempty"`
+	SampleSize    int32                  `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	Seed          int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SamplingInfo) Reset() {
+	*x = SamplingInfo{}
+	mi := &file_competitor_v1_report_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SamplingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SamplingInfo) ProtoMessage() {}
+
+func (x *SamplingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_report_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SamplingInfo.ProtoReflect.Descriptor instead.
+func (*SamplingInfo) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_report_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SamplingInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SamplingInfo) GetOriginalCount() int32 {
+	if x != nil {
+		return x.OriginalCount
+	}
+	return 0
+}
+
+func (x *SamplingInfo) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *SamplingInfo) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+var File_competitor_v1_report_proto protoreflect.FileDescriptor
+
+const file_competitor_v1_report_proto_rawDesc = "" +
+	"\n" +
+	"\x1acompetitor/v1/report.proto\x12\rcompetitor.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\f\n" +
+	"\x10CompetitorReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\fgenerated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12%\n" +
+	"\x0etarget_company\x18\x03 \x01(\tR\rtargetCompany\x12C\n" +
+	"\vcompetitors\x18\x04 \x03(\v2!.competitor.v1.CompetitorAnalysisR\vcompetitors\x12'\n" +
+	"\x0fmarket_insights\x18\x05 \x01(\tR\x0emarketInsights\x12(\n" +
+	"\x0frecommendations\x18\x06 \x03(\tR\x0frecommendations\x12+\n" +
+	"\x11executive_summary\x18\a \x01(\tR\x10executiveSummary\x12\x18\n" +
+	"\apersona\x18\b \x01(\tR\apersona\x12T\n" +
+	"\x16recommendation_details\x18\t \x03(\v2\x1d.competitor.v1.RecommendationR\x15recommendationDetails\x12'\n" +
+	"\x0fconfidence_note\x18\n" +
+	" \x01(\tR\x0econfidenceNote\x12W\n" +
+	"\x15recommendation_groups\x18\v \x03(\v2\".competitor.v1.RecommendationGroupR\x14recommendationGroups\x12E\n" +
+	"\x0fcategory_shares\x18\f \x03(\v2\x1c.competitor.v1.CategoryShareR\x0ecategoryShares\x12!\n" +
+	"\fothers_share\x18\r \x01(\x01R\vothersShare\x12/\n" +
+	"\x13omitted_competitors\x18\x0e \x01(\x05R\x12omittedCompetitors\x12.\n" +
+	"\x13excluded_by_product\x18\x0f \x01(\x05R\x11excludedByProduct\x12-\n" +
+	"\x04meta\x18\x10 \x01(\v2\x19.competitor.v1.ReportMetaR\x04meta\x12#\n" +
+	"\rskipped_steps\x18\x11 \x03(\tR\fskippedSteps\x127\n" +
+	"\bsampling\x18\x12 \x01(\v2\x1b.competitor.v1.SamplingInfoR\bsampling\x12O\n" +
+	"\n" +
+	"pseudonyms\x18\x13 \x03(\v2/.competitor.v1.CompetitorReport.PseudonymsEntryR\n" +
+	"pseudonyms\x12\x18\n" +
+	"\apartial\x18\x14 \x01(\bR\apartial\x12%\n" +
+	"\x0emissing_stages\x18\x15 \x03(\tR\rmissingStages\x12/\n" +
+	"\x13score_normalization\x18\x16 \x01(\tR\x12scoreNormalization\x12I\n" +
+	"\x10market_viability\x18\x17 \x01(\v2\x1e.competitor.v1.MarketViabilityR\x0fmarketViability\x12Y\n" +
+	"\x0etier_omissions\x18\x18 \x03(\v22.competitor.v1.CompetitorReport.TierOmissionsEntryR\rtierOmissions\x12C\n" +
+	"\x0efeature_matrix\x18\x19 \x01(\v2\x1c.competitor.v1.FeatureMatrixR\rfeatureMatrix\x12\x1e\n" +
+	"\n" +
+	"industries\x18\x1a \x03(\tR\n" +
+	"industries\x12'\n" +
+	"\x0findustry_source\x18\x1b \x01(\tR\x0eindustrySource\x12)\n" +
+	"\x10emerging_players\x18\x1c \x03(\tR\x0femergingPlayers\x1a=\n" +
+	"\x0fPseudonymsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12TierOmissionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xad\x10\n" +
+	"\x12CompetitorAnalysis\x12'\n" +
+	"\x0fcompetitor_name\x18\x01 \x01(\tR\x0ecompetitorName\x12!\n" +
+	"\fthreat_level\x18\x02 \x01(\tR\vthreatLevel\x12!\n" +
+	"\fthreat_score\x18\x03 \x01(\x01R\vthreatScore\x12'\n" +
+	"\x0femerging_threat\x18\x04 \x01(\bR\x0eemergingThreat\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x05R\x04rank\x12^\n" +
+	"\x0fscore_breakdown\x18\x06 \x03(\v25.competitor.v1.CompetitorAnalysis.ScoreBreakdownEntryR\x0escoreBreakdown\x12!\n" +
+	"\fmarket_share\x18\a \x01(\x01R\vmarketShare\x12)\n" +
+	"\x10normalized_share\x18\b \x01(\x01R\x0fnormalizedShare\x12\x18\n" +
+	"\afunding\x18\t \x01(\x01R\afunding\x12\x1a\n" +
+	"\bmomentum\x18\n" +
+	" \x01(\tR\bmomentum\x12 \n" +
+	"\vpositioning\x18\v \x01(\tR\vpositioning\x12/\n" +
+	"\x13key_differentiators\x18\f \x03(\tR\x12keyDifferentiators\x12\x1d\n" +
+	"\n" +
+	"tech_stack\x18\r \x03(\tR\ttechStack\x12\x1e\n" +
+	"\n" +
+	"weaknesses\x18\x0e \x03(\tR\n" +
+	"weaknesses\x12$\n" +
+	"\ropportunities\x18\x0f \x03(\tR\ropportunities\x12\x14\n" +
+	"\x05risks\x18\x10 \x03(\tR\x05risks\x12%\n" +
+	"\x0escreenshot_url\x18\x11 \x01(\tR\rscreenshotUrl\x12C\n" +
+	"\x0ewebsite_status\x18\x12 \x01(\v2\x1c.competitor.v1.WebsiteStatusR\rwebsiteStatus\x12\"\n" +
+	"\fcompleteness\x18\x13 \x01(\x01R\fcompleteness\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x14 \x01(\x01R\n" +
+	"confidence\x12\x1e\n" +
+	"\n" +
+	"industries\x18\x15 \x03(\tR\n" +
+	"industries\x125\n" +
+	"\n" +
+	"leadership\x18\x16 \x03(\v2\x15.competitor.v1.PersonR\n" +
+	"leadership\x12\x1f\n" +
+	"\vaction_plan\x18\x17 \x03(\tR\n" +
+	"actionPlan\x12\x14\n" +
+	"\x05notes\x18\x18 \x03(\tR\x05notes\x121\n" +
+	"\x14positioning_inferred\x18\x19 \x01(\bR\x13positioningInferred\x12+\n" +
+	"\x11share_uncertainty\x18\x1a \x01(\x01R\x10shareUncertainty\x12A\n" +
+	"\rrelationships\x18\x1b \x03(\v2\x1b.competitor.v1.RelationshipR\rrelationships\x12%\n" +
+	"\x0evalue_position\x18\x1c \x01(\tR\rvaluePosition\x12\x1a\n" +
+	"\bcategory\x18\x1d \x01(\tR\bcategory\x12\x1c\n" +
+	"\tcustomers\x18\x1e \x01(\x03R\tcustomers\x128\n" +
+	"\vrecent_news\x18\x1f \x03(\v2\x17.competitor.v1.NewsItemR\n" +
+	"recentNews\x12'\n" +
+	"\x0fbenchmark_notes\x18  \x03(\tR\x0ebenchmarkNotes\x12#\n" +
+	"\rdata_warnings\x18! \x03(\tR\fdataWarnings\x12 \n" +
+	"\vhighlighted\x18\" \x01(\bR\vhighlighted\x12#\n" +
+	"\rfocus_summary\x18# \x01(\tR\ffocusSummary\x12\\\n" +
+	"\x16opportunity_priorities\x18$ \x03(\v2%.competitor.v1.PrioritizedOpportunityR\x15opportunityPriorities\x12;\n" +
+	"\x17normalized_threat_score\x18% \x01(\x01H\x00R\x15normalizedThreatScore\x88\x01\x01\x12^\n" +
+	"\x0ffeature_support\x18& \x03(\v25.competitor.v1.CompetitorAnalysis.FeatureSupportEntryR\x0efeatureSupport\x12Q\n" +
+	"\n" +
+	"provenance\x18' \x03(\v21.competitor.v1.CompetitorAnalysis.ProvenanceEntryR\n" +
+	"provenance\x12!\n" +
+	"\fpricing_tier\x18( \x01(\tR\vpricingTier\x12'\n" +
+	"\x0femerging_player\x18) \x01(\bR\x0eemergingPlayer\x12-\n" +
+	"\x12staleness_discount\x18* \x01(\x01R\x11stalenessDiscount\x1aA\n" +
+	"\x13ScoreBreakdownEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1aA\n" +
+	"\x13FeatureSupportEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fProvenanceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x1a\n" +
+	"\x18_normalized_threat_score\"\x9f\x01\n" +
+	"\rWebsiteStatus\x12\x1c\n" +
+	"\treachable\x18\x01 \x01(\bR\treachable\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"[\n" +
+	"\x06Person\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12)\n" +
+	"\x10years_experience\x18\x03 \x01(\x05R\x0fyearsExperience\":\n" +
+	"\fRelationship\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x91\x01\n" +
+	"\bNewsItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12=\n" +
+	"\fpublished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1c\n" +
+	"\tsentiment\x18\x04 \x01(\tR\tsentiment\"r\n" +
+	"\x16PrioritizedOpportunity\x12 \n" +
+	"\vopportunity\x18\x01 \x01(\tR\vopportunity\x12\x1a\n" +
+	"\bweakness\x18\x02 \x01(\tR\bweakness\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x01R\bpriority\"\x9d\x01\n" +
+	"\rFeatureMatrix\x12\x1a\n" +
+	"\bfeatures\x18\x01 \x03(\tR\bfeatures\x12 \n" +
+	"\vcompetitors\x18\x02 \x03(\tR\vcompetitors\x12:\n" +
+	"\asupport\x18\x03 \x03(\v2 .competitor.v1.FeatureSupportRowR\asupport\x12\x12\n" +
+	"\x04gaps\x18\x04 \x03(\tR\x04gaps\"-\n" +
+	"\x11FeatureSupportRow\x12\x18\n" +
+	"\asupport\x18\x01 \x03(\tR\asupport\"\xce\x01\n" +
+	"\x0eRecommendation\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\x12\x18\n" +
+	"\asources\x18\x05 \x03(\tR\asources\x12\x18\n" +
+	"\adefault\x18\x06 \x01(\bR\adefault\x12\x1c\n" +
+	"\trationale\x18\a \x01(\tR\trationale\"[\n" +
+	"\x13RecommendationGroup\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12(\n" +
+	"\x0frecommendations\x18\x02 \x03(\tR\x0frecommendations\"c\n" +
+	"\rCategoryShare\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05share\x18\x02 \x01(\x01R\x05share\x12 \n" +
+	"\vcompetitors\x18\x03 \x01(\x05R\vcompetitors\"\xb3\x01\n" +
+	"\x0fMarketViability\x12)\n" +
+	"\x10reconsider_entry\x18\x01 \x01(\bR\x0freconsiderEntry\x12\x10\n" +
+	"\x03hhi\x18\x02 \x01(\x01R\x03hhi\x12\x1d\n" +
+	"\n" +
+	"top_threat\x18\x03 \x01(\tR\ttopThreat\x12(\n" +
+	"\x10top_threat_share\x18\x04 \x01(\x01R\x0etopThreatShare\x12\x1a\n" +
+	"\badvisory\x18\x05 \x01(\tR\badvisory\"\xd4\x01\n" +
+	"\n" +
+	"ReportMeta\x12c\n" +
+	"\x14sources_last_updated\x18\x01 \x03(\v21.competitor.v1.ReportMeta.SourcesLastUpdatedEntryR\x12sourcesLastUpdated\x1aa\n" +
+	"\x17SourcesLastUpdatedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05value:\x028\x01\"\x82\x01\n" +
+	"\fSamplingInfo\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12%\n" +
+	"\x0eoriginal_count\x18\x02 \x01(\x05R\roriginalCount\x12\x1f\n" +
+	"\vsample_size\x18\x03 \x01(\x05R\n" +
+	"sampleSize\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seedBGZEgithub.com/mk-knight23/ai-sdk-openai/proto/competitor/v1;competitorv1b\x06proto3"
+
+var (
+	file_competitor_v1_report_proto_rawDescOnce sync.Once
+	file_competitor_v1_report_proto_rawDescData []byte
+)
+
+func file_competitor_v1_report_proto_rawDescGZIP() []byte {
+	file_competitor_v1_report_proto_rawDescOnce.Do(func() {
+		file_competitor_v1_report_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_competitor_v1_report_proto_rawDesc), len(file_competitor_v1_report_proto_rawDesc)))
+	})
+	return file_competitor_v1_report_proto_rawDescData
+}
+
+var file_competitor_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_competitor_v1_report_proto_goTypes = []any{
+	(*CompetitorReport)(nil),       // 0: competitor.v1.CompetitorReport
+	(*CompetitorAnalysis)(nil),     // 1: competitor.v1.CompetitorAnalysis
+	(*WebsiteStatus)(nil),          // 2: competitor.v1.WebsiteStatus
+	(*Person)(nil),                 // 3: competitor.v1.Person
+	(*Relationship)(nil),           // 4: competitor.v1.Relationship
+	(*NewsItem)(nil),               // 5: competitor.v1.NewsItem
+	(*PrioritizedOpportunity)(nil), // 6: competitor.v1.PrioritizedOpportunity
+	(*FeatureMatrix)(nil),          // 7: competitor.v1.FeatureMatrix
+	(*FeatureSupportRow)(nil),      // 8: competitor.v1.FeatureSupportRow
+	(*Recommendation)(nil),         // 9: competitor.v1.Recommendation
+	(*RecommendationGroup)(nil),    // 10: competitor.v1.RecommendationGroup
+	(*CategoryShare)(nil),          // 11: competitor.v1.CategoryShare
+	(*MarketViability)(nil),        // 12: competitor.v1.MarketViability
+	(*ReportMeta)(nil),             // 13: competitor.v1.ReportMeta
+	(*SamplingInfo)(nil),           // 14: competitor.v1.SamplingInfo
+	nil,                            // 15: competitor.v1.CompetitorReport.PseudonymsEntry
+	nil,                            // 16: competitor.v1.CompetitorReport.TierOmissionsEntry
+	nil,                            // 17: competitor.v1.CompetitorAnalysis.ScoreBreakdownEntry
+	nil,                            // 18: competitor.v1.CompetitorAnalysis.FeatureSupportEntry
+	nil,                            // 19: competitor.v1.CompetitorAnalysis.ProvenanceEntry
+	nil,                            // 20: competitor.v1.ReportMeta.SourcesLastUpdatedEntry
+	(*timestamppb.Timestamp)(nil),  // 21: google.protobuf.Timestamp
+}
+var file_competitor_v1_report_proto_depIdxs = []int32{
+	21, // 0: competitor.v1.CompetitorReport.generated_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: competitor.v1.CompetitorReport.competitors:type_name -> competitor.v1.CompetitorAnalysis
+	9,  // 2: competitor.v1.CompetitorReport.recommendation_details:type_name -> competitor.v1.Recommendation
+	10, // 3: competitor.v1.CompetitorReport.recommendation_groups:type_name -> competitor.v1.RecommendationGroup
+	11, // 4: competitor.v1.CompetitorReport.category_shares:type_name -> competitor.v1.CategoryShare
+	13, // 5: competitor.v1.CompetitorReport.meta:type_name -> competitor.v1.ReportMeta
+	14, // 6: competitor.v1.CompetitorReport.sampling:type_name -> competitor.v1.SamplingInfo
+	15, // 7: competitor.v1.CompetitorReport.pseudonyms:type_name -> competitor.v1.CompetitorReport.PseudonymsEntry
+	12, // 8: competitor.v1.CompetitorReport.market_viability:type_name -> competitor.v1.MarketViability
+	16, // 9: competitor.v1.CompetitorReport.tier_omissions:type_name -> competitor.v1.CompetitorReport.TierOmissionsEntry
+	7,  // 10: competitor.v1.CompetitorReport.feature_matrix:type_name -> competitor.v1.FeatureMatrix
+	17, // 11: competitor.v1.CompetitorAnalysis.score_breakdown:type_name -> competitor.v1.CompetitorAnalysis.ScoreBreakdownEntry
+	2,  // 12: competitor.v1.CompetitorAnalysis.website_status:type_name -> competitor.v1.WebsiteStatus
+	3,  // 13: competitor.v1.CompetitorAnalysis.leadership:type_name -> competitor.v1.Person
+	4,  // 14: competitor.v1.CompetitorAnalysis.relationships:type_name -> competitor.v1.Relationship
+	5,  // 15: competitor.v1.CompetitorAnalysis.recent_news:type_name -> competitor.v1.NewsItem
+	6,  // 16: competitor.v1.CompetitorAnalysis.opportunity_priorities:type_name -> competitor.v1.PrioritizedOpportunity
+	18, // 17: competitor.v1.CompetitorAnalysis.feature_support:type_name -> competitor.v1.CompetitorAnalysis.FeatureSupportEntry
+	19, // 18: competitor.v1.CompetitorAnalysis.provenance:type_name -> competitor.v1.CompetitorAnalysis.ProvenanceEntry
+	21, // 19: competitor.v1.WebsiteStatus.checked_at:type_name -> google.protobuf.Timestamp
+	21, // 20: competitor.v1.NewsItem.published_at:type_name -> google.protobuf.Timestamp
+	8,  // 21: competitor.v1.FeatureMatrix.support:type_name -> competitor.v1.FeatureSupportRow
+	20, // 22: competitor.v1.ReportMeta.sources_last_updated:type_name -> competitor.v1.ReportMeta.SourcesLastUpdatedEntry
+	21, // 23: competitor.v1.ReportMeta.SourcesLastUpdatedEntry.value:type_name -> google.protobuf.Timestamp
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_competitor_v1_report_proto_init() }
+func file_competitor_v1_report_proto_init() {
+	if File_competitor_v1_report_proto != nil {
+		return
+	}
+	file_competitor_v1_report_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_competitor_v1_report_proto_rawDesc), len(file_competitor_v1_report_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_competitor_v1_report_proto_goTypes,
+		DependencyIndexes: file_competitor_v1_report_proto_depIdxs,
+		MessageInfos:      file_competitor_v1_report_proto_msgTypes,
+	}.Build()
+	File_competitor_v1_report_proto = out.File
+	file_competitor_v1_report_proto_goTypes = nil
+	file_competitor_v1_report_proto_depIdxs = nil
+}
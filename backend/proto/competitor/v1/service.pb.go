@@ -0,0 +1,266 @@
+// gRPC access to the competitor intelligence agent, mirroring the REST
+// analyze endpoint. Regenerate with `buf generate` from the backend directory.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: competitor/v1/service.proto
+
+package competitorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AnalyzeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyName   string                 `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Industry      string                 `protobuf:"bytes,2,opt,name=industry,proto3" json:"industry,omitempty"`
+	Industries    []string               `protobuf:"bytes,3,rep,name=industries,proto3" json:"industries,omitempty"`
+	Persona       string                 `protobuf:"bytes,4,opt,name=persona,proto3" json:"persona,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeRequest) Reset() {
+	*x = AnalyzeRequest{}
+	mi := &file_competitor_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRequest) ProtoMessage() {}
+
+func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalyzeRequest) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *AnalyzeRequest) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *AnalyzeRequest) GetIndustries() []string {
+	if x != nil {
+		return x.Industries
+	}
+	return nil
+}
+
+func (x *AnalyzeRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *AnalyzeRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type AnalyzeEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*AnalyzeEvent_Stage
+	//	*AnalyzeEvent_Report
+	Event         isAnalyzeEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeEvent) Reset() {
+	*x = AnalyzeEvent{}
+	mi := &file_competitor_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeEvent) ProtoMessage() {}
+
+func (x *AnalyzeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_competitor_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeEvent.ProtoReflect.Descriptor instead.
+func (*AnalyzeEvent) Descriptor() ([]byte, []int) {
+	return file_competitor_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnalyzeEvent) GetEvent() isAnalyzeEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *AnalyzeEvent) GetStage() string {
+	if x != nil {
+		if x, ok := x.Event.(*AnalyzeEvent_Stage); ok {
+			return x.Stage
+		}
+	}
+	return ""
+}
+
+func (x *AnalyzeEvent) GetReport() *CompetitorReport {
+	if x != nil {
+		if x, ok := x.Event.(*AnalyzeEvent_Report); ok {
+			return x.Report
+		}
+	}
+	return nil
+}
+
+type isAnalyzeEvent_Event interface {
+	isAnalyzeEvent_Event()
+}
+
+type AnalyzeEvent_Stage struct {
+	// stage names a pipeline stage that just started: research, analysis,
+	// report or persistence
+	Stage string `protobuf:"bytes,1,opt,name=stage,proto3,oneof"`
+}
+
+type AnalyzeEvent_Report struct {
+	Report *CompetitorReport `protobuf:"bytes,2,opt,name=report,proto3,oneof"`
+}
+
+func (*AnalyzeEvent_Stage) isAnalyzeEvent_Event() {}
+
+func (*AnalyzeEvent_Report) isAnalyzeEvent_Event() {}
+
+var File_competitor_v1_service_proto protoreflect.FileDescriptor
+
+const file_competitor_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcompetitor/v1/service.proto\x12\rcompetitor.v1\x1a\x1acompetitor/v1/report.proto\"\xa4\x01\n" +
+	"\x0eAnalyzeRequest\x12!\n" +
+	"\fcompany_name\x18\x01 \x01(\tR\vcompanyName\x12\x1a\n" +
+	"\bindustry\x18\x02 \x01(\tR\bindustry\x12\x1e\n" +
+	"\n" +
+	"industries\x18\x03 \x03(\tR\n" +
+	"industries\x12\x18\n" +
+	"\apersona\x18\x04 \x01(\tR\apersona\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"j\n" +
+	"\fAnalyzeEvent\x12\x16\n" +
+	"\x05stage\x18\x01 \x01(\tH\x00R\x05stage\x129\n" +
+	"\x06report\x18\x02 \x01(\v2\x1f.competitor.v1.CompetitorReportH\x00R\x06reportB\a\n" +
+	"\x05event2\xb9\x01\n" +
+	"\x1dCompetitorIntelligenceService\x12I\n" +
+	"\aAnalyze\x12\x1d.competitor.v1.AnalyzeRequest\x1a\x1f.competitor.v1.CompetitorReport\x12M\n" +
+	"\rAnalyzeStream\x12\x1d.competitor.v1.AnalyzeRequest\x1a\x1b.competitor.v1.AnalyzeEvent0\x01BGZEgithub.com/mk-knight23/ai-sdk-openai/proto/competitor/v1;competitorv1b\x06proto3"
+
+var (
+	file_competitor_v1_service_proto_rawDescOnce sync.Once
+	file_competitor_v1_service_proto_rawDescData []byte
+)
+
+func file_competitor_v1_service_proto_rawDescGZIP() []byte {
+	file_competitor_v1_service_proto_rawDescOnce.Do(func() {
+		file_competitor_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_competitor_v1_service_proto_rawDesc), len(file_competitor_v1_service_proto_rawDesc)))
+	})
+	return file_competitor_v1_service_proto_rawDescData
+}
+
+var file_competitor_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_competitor_v1_service_proto_goTypes = []any{
+	(*AnalyzeRequest)(nil),   // 0: competitor.v1.AnalyzeRequest
+	(*AnalyzeEvent)(nil),     // 1: competitor.v1.AnalyzeEvent
+	(*CompetitorReport)(nil), // 2: competitor.v1.CompetitorReport
+}
+var file_competitor_v1_service_proto_depIdxs = []int32{
+	2, // 0: competitor.v1.AnalyzeEvent.report:type_name -> competitor.v1.CompetitorReport
+	0, // 1: competitor.v1.CompetitorIntelligenceService.Analyze:input_type -> competitor.v1.AnalyzeRequest
+	0, // 2: competitor.v1.CompetitorIntelligenceService.AnalyzeStream:input_type -> competitor.v1.AnalyzeRequest
+	2, // 3: competitor.v1.CompetitorIntelligenceService.Analyze:output_type -> competitor.v1.CompetitorReport
+	1, // 4: competitor.v1.CompetitorIntelligenceService.AnalyzeStream:output_type -> competitor.v1.AnalyzeEvent
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_competitor_v1_service_proto_init() }
+func file_competitor_v1_service_proto_init() {
+	if File_competitor_v1_service_proto != nil {
+		return
+	}
+	file_competitor_v1_report_proto_init()
+	file_competitor_v1_service_proto_msgTypes[1].OneofWrappers = []any{
+		(*AnalyzeEvent_Stage)(nil),
+		(*AnalyzeEvent_Report)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_competitor_v1_service_proto_rawDesc), len(file_competitor_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_competitor_v1_service_proto_goTypes,
+		DependencyIndexes: file_competitor_v1_service_proto_depIdxs,
+		MessageInfos:      file_competitor_v1_service_proto_msgTypes,
+	}.Build()
+	File_competitor_v1_service_proto = out.File
+	file_competitor_v1_service_proto_goTypes = nil
+	file_competitor_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// gRPC access to the competitor intelligence agent, mirroring the REST
+// analyze endpoint. Regenerate with `buf generate` from the backend directory.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: competitor/v1/service.proto
+
+package competitorv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CompetitorIntelligenceService_Analyze_FullMethodName       = "/competitor.v1.CompetitorIntelligenceService/Analyze"
+	CompetitorIntelligenceService_AnalyzeStream_FullMethodName = "/competitor.v1.CompetitorIntelligenceService/AnalyzeStream"
+)
+
+// CompetitorIntelligenceServiceClient is the client API for CompetitorIntelligenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CompetitorIntelligenceServiceClient interface {
+	// Analyze runs the full pipeline and returns the finished report
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*CompetitorReport, error)
+	// AnalyzeStream reports each pipeline stage as it starts, then the report
+	AnalyzeStream(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AnalyzeEvent], error)
+}
+
+type competitorIntelligenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompetitorIntelligenceServiceClient(cc grpc.ClientConnInterface) CompetitorIntelligenceServiceClient {
+	return &competitorIntelligenceServiceClient{cc}
+}
+
+func (c *competitorIntelligenceServiceClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*CompetitorReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompetitorReport)
+	err := c.cc.Invoke(ctx, CompetitorIntelligenceService_Analyze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *competitorIntelligenceServiceClient) AnalyzeStream(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AnalyzeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CompetitorIntelligenceService_ServiceDesc.Streams[0], CompetitorIntelligenceService_AnalyzeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AnalyzeRequest, AnalyzeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CompetitorIntelligenceService_AnalyzeStreamClient = grpc.ServerStreamingClient[AnalyzeEvent]
+
+// CompetitorIntelligenceServiceServer is the server API for CompetitorIntelligenceService service.
+// All implementations must embed UnimplementedCompetitorIntelligenceServiceServer
+// for forward compatibility.
+type CompetitorIntelligenceServiceServer interface {
+	// Analyze runs the full pipeline and returns the finished report
+	Analyze(context.Context, *AnalyzeRequest) (*CompetitorReport, error)
+	// AnalyzeStream reports each pipeline stage as it starts, then the report
+	AnalyzeStream(*AnalyzeRequest, grpc.ServerStreamingServer[AnalyzeEvent]) error
+	mustEmbedUnimplementedCompetitorIntelligenceServiceServer()
+}
+
+// UnimplementedCompetitorIntelligenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCompetitorIntelligenceServiceServer struct{}
+
+func (UnimplementedCompetitorIntelligenceServiceServer) Analyze(context.Context, *AnalyzeRequest) (*CompetitorReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedCompetitorIntelligenceServiceServer) AnalyzeStream(*AnalyzeRequest, grpc.ServerStreamingServer[AnalyzeEvent]) error {
+	return status.Error(codes.Unimplemented, "method AnalyzeStream not implemented")
+}
+func (UnimplementedCompetitorIntelligenceServiceServer) mustEmbedUnimplementedCompetitorIntelligenceServiceServer() {
+}
+func (UnimplementedCompetitorIntelligenceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCompetitorIntelligenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompetitorIntelligenceServiceServer will
+// result in compilation errors.
+type UnsafeCompetitorIntelligenceServiceServer interface {
+	mustEmbedUnimplementedCompetitorIntelligenceServiceServer()
+}
+
+func RegisterCompetitorIntelligenceServiceServer(s grpc.ServiceRegistrar, srv CompetitorIntelligenceServiceServer) {
+	// If the following call panics, it indicates UnimplementedCompetitorIntelligenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CompetitorIntelligenceService_ServiceDesc, srv)
+}
+
+func _CompetitorIntelligenceService_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompetitorIntelligenceServiceServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompetitorIntelligenceService_Analyze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompetitorIntelligenceServiceServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompetitorIntelligenceService_AnalyzeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AnalyzeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CompetitorIntelligenceServiceServer).AnalyzeStream(m, &grpc.GenericServerStream[AnalyzeRequest, AnalyzeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CompetitorIntelligenceService_AnalyzeStreamServer = grpc.ServerStreamingServer[AnalyzeEvent]
+
+// CompetitorIntelligenceService_ServiceDesc is the grpc.ServiceDesc for CompetitorIntelligenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompetitorIntelligenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "competitor.v1.CompetitorIntelligenceService",
+	HandlerType: (*CompetitorIntelligenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Analyze",
+			Handler:    _CompetitorIntelligenceService_Analyze_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AnalyzeStream",
+			Handler:       _CompetitorIntelligenceService_AnalyzeStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "competitor/v1/service.proto",
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// threatLevels lists the accepted threat level filter values
+var threatLevels = map[string]bool{
+	"high":   true,
+	"medium": true,
+	"low":    true,
+}
+
+// requireStore rejects report routes when persistence is not configured
+func (s *server) requireStore(c *fiber.Ctx) error {
+	if s.agent.Store() == nil {
+		return sendError(c, fiber.StatusNotImplemented, adk.MsgStorageNotConfigured)
+	}
+	return c.Next()
+}
+
+// searchReports finds stored reports by competitor name and threat level
+func (s *server) searchReports(c *fiber.Ctx) error {
+	query := adk.ReportQuery{
+		CompanyName:    c.Query("company"),
+		CompetitorName: c.Query("competitor"),
+		ThreatLevel:    c.Query("threat_level"),
+		Page:           c.QueryInt("page", 1),
+		PageSize:       c.QueryInt("page_size", adk.DefaultSearchPageSize),
+	}
+
+	if query.ThreatLevel != "" && !threatLevels[strings.ToLower(query.ThreatLevel)] {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgInvalidThreatLevel)
+	}
+
+	result, err := adk.SearchReports(c.Context(), s.agent.Store(), query)
+	if err != nil {
+		return sendError(c, fiber.StatusInternalServerError, adk.MsgInternalError, err.Error())
+	}
+
+	return c.JSON(result)
+}
+
+// rankingChanges returns the rank movement feed for a company's stored reports
+func (s *server) rankingChanges(c *fiber.Ctx) error {
+	company := c.Query("company")
+	if company == "" {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgCompanyRequired)
+	}
+
+	history, err := s.agent.Store().List(c.Context(), company)
+	if err != nil {
+		return sendError(c, fiber.StatusInternalServerError, adk.MsgInternalError, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"company": company,
+		"reports": len(history),
+		"changes": adk.MaterialRankingChanges(history, s.agent.Config.MinShareChange),
+	})
+}
+
+// threatTrends returns per-competitor threat score series across a
+// company's most recent stored runs, for rendering sparklines
+func (s *server) threatTrends(c *fiber.Ctx) error {
+	company := c.Query("company")
+	if company == "" {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgCompanyRequired)
+	}
+
+	history, err := s.agent.Store().List(c.Context(), company)
+	if err != nil {
+		return sendError(c, fiber.StatusInternalServerError, adk.MsgInternalError, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"company": company,
+		"trends":  adk.ThreatTrends(history, c.QueryInt("limit")),
+	})
+}
+
+// diffReports lists material competitor changes between two stored reports
+func (s *server) diffReports(c *fiber.Ctx) error {
+	fromID, toID := c.Query("from"), c.Query("to")
+	if fromID == "" || toID == "" {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgDiffParamsRequired)
+	}
+
+	store := s.agent.Store()
+	from, err := store.Get(c.Context(), fromID)
+	if err != nil {
+		return reportError(c, err)
+	}
+	to, err := store.Get(c.Context(), toID)
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"from":    fromID,
+		"to":      toID,
+		"changes": adk.DiffReports(from, to, s.agent.Config.MinShareChange),
+	})
+}
+
+// compareReports returns the structured differences between two stored
+// reports, flagging reports for different companies
+func (s *server) compareReports(c *fiber.Ctx) error {
+	aID, bID := c.Query("a"), c.Query("b")
+	if aID == "" || bID == "" {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgCompareParamsRequired)
+	}
+
+	store := s.agent.Store()
+	a, err := store.Get(c.Context(), aID)
+	if err != nil {
+		return reportError(c, err)
+	}
+	b, err := store.Get(c.Context(), bID)
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	return c.JSON(adk.CompareReports(a, b, s.agent.Config.MinShareChange))
+}
+
+// replayReport re-runs analysis on a stored report's inputs and reports
+// whether the original analyses were reproduced. The replayed report is
+// redacted for the output profile and anonymized on request, as for
+// /api/analyze.
+func (s *server) replayReport(c *fiber.Ctx) error {
+	profile, err := s.outputProfile(c)
+	if err != nil {
+		return sendProfileError(c, err)
+	}
+
+	id := c.Params("id")
+	original, err := s.agent.Store().Get(c.Context(), id)
+	if err != nil {
+		return reportError(c, err)
+	}
+
+	replay, err := s.agent.Replay(c.Context(), id)
+	if err != nil {
+		return reportError(c, err)
+	}
+	reproduced := adk.ReplayMatches(original, replay)
+
+	if c.QueryBool("anonymize") {
+		replay = anonymize(c.UserContext(), replay)
+	}
+	redacted, err := s.agent.Redacted(replay, profile)
+	if err != nil {
+		return sendProfileError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"report_id":  id,
+		"reproduced": reproduced,
+		"report":     redacted,
+	})
+}
+
+// reportError maps stored report lookup and replay failures onto HTTP statuses
+func reportError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, adk.ErrReportNotFound):
+		return sendError(c, fiber.StatusNotFound, adk.MsgReportNotFound)
+	case errors.Is(err, adk.ErrNotReplayable):
+		return sendError(c, fiber.StatusConflict, adk.MsgReportNotReplayable)
+	}
+	return sendError(c, fiber.StatusInternalServerError, adk.MsgInternalError, err.Error())
+}
@@ -0,0 +1,504 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// setupStoreApp creates a test app backed by an in-memory report store
+func setupStoreApp(t *testing.T, reports ...*adk.CompetitorReport) (*fiber.App, adk.ReportStore) {
+	t.Helper()
+
+	store := adk.NewMemoryReportStore()
+	for _, report := range reports {
+		if err := store.Save(context.Background(), report); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store))
+	return newServer(agent, serverConfig{}).routes(), store
+}
+
+// TestSearchReportsEndpoint tests searching stored reports over HTTP
+func TestSearchReportsEndpoint(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	app, _ := setupStoreApp(t,
+		&adk.CompetitorReport{GeneratedAt: base, TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Competitor X", ThreatLevel: "High"},
+		}},
+		&adk.CompetitorReport{GeneratedAt: base.Add(time.Hour), TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Competitor X", ThreatLevel: "Low"},
+		}},
+		&adk.CompetitorReport{GeneratedAt: base.Add(2 * time.Hour), TargetCompany: "OtherCorp", Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Competitor X", ThreatLevel: "High"},
+			{CompetitorName: "Competitor Z", ThreatLevel: "High"},
+		}},
+	)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/search?competitor=Competitor%20X&threat_level=High&page_size=1", nil))
+	if err != nil {
+		t.Fatalf("Failed to test search endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var result adk.ReportSearchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if result.Total != 2 {
+		t.Errorf("Expected 2 matching reports, got %d", result.Total)
+	}
+
+	if len(result.Results) != 1 || result.Results[0].TargetCompany != "OtherCorp" {
+		t.Errorf("Expected newest match on the first page, got %+v", result.Results)
+	}
+
+	for _, match := range result.Results[0].Matches {
+		if match.CompetitorName != "Competitor X" {
+			t.Errorf("Unexpected match %s", match.CompetitorName)
+		}
+	}
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/search?page=9223372036854775807", nil))
+	if err != nil {
+		t.Fatalf("Failed to test search endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 for a page past the end, got %d", resp.StatusCode)
+	}
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/search?threat_level=Extreme", nil))
+	if err != nil {
+		t.Fatalf("Failed to test search endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for unknown threat level, got %d", resp.StatusCode)
+	}
+}
+
+// TestSearchReportsEndpoint_NoStore tests the response when persistence is off
+func TestSearchReportsEndpoint_NoStore(t *testing.T) {
+	app := setupTestApp()
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/search", nil))
+	if err != nil {
+		t.Fatalf("Failed to test search endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("Expected status 501 without a store, got %d", resp.StatusCode)
+	}
+}
+
+// TestRankingChangesEndpoint tests the rank movement feed over HTTP
+func TestRankingChangesEndpoint(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	app, _ := setupStoreApp(t,
+		&adk.CompetitorReport{GeneratedAt: base, TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Competitor A", Rank: 1},
+			{CompetitorName: "Competitor B", Rank: 2},
+		}},
+		&adk.CompetitorReport{GeneratedAt: base.Add(time.Hour), TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Competitor A", Rank: 2},
+			{CompetitorName: "Competitor B", Rank: 1},
+		}},
+	)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/ranking-changes?company=TestCorp", nil))
+	if err != nil {
+		t.Fatalf("Failed to test ranking changes endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var result struct {
+		Reports int              `json:"reports"`
+		Changes []adk.RankChange `json:"changes"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if result.Reports != 2 || len(result.Changes) != 2 {
+		t.Fatalf("Expected 2 changes across 2 reports, got %+v", result)
+	}
+
+	for _, change := range result.Changes {
+		if change.CompetitorName == "Competitor B" && (change.FromRank != 2 || change.ToRank != 1 || change.Delta != 1) {
+			t.Errorf("Unexpected change for Competitor B: %+v", change)
+		}
+	}
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/ranking-changes", nil))
+	if err != nil {
+		t.Fatalf("Failed to test ranking changes endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400 without company, got %d", resp.StatusCode)
+	}
+}
+
+// TestThreatTrendsEndpoint tests sparkline series built from stored reports
+func TestThreatTrendsEndpoint(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var reports []*adk.CompetitorReport
+	for i, score := range []float64{10, 20, 30, 40} {
+		reports = append(reports, &adk.CompetitorReport{GeneratedAt: base.Add(time.Duration(i) * time.Hour), TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Competitor A", ThreatScore: score},
+		}})
+	}
+	app, _ := setupStoreApp(t, reports...)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/threat-trends?company=TestCorp&limit=3", nil))
+	if err != nil {
+		t.Fatalf("Failed to test threat trends endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var result struct {
+		Trends []adk.ThreatTrend `json:"trends"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if len(result.Trends) != 1 || len(result.Trends[0].Points) != 3 {
+		t.Fatalf("Expected one series capped to 3 points, got %+v", result.Trends)
+	}
+	for i, point := range result.Trends[0].Points {
+		if want := float64(i+2) * 10; point.Score != want {
+			t.Errorf("Point %d: expected score %v, got %v", i, want, point.Score)
+		}
+	}
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/threat-trends", nil))
+	if err != nil {
+		t.Fatalf("Failed to test threat trends endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400 without company, got %d", resp.StatusCode)
+	}
+}
+
+// TestReplayReportEndpoint tests replaying a stored report over HTTP
+func TestReplayReportEndpoint(t *testing.T) {
+	app, store := setupStoreApp(t)
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store))
+	original, err := agent.Run(context.Background(), "TestCorp", "Technology")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/"+original.ID+"/replay", nil))
+	if err != nil {
+		t.Fatalf("Failed to test replay endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var result struct {
+		ReportID   string                `json:"report_id"`
+		Reproduced bool                  `json:"reproduced"`
+		Report     *adk.CompetitorReport `json:"report"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if result.ReportID != original.ID || !result.Reproduced {
+		t.Errorf("Expected report %s to be reproduced, got %+v", original.ID, result)
+	}
+	if result.Report == nil || len(result.Report.Competitors) != len(original.Competitors) {
+		t.Fatalf("Expected %d replayed competitors, got %+v", len(original.Competitors), result.Report)
+	}
+	for i, analysis := range result.Report.Competitors {
+		if analysis.CompetitorName != original.Competitors[i].CompetitorName || analysis.ThreatScore != original.Competitors[i].ThreatScore {
+			t.Errorf("Expected %+v, got %+v", original.Competitors[i], analysis)
+		}
+	}
+}
+
+// TestReplayReportEndpoint_Redacted tests that replays are redacted and
+// anonymized like fresh analyses
+func TestReplayReportEndpoint_Redacted(t *testing.T) {
+	store := adk.NewMemoryReportStore()
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store), adk.WithOutputProfile(adk.ProfilePublic))
+	app := newServer(agent, serverConfig{}).routes()
+	original, err := agent.Run(context.Background(), "TestCorp", "Technology")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantNames  bool
+	}{
+		{name: "Default profile", wantStatus: http.StatusOK, wantNames: true},
+		{name: "Anonymized", query: "?anonymize=true", wantStatus: http.StatusOK},
+		{name: "Less restrictive profile", query: "?profile=internal", wantStatus: http.StatusForbidden},
+		{name: "Unknown profile", query: "?profile=partner", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/"+original.ID+"/replay"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("Failed to test replay endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if strings.Contains(string(body), `"funding"`) {
+				t.Errorf("Expected funding redacted, got %s", body)
+			}
+			if strings.Contains(string(body), original.Competitors[0].CompetitorName) != tt.wantNames {
+				t.Errorf("Expected real names present = %v, got %s", tt.wantNames, body)
+			}
+		})
+	}
+}
+
+// TestReplayReportEndpoint_Errors tests replay status codes
+func TestReplayReportEndpoint_Errors(t *testing.T) {
+	legacy := &adk.CompetitorReport{TargetCompany: "TestCorp"}
+	app, _ := setupStoreApp(t, legacy)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{name: "Unknown report", id: "missing", wantStatus: http.StatusNotFound},
+		{name: "No stored inputs", id: legacy.ID, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/"+tt.id+"/replay", nil))
+			if err != nil {
+				t.Fatalf("Failed to test replay endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+// TestDiffReportsEndpoint tests that the configured threshold filters diffs
+func TestDiffReportsEndpoint(t *testing.T) {
+	store := adk.NewMemoryReportStore()
+	from := &adk.CompetitorReport{TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+		{CompetitorName: "Steady", MarketShare: 10, ThreatLevel: "Medium"},
+		{CompetitorName: "Surging", MarketShare: 12, ThreatLevel: "Medium"},
+	}}
+	to := &adk.CompetitorReport{TargetCompany: "TestCorp", Competitors: []adk.CompetitorAnalysis{
+		{CompetitorName: "Steady", MarketShare: 12, ThreatLevel: "Medium"},
+		{CompetitorName: "Surging", MarketShare: 22, ThreatLevel: "Medium"},
+	}}
+	for _, report := range []*adk.CompetitorReport{from, to} {
+		if err := store.Save(context.Background(), report); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store), adk.WithChangeThreshold(5))
+	app := newServer(agent, serverConfig{}).routes()
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/diff?from="+from.ID+"&to="+to.ID, nil))
+	if err != nil {
+		t.Fatalf("Failed to test diff endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var result struct {
+		Changes []adk.CompetitorChange `json:"changes"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if len(result.Changes) != 1 || result.Changes[0].CompetitorName != "Surging" || result.Changes[0].ShareDelta != 10 {
+		t.Errorf("Expected only the 10-point change to surface, got %+v", result.Changes)
+	}
+}
+
+// TestCompareReportsEndpoint tests competitor, threat and recommendation
+// deltas between two stored reports
+func TestCompareReportsEndpoint(t *testing.T) {
+	a := &adk.CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Rising", MarketShare: 10, ThreatLevel: "Medium"},
+			{CompetitorName: "Gone", MarketShare: 5, ThreatLevel: "Low"},
+		},
+		Recommendations: []string{"Invest in support", "Cut prices"},
+	}
+	b := &adk.CompetitorReport{
+		TargetCompany: "TestCorp",
+		Competitors: []adk.CompetitorAnalysis{
+			{CompetitorName: "Rising", MarketShare: 25, ThreatLevel: "High"},
+		},
+		Recommendations: []string{"Invest in support", "Build integrations"},
+	}
+	other := &adk.CompetitorReport{TargetCompany: "OtherCorp"}
+	app, _ := setupStoreApp(t, a, b, other)
+
+	tests := []struct {
+		name        string
+		query       string
+		wantChanges int
+		wantThreats map[string]int
+		wantAdded   []string
+		wantRemoved []string
+		wantWarning bool
+	}{
+		{
+			name:        "Same company",
+			query:       "?a=" + a.ID + "&b=" + b.ID,
+			wantChanges: 2,
+			wantThreats: map[string]int{"High": 1, "Medium": -1, "Low": -1},
+			wantAdded:   []string{"Build integrations"},
+			wantRemoved: []string{"Cut prices"},
+		},
+		{
+			name:        "Different companies",
+			query:       "?a=" + a.ID + "&b=" + other.ID,
+			wantChanges: 2,
+			wantThreats: map[string]int{"Medium": -1, "Low": -1},
+			wantAdded:   []string{},
+			wantRemoved: []string{"Invest in support", "Cut prices"},
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/compare"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("Failed to test compare endpoint: %v", err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+			}
+
+			var result adk.ReportComparison
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+
+			if len(result.Changes) != tt.wantChanges {
+				t.Errorf("Expected %d competitor changes, got %+v", tt.wantChanges, result.Changes)
+			}
+			for level, want := range tt.wantThreats {
+				if result.ThreatDeltas[level] != want {
+					t.Errorf("Expected %s threat delta %d, got %d", level, want, result.ThreatDeltas[level])
+				}
+			}
+			if !slices.Equal(result.AddedRecommendations, tt.wantAdded) {
+				t.Errorf("Expected added recommendations %v, got %v", tt.wantAdded, result.AddedRecommendations)
+			}
+			if !slices.Equal(result.RemovedRecommendations, tt.wantRemoved) {
+				t.Errorf("Expected removed recommendations %v, got %v", tt.wantRemoved, result.RemovedRecommendations)
+			}
+			if (len(result.Warnings) > 0) != tt.wantWarning {
+				t.Errorf("Expected warning = %v, got %v", tt.wantWarning, result.Warnings)
+			}
+		})
+	}
+}
+
+// TestCompareReportsEndpoint_Errors tests compare validation and unknown reports
+func TestCompareReportsEndpoint_Errors(t *testing.T) {
+	known := &adk.CompetitorReport{TargetCompany: "TestCorp"}
+	app, _ := setupStoreApp(t, known)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "Missing parameters", query: "?a=" + known.ID, wantStatus: http.StatusBadRequest},
+		{name: "Unknown first report", query: "?a=missing&b=" + known.ID, wantStatus: http.StatusNotFound},
+		{name: "Unknown second report", query: "?a=" + known.ID + "&b=missing", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/compare"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("Failed to test compare endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+// TestDiffReportsEndpoint_Errors tests diff validation and unknown reports
+func TestDiffReportsEndpoint_Errors(t *testing.T) {
+	app, _ := setupStoreApp(t)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "Missing parameters", query: "?from=a", wantStatus: http.StatusBadRequest},
+		{name: "Unknown report", query: "?from=a&to=b", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/reports/diff"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("Failed to test diff endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
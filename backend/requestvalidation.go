@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// requestKey is the Locals key holding a request parsed by validated
+const requestKey = "validatedRequest"
+
+// validatable is a request body that can check its own fields
+type validatable interface {
+	validate() adk.ValidationErrors
+}
+
+// validated returns middleware that parses the body with parse and checks
+// it, answering 400 when it cannot be parsed and 422 with every field
+// problem when it is invalid. Content types are checked (415) by
+// requireJSON or requireAnalyzeBody ahead of it. Handlers read the result
+// with validatedRequest.
+func validated[T validatable](parse func(*fiber.Ctx) (T, error)) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		req, err := parse(c)
+		if err != nil {
+			return sendError(c, fiber.StatusBadRequest, adk.MsgInvalidRequestBody)
+		}
+		if errs := req.validate(); len(errs) > 0 {
+			return sendValidationErrors(c, errs)
+		}
+		c.Locals(requestKey, req)
+		return c.Next()
+	}
+}
+
+// validatedRequest returns the request stored by validated
+func validatedRequest[T validatable](c *fiber.Ctx) T {
+	req, _ := c.Locals(requestKey).(T)
+	return req
+}
+
+// bodyParser parses a JSON body into a new T
+func bodyParser[T any](c *fiber.Ctx) (*T, error) {
+	req := new(T)
+	if err := c.BodyParser(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// sendValidationErrors answers 422 listing every invalid field
+func sendValidationErrors(c *fiber.Ctx, errs adk.ValidationErrors) error {
+	return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+		"error":  adk.Message(requestLanguage(c), adk.MsgInvalidInput, fieldErrorDetail(errs)),
+		"code":   adk.MsgInvalidInput,
+		"errors": errs,
+	})
+}
+
+// fieldError converts a parse error for the field at path
+func fieldError(path string, err error) adk.FieldError {
+	return adk.FieldError{Path: path, Message: inputDetail(err)}
+}
+
+// fieldErrorDetail lists every field problem on one line
+func fieldErrorDetail(errs adk.ValidationErrors) string {
+	details := make([]string, len(errs))
+	for i, fieldErr := range errs {
+		details[i] = fieldErr.Error()
+	}
+	return strings.Join(details, "; ")
+}
+
+// validate checks the request's options and any supplied competitor data
+func (r *AnalyzeRequest) validate() adk.ValidationErrors {
+	var errs adk.ValidationErrors
+	if len(r.industries()) > maxIndustries {
+		errs = append(errs, adk.FieldError{Path: "industries", Message: fmt.Sprintf("must list at most %d distinct industries", maxIndustries)})
+	}
+	if _, err := adk.ParseOrder(r.OrderBy); err != nil {
+		errs = append(errs, fieldError("order_by", err))
+	}
+	if _, err := adk.ParsePersona(r.Persona); err != nil {
+		errs = append(errs, fieldError("persona", err))
+	}
+	if r.Competitors != nil {
+		var invalid adk.ValidationErrors
+		if err := adk.ValidateCompetitors(r.Competitors); errors.As(err, &invalid) {
+			errs = append(errs, invalid...)
+		}
+	}
+	return errs
+}
+
+// validate checks the analysis request and its overrides
+func (r *WhatIfRequest) validate() adk.ValidationErrors {
+	errs := r.AnalyzeRequest.validate()
+	if _, err := r.Overrides.options(adk.Config{}); err != nil {
+		errs = append(errs, fieldError("overrides", err))
+	}
+	return errs
+}
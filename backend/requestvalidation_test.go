@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// validationResponse is the error body shared by analyze-style endpoints
+type validationResponse struct {
+	Code   string           `json:"code"`
+	Errors []adk.FieldError `json:"errors"`
+}
+
+// errorPaths lists the field paths of errs
+func errorPaths(errs []adk.FieldError) string {
+	paths := make([]string, len(errs))
+	for i, fieldErr := range errs {
+		paths[i] = fieldErr.Path
+	}
+	return strings.Join(paths, ",")
+}
+
+// TestAnalyzeEndpoints_SharedValidation tests that every analyze-style
+// endpoint rejects the same bad inputs identically
+func TestAnalyzeEndpoints_SharedValidation(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantCode    string
+		wantPaths   string
+	}{
+		{name: "Wrong content type", contentType: "text/plain", body: `{"company_name":"TestCorp"}`, wantStatus: http.StatusUnsupportedMediaType, wantCode: adk.MsgUnsupportedMediaType},
+		{name: "Malformed JSON", body: `{"company_name":`, wantStatus: http.StatusBadRequest, wantCode: adk.MsgInvalidRequestBody},
+		{
+			name:       "Too many industries",
+			body:       `{"company_name":"TestCorp","industries":["a","b","c","d","e","f","g","h","i","j","k"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   adk.MsgInvalidInput,
+			wantPaths:  "industries",
+		},
+		{name: "Unknown order", body: `{"company_name":"TestCorp","order_by":"popularity"}`, wantStatus: http.StatusUnprocessableEntity, wantCode: adk.MsgInvalidInput, wantPaths: "order_by"},
+		{
+			name:       "Several problems",
+			body:       `{"company_name":"TestCorp","persona":"auditor","competitors":[{"name":"","market_share":-5}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   adk.MsgInvalidInput,
+			wantPaths:  "persona,competitors[0].name,competitors[0].market_share",
+		},
+	}
+
+	for _, path := range []string{"/api/analyze", "/api/analyze/whatif"} {
+		for _, tt := range tests {
+			t.Run(path+" "+tt.name, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader([]byte(tt.body)))
+				contentType := tt.contentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				req.Header.Set("Content-Type", contentType)
+
+				resp, err := app.Test(req)
+				if err != nil {
+					t.Fatalf("Failed to test %s: %v", path, err)
+				}
+				if resp.StatusCode != tt.wantStatus {
+					t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+				}
+
+				var got validationResponse
+				if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed to decode error: %v", err)
+				}
+				if got.Code != tt.wantCode {
+					t.Errorf("Expected code %q, got %q", tt.wantCode, got.Code)
+				}
+				if paths := errorPaths(got.Errors); paths != tt.wantPaths {
+					t.Errorf("Expected error paths %q, got %q", tt.wantPaths, paths)
+				}
+			})
+		}
+	}
+}
+
+// TestAnalyzeBatch_ItemValidation tests that batch items fail validation
+// with the same field errors as single analyses
+func TestAnalyzeBatch_ItemValidation(t *testing.T) {
+	app := setupTestApp()
+
+	body := `{"items":[
+		{"correlation_id":"ok","company_name":"TestCorp"},
+		{"correlation_id":"bad","company_name":"TestCorp","persona":"auditor","competitors":[{"name":"","market_share":-5}]}
+	]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze/batch", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test batch endpoint: %v", err)
+	}
+
+	var got struct {
+		Results []BatchResult `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(got.Results) != 2 || got.Results[0].Status != batchStatusOK {
+		t.Fatalf("Expected the valid item to succeed, got %+v", got.Results)
+	}
+	bad := got.Results[1]
+	if bad.Status != batchStatusError || bad.Report != nil {
+		t.Errorf("Expected the invalid item to fail without running, got %+v", bad)
+	}
+	if want, paths := "persona,competitors[0].name,competitors[0].market_share", errorPaths(bad.Errors); paths != want {
+		t.Errorf("Expected error paths %q, got %q", want, paths)
+	}
+}
+
+// TestAnalyzeRequest_Industries tests folding and deduplicating requested industries
+func TestAnalyzeRequest_Industries(t *testing.T) {
+	tests := []struct {
+		name string
+		req  AnalyzeRequest
+		want []string
+	}{
+		{name: "Single", req: AnalyzeRequest{Industry: "SaaS"}, want: []string{"SaaS"}},
+		{name: "Folded", req: AnalyzeRequest{Industry: "SaaS", Industries: []string{"Fintech"}}, want: []string{"SaaS", "Fintech"}},
+		{name: "Duplicates", req: AnalyzeRequest{Industry: "saas", Industries: []string{"SaaS", "Fintech", " fintech ", "SAAS"}}, want: []string{"saas", "Fintech"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.req.industries(); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	repeated := AnalyzeRequest{CompanyName: "TestCorp", Industries: slices.Repeat([]string{"SaaS"}, 50)}
+	if errs := repeated.validate(); len(errs) != 0 {
+		t.Errorf("Expected repeated industries to count once, got %v", errs)
+	}
+}
@@ -0,0 +1,974 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// serverConfig holds HTTP server settings read from the environment; each
+// field is loaded and shown through its configSettings entry
+type serverConfig struct {
+	Port                  string
+	Environment           string
+	MaxConcurrentAnalyses int
+	ReportStoreDir        string
+
+	// BatchMaxConcurrency caps the worker pool a batch request may ask for;
+	// each worker also takes one of MaxConcurrentAnalyses' slots
+	BatchMaxConcurrency int
+
+	// GRPCPort serves the agent over gRPC alongside HTTP (disabled when empty)
+	GRPCPort string
+
+	// CompressReports gzips reports saved to ReportStoreDir
+	CompressReports bool
+
+	// MemoryStoreMaxReports caps the in-memory store used without
+	// ReportStoreDir, evicting the oldest reports (0 keeps every report)
+	MemoryStoreMaxReports int
+
+	// ReportRetention deletes stored reports older than this (0 keeps
+	// them forever), sweeping every ReportCleanupInterval; with
+	// ReportCleanupDryRun expired reports are only logged
+	ReportRetention       time.Duration
+	ReportCleanupInterval time.Duration
+	ReportCleanupDryRun   bool
+
+	// EnableFailureInjection honors X-Inject-Failure when Environment is
+	// explicitly development or test
+	EnableFailureInjection bool
+
+	// ScreenshotServiceURL enables homepage thumbnails via a render service
+	ScreenshotServiceURL string
+	ScreenshotTimeout    time.Duration
+
+	// NewsFeedURL enables recent headlines from an RSS search endpoint,
+	// keeping NewsLimit items per competitor
+	NewsFeedURL string
+	NewsTimeout time.Duration
+	NewsLimit   int
+
+	// CheckWebsites HEAD-requests competitor sites to score data quality
+	CheckWebsites       bool
+	WebsiteCheckTimeout time.Duration
+
+	// InferPositioning guesses positioning from share when pricing is unknown
+	InferPositioning bool
+
+	// ValueFewStrengths and ValueManyStrengths bound the overpriced and
+	// bargain value positions
+	ValueFewStrengths  int
+	ValueManyStrengths int
+
+	// LargeCustomerBase is the customer count credited as a large installed base
+	LargeCustomerBase int
+
+	// ObservationHalfLife is how fast dated strengths and weaknesses lose weight
+	ObservationHalfLife time.Duration
+
+	// ThreatDecayAfter is the data age past which threat scores decay, halving
+	// every ThreatDecayHalfLife; zero disables the decay
+	ThreatDecayAfter    time.Duration
+	ThreatDecayHalfLife time.Duration
+
+	// InsightsTemplate (inline) or InsightsTemplateFile overrides the
+	// market insights wording; the inline template wins when both are set
+	InsightsTemplate     string
+	InsightsTemplateFile string
+
+	// MaxFieldLength* truncate long strings per export format (0 disables)
+	MaxFieldLengthJSON     int
+	MaxFieldLengthMarkdown int
+	MaxFieldLengthCSV      int
+	MaxFieldLengthEmail    int
+
+	// RadarAxes is a comma-separated axis list for radar exports
+	RadarAxes string
+
+	// Features is a comma-separated feature list compared across competitors
+	Features string
+
+	// JSONEmptyLists renders nil lists as [] in JSON responses
+	JSONEmptyLists bool
+
+	// JSONPayload omits (lean) or includes (full) every empty field in
+	// JSON reports
+	JSONPayload string
+
+	// MarkdownCitations footnotes sourced fields in Markdown reports
+	MarkdownCitations bool
+
+	// AnalysisCacheSize caches analyses of identical competitor data (0 disables)
+	AnalysisCacheSize int
+
+	// TimeBudget skips optional enrichment once an analysis has run this long
+	TimeBudget time.Duration
+
+	// RunRetries re-executes failed analyses; RunRetryBackoff is the first delay
+	RunRetries      int
+	RunRetryBackoff time.Duration
+
+	// Persona is the default report persona (investor, product or sales)
+	Persona string
+
+	// Tone phrases recommendations (directive, advisory or neutral)
+	Tone string
+
+	// Timezone is the IANA zone report timestamps are rendered in
+	Timezone string
+
+	// ShareFormat renders market shares as float or integer percents
+	ShareFormat string
+
+	// ScoreNormalization adds zscore or minmax threat scores to reports
+	ScoreNormalization string
+
+	// HighThreatShare and MediumThreatShare are the market share percents at
+	// which competitors rate as High and Medium threats (0 keeps 20 and 10)
+	HighThreatShare   float64
+	MediumThreatShare float64
+
+	// ViabilityHHI and ViabilityTopShare are the concentration and top
+	// threat share above which reports advise against market entry (0 keeps
+	// 2500 and 50)
+	ViabilityHHI      float64
+	ViabilityTopShare float64
+
+	// MinThreatLevel drops lower-threat competitors from reports
+	MinThreatLevel string
+
+	// TierCaps are "level=n,..." limits on competitors listed per threat level
+	TierCaps string
+
+	// SampleSize caps analyzed competitors, chosen by SampleMethod
+	// (top_share, random or stratified) seeded by SampleSeed
+	SampleSize   int
+	SampleMethod string
+	SampleSeed   int
+
+	// FuzzyMatchThreshold merges near-duplicate competitor names at or
+	// above this similarity (0-1, 0 disables)
+	FuzzyMatchThreshold float64
+
+	// TieBreaks is the comma-separated chain (share, name) ordering
+	// competitors with equal threat scores
+	TieBreaks string
+
+	// MinShareChange is the share move in points that diffs and the change
+	// feed treat as material
+	MinShareChange float64
+
+	// EmergingGrowthRate is the annual growth percent that flags emerging threats
+	EmergingGrowthRate float64
+
+	// EmergingMaxShare and EmergingMinStrengths bound which fast growers are
+	// flagged as emerging players
+	EmergingMaxShare     float64
+	EmergingMinStrengths int
+
+	// NormalizeShares rescales market shares to sum to exactly 100%
+	NormalizeShares bool
+
+	// AcceptFormInput lets /api/analyze take form-encoded HTML form posts
+	AcceptFormInput bool
+
+	// PartialResults answers 206 with completed stages when a later stage fails
+	PartialResults bool
+
+	// MinRecommendations pads reports with generic advice up to this many
+	MinRecommendations int
+
+	// DefaultRecommendations are "|"-separated house recommendations
+	DefaultRecommendations string
+
+	// RecommendationRationale explains each recommendation by default
+	RecommendationRationale bool
+
+	// IndustryBenchmarks are "industry=tier,leader_share;..." norms
+	// competitors are compared against
+	IndustryBenchmarks string
+
+	// IndustryLookup is "company=industry;..." used to infer the industry
+	// of known companies when a request omits it
+	IndustryLookup string
+
+	// OpportunityWeights are "industry=keyword:weight,...;..." weights
+	// ranking opportunities by strategic relevance
+	OpportunityWeights string
+
+	// RedactionRules are "profile=field,field;..." export redaction rules
+	RedactionRules string
+
+	// OutputProfile is the default export redaction profile; requests need
+	// the API key to pick a profile that redacts less
+	OutputProfile string
+
+	// APIKey gates admin endpoints; they are disabled when empty
+	APIKey string
+}
+
+// configSetting ties a serverConfig field to its environment variable and
+// its key in the config endpoint's view
+type configSetting struct {
+	env string
+	key string
+	// def is the default, of the field's type; nil means the zero value
+	def any
+	// mask redacts string values shown by the config endpoint
+	mask func(string) string
+	// field returns a pointer to the setting's serverConfig field
+	field func(*serverConfig) any
+}
+
+// configSettings lists every server setting, in .env.example order
+var configSettings = []configSetting{
+	{env: "PORT", key: "port", def: "8080", field: func(c *serverConfig) any { return &c.Port }},
+	{env: "GRPC_PORT", key: "grpc_port", field: func(c *serverConfig) any { return &c.GRPCPort }},
+	{env: "ENVIRONMENT", key: "environment", field: func(c *serverConfig) any { return &c.Environment }},
+	{env: "MAX_CONCURRENT_ANALYSES", key: "max_concurrent_analyses", def: 10, field: func(c *serverConfig) any { return &c.MaxConcurrentAnalyses }},
+	{env: "BATCH_MAX_CONCURRENCY", key: "batch_max_concurrency", def: 16, field: func(c *serverConfig) any { return &c.BatchMaxConcurrency }},
+	{env: "REPORT_STORE_DIR", key: "report_store_dir", field: func(c *serverConfig) any { return &c.ReportStoreDir }},
+	{env: "REPORT_STORE_COMPRESS", key: "compress_reports", field: func(c *serverConfig) any { return &c.CompressReports }},
+	{env: "MEMORY_STORE_MAX_REPORTS", key: "memory_store_max_reports", def: 1000, field: func(c *serverConfig) any { return &c.MemoryStoreMaxReports }},
+	{env: "REPORT_RETENTION", key: "report_retention", field: func(c *serverConfig) any { return &c.ReportRetention }},
+	{env: "REPORT_CLEANUP_INTERVAL", key: "report_cleanup_interval", def: time.Hour, field: func(c *serverConfig) any { return &c.ReportCleanupInterval }},
+	{env: "REPORT_CLEANUP_DRY_RUN", key: "report_cleanup_dry_run", field: func(c *serverConfig) any { return &c.ReportCleanupDryRun }},
+	{env: "ENABLE_FAILURE_INJECTION", key: "enable_failure_injection", field: func(c *serverConfig) any { return &c.EnableFailureInjection }},
+	{env: "SCREENSHOT_SERVICE_URL", key: "screenshot_service_url", mask: redactURL, field: func(c *serverConfig) any { return &c.ScreenshotServiceURL }},
+	{env: "SCREENSHOT_TIMEOUT", key: "screenshot_timeout", def: 3 * time.Second, field: func(c *serverConfig) any { return &c.ScreenshotTimeout }},
+	{env: "CHECK_WEBSITES", key: "check_websites", field: func(c *serverConfig) any { return &c.CheckWebsites }},
+	{env: "WEBSITE_CHECK_TIMEOUT", key: "website_check_timeout", def: 3 * time.Second, field: func(c *serverConfig) any { return &c.WebsiteCheckTimeout }},
+	{env: "NEWS_FEED_URL", key: "news_feed_url", mask: redactURL, field: func(c *serverConfig) any { return &c.NewsFeedURL }},
+	{env: "NEWS_TIMEOUT", key: "news_timeout", def: 3 * time.Second, field: func(c *serverConfig) any { return &c.NewsTimeout }},
+	{env: "NEWS_LIMIT", key: "news_limit", def: 3, field: func(c *serverConfig) any { return &c.NewsLimit }},
+	{env: "INFER_POSITIONING", key: "infer_positioning", field: func(c *serverConfig) any { return &c.InferPositioning }},
+	{env: "VALUE_FEW_STRENGTHS", key: "value_few_strengths", field: func(c *serverConfig) any { return &c.ValueFewStrengths }},
+	{env: "VALUE_MANY_STRENGTHS", key: "value_many_strengths", field: func(c *serverConfig) any { return &c.ValueManyStrengths }},
+	{env: "LARGE_CUSTOMER_BASE", key: "large_customer_base", field: func(c *serverConfig) any { return &c.LargeCustomerBase }},
+	{env: "OBSERVATION_HALF_LIFE", key: "observation_half_life", field: func(c *serverConfig) any { return &c.ObservationHalfLife }},
+	{env: "THREAT_DECAY_AFTER", key: "threat_decay_after", field: func(c *serverConfig) any { return &c.ThreatDecayAfter }},
+	{env: "THREAT_DECAY_HALF_LIFE", key: "threat_decay_half_life", field: func(c *serverConfig) any { return &c.ThreatDecayHalfLife }},
+	{env: "INSIGHTS_TEMPLATE", key: "insights_template", field: func(c *serverConfig) any { return &c.InsightsTemplate }},
+	{env: "INSIGHTS_TEMPLATE_FILE", key: "insights_template_file", field: func(c *serverConfig) any { return &c.InsightsTemplateFile }},
+	{env: "MAX_FIELD_LENGTH_JSON", key: "max_field_length_json", field: func(c *serverConfig) any { return &c.MaxFieldLengthJSON }},
+	{env: "MAX_FIELD_LENGTH_MARKDOWN", key: "max_field_length_markdown", field: func(c *serverConfig) any { return &c.MaxFieldLengthMarkdown }},
+	{env: "MAX_FIELD_LENGTH_CSV", key: "max_field_length_csv", field: func(c *serverConfig) any { return &c.MaxFieldLengthCSV }},
+	{env: "MAX_FIELD_LENGTH_EMAIL", key: "max_field_length_email", field: func(c *serverConfig) any { return &c.MaxFieldLengthEmail }},
+	{env: "JSON_EMPTY_LISTS", key: "json_empty_lists", field: func(c *serverConfig) any { return &c.JSONEmptyLists }},
+	{env: "JSON_PAYLOAD", key: "json_payload", field: func(c *serverConfig) any { return &c.JSONPayload }},
+	{env: "MARKDOWN_CITATIONS", key: "markdown_citations", field: func(c *serverConfig) any { return &c.MarkdownCitations }},
+	{env: "RADAR_AXES", key: "radar_axes", field: func(c *serverConfig) any { return &c.RadarAxes }},
+	{env: "FEATURES", key: "features", field: func(c *serverConfig) any { return &c.Features }},
+	{env: "ANALYSIS_CACHE_SIZE", key: "analysis_cache_size", field: func(c *serverConfig) any { return &c.AnalysisCacheSize }},
+	{env: "TIME_BUDGET", key: "time_budget", field: func(c *serverConfig) any { return &c.TimeBudget }},
+	{env: "RUN_RETRIES", key: "run_retries", field: func(c *serverConfig) any { return &c.RunRetries }},
+	{env: "RUN_RETRY_BACKOFF", key: "run_retry_backoff", def: 100 * time.Millisecond, field: func(c *serverConfig) any { return &c.RunRetryBackoff }},
+	{env: "ENABLE_PARTIAL_RESULTS", key: "partial_results", field: func(c *serverConfig) any { return &c.PartialResults }},
+	{env: "ACCEPT_FORM_INPUT", key: "accept_form_input", def: true, field: func(c *serverConfig) any { return &c.AcceptFormInput }},
+	{env: "HIGH_THREAT_SHARE", key: "high_threat_share", field: func(c *serverConfig) any { return &c.HighThreatShare }},
+	{env: "MEDIUM_THREAT_SHARE", key: "medium_threat_share", field: func(c *serverConfig) any { return &c.MediumThreatShare }},
+	{env: "VIABILITY_HHI", key: "viability_hhi", field: func(c *serverConfig) any { return &c.ViabilityHHI }},
+	{env: "VIABILITY_TOP_SHARE", key: "viability_top_share", field: func(c *serverConfig) any { return &c.ViabilityTopShare }},
+	{env: "MIN_THREAT_LEVEL", key: "min_threat_level", field: func(c *serverConfig) any { return &c.MinThreatLevel }},
+	{env: "TIER_CAPS", key: "tier_caps", field: func(c *serverConfig) any { return &c.TierCaps }},
+	{env: "SAMPLE_SIZE", key: "sample_size", field: func(c *serverConfig) any { return &c.SampleSize }},
+	{env: "SAMPLE_METHOD", key: "sample_method", field: func(c *serverConfig) any { return &c.SampleMethod }},
+	{env: "SAMPLE_SEED", key: "sample_seed", field: func(c *serverConfig) any { return &c.SampleSeed }},
+	{env: "TIE_BREAKS", key: "tie_breaks", field: func(c *serverConfig) any { return &c.TieBreaks }},
+	{env: "FUZZY_MATCH_THRESHOLD", key: "fuzzy_match_threshold", field: func(c *serverConfig) any { return &c.FuzzyMatchThreshold }},
+	{env: "NORMALIZE_SHARES", key: "normalize_shares", field: func(c *serverConfig) any { return &c.NormalizeShares }},
+	{env: "EMERGING_GROWTH_RATE", key: "emerging_growth_rate", field: func(c *serverConfig) any { return &c.EmergingGrowthRate }},
+	{env: "EMERGING_MAX_SHARE", key: "emerging_max_share", field: func(c *serverConfig) any { return &c.EmergingMaxShare }},
+	{env: "EMERGING_MIN_STRENGTHS", key: "emerging_min_strengths", field: func(c *serverConfig) any { return &c.EmergingMinStrengths }},
+	{env: "CHANGE_MIN_SHARE", key: "min_share_change", field: func(c *serverConfig) any { return &c.MinShareChange }},
+	{env: "API_KEY", key: "api_key", mask: redactSecret, field: func(c *serverConfig) any { return &c.APIKey }},
+	{env: "INDUSTRY_BENCHMARKS", key: "industry_benchmarks", field: func(c *serverConfig) any { return &c.IndustryBenchmarks }},
+	{env: "INDUSTRY_LOOKUP", key: "industry_lookup", field: func(c *serverConfig) any { return &c.IndustryLookup }},
+	{env: "OPPORTUNITY_WEIGHTS", key: "opportunity_weights", field: func(c *serverConfig) any { return &c.OpportunityWeights }},
+	{env: "REDACTION_RULES", key: "redaction_rules", field: func(c *serverConfig) any { return &c.RedactionRules }},
+	{env: "OUTPUT_PROFILE", key: "output_profile", field: func(c *serverConfig) any { return &c.OutputProfile }},
+	{env: "MIN_RECOMMENDATIONS", key: "min_recommendations", field: func(c *serverConfig) any { return &c.MinRecommendations }},
+	{env: "DEFAULT_RECOMMENDATIONS", key: "default_recommendations", field: func(c *serverConfig) any { return &c.DefaultRecommendations }},
+	{env: "RECOMMENDATION_RATIONALE", key: "recommendation_rationale", field: func(c *serverConfig) any { return &c.RecommendationRationale }},
+	{env: "REPORT_PERSONA", key: "persona", field: func(c *serverConfig) any { return &c.Persona }},
+	{env: "RECOMMENDATION_TONE", key: "tone", field: func(c *serverConfig) any { return &c.Tone }},
+	{env: "REPORT_TIMEZONE", key: "timezone", def: "UTC", field: func(c *serverConfig) any { return &c.Timezone }},
+	{env: "MARKET_SHARE_FORMAT", key: "share_format", field: func(c *serverConfig) any { return &c.ShareFormat }},
+	{env: "SCORE_NORMALIZATION", key: "score_normalization", field: func(c *serverConfig) any { return &c.ScoreNormalization }},
+}
+
+// loadServerConfig reads server settings from environment variables
+func loadServerConfig() serverConfig {
+	var cfg serverConfig
+	for _, setting := range configSettings {
+		setting.load(&cfg)
+	}
+	return cfg
+}
+
+// load sets the setting's field from its environment variable, falling
+// back to the default
+func (s configSetting) load(cfg *serverConfig) {
+	switch field := s.field(cfg).(type) {
+	case *string:
+		def, _ := s.def.(string)
+		*field = getEnv(s.env, def)
+	case *int:
+		def, _ := s.def.(int)
+		*field = getEnvAsInt(s.env, def)
+	case *float64:
+		def, _ := s.def.(float64)
+		*field = getEnvAsFloat(s.env, def)
+	case *bool:
+		def, _ := s.def.(bool)
+		*field = getEnvAsBool(s.env, def)
+	case *time.Duration:
+		def, _ := s.def.(time.Duration)
+		*field = getEnvAsDuration(s.env, def)
+	}
+}
+
+// value renders the setting's field for the config endpoint
+func (s configSetting) value(cfg *serverConfig) any {
+	switch field := s.field(cfg).(type) {
+	case *string:
+		if s.mask != nil {
+			return s.mask(*field)
+		}
+		return *field
+	case *time.Duration:
+		return field.String()
+	default:
+		return reflect.ValueOf(field).Elem().Interface()
+	}
+}
+
+// maxFieldLengths maps export formats to their configured field limits
+func (cfg serverConfig) maxFieldLengths() map[string]int {
+	return map[string]int{
+		adk.FormatJSON:     cfg.MaxFieldLengthJSON,
+		adk.FormatMarkdown: cfg.MaxFieldLengthMarkdown,
+		adk.FormatCSV:      cfg.MaxFieldLengthCSV,
+		adk.FormatEmail:    cfg.MaxFieldLengthEmail,
+	}
+}
+
+// server wires the competitor intelligence agent to HTTP routes
+type server struct {
+	agent *adk.CompetitorIntelligenceAgent
+	cfg   serverConfig
+
+	// analyses is a semaphore bounding in-flight analyses; nil means unbounded
+	analyses chan struct{}
+}
+
+// newServer creates a server for the given agent and configuration
+func newServer(agent *adk.CompetitorIntelligenceAgent, cfg serverConfig) *server {
+	s := &server{
+		agent: agent,
+		cfg:   cfg,
+	}
+	if cfg.MaxConcurrentAnalyses > 0 {
+		s.analyses = make(chan struct{}, cfg.MaxConcurrentAnalyses)
+	}
+	return s
+}
+
+// failureInjectionEnvironments are the environments that may honor
+// X-Inject-Failure; an unset environment never does
+var failureInjectionEnvironments = []string{"development", "test"}
+
+// failureInjectionEnabled reports whether X-Inject-Failure is honored.
+// It requires both the opt-in flag and an explicit non-production environment.
+func (s *server) failureInjectionEnabled() bool {
+	return s.cfg.EnableFailureInjection && slices.Contains(failureInjectionEnvironments, strings.ToLower(s.cfg.Environment))
+}
+
+// routes builds the Fiber app with all endpoints registered
+func (s *server) routes() *fiber.App {
+	app := fiber.New()
+
+	if s.failureInjectionEnabled() {
+		app.Use(injectFailure)
+	}
+
+	// Health check endpoint
+	app.Get("/health", s.health)
+
+	// Root endpoint
+	app.Get("/", s.index)
+
+	// API routes
+	api := app.Group("/api", s.identifyCaller)
+
+	// Competitor intelligence endpoint
+	api.Post("/analyze", s.requireAnalyzeBody, validated(parseAnalyzeRequest), s.limitConcurrency, s.analyze)
+	api.Post("/analyze/batch", requireJSON, s.analyzeBatch)
+	api.Post("/analyze/whatif", requireJSON, validated(bodyParser[WhatIfRequest]), s.limitConcurrency, s.analyzeWhatIf)
+	api.Get("/analyze/insights", s.limitConcurrency, s.analyzeInsights)
+
+	// Stored report routes
+	reports := api.Group("/reports", s.requireStore)
+	reports.Get("/search", s.searchReports)
+	reports.Get("/ranking-changes", s.rankingChanges)
+	reports.Get("/threat-trends", s.threatTrends)
+	reports.Get("/diff", s.diffReports)
+	reports.Get("/compare", s.compareReports)
+	reports.Get("/:id/replay", s.replayReport)
+
+	// Admin endpoints
+	api.Get("/_config", s.requireAPIKey, s.effectiveConfig)
+	api.Post("/_cache/invalidate", s.requireAPIKey, s.invalidateCache)
+
+	// AI endpoint (placeholder for now)
+	api.Post("/ai", s.ai)
+
+	return app
+}
+
+// health reports service liveness and which build is running
+func (s *server) health(c *fiber.Ctx) error {
+	build := currentBuildInfo()
+	return c.JSON(fiber.Map{
+		"status":     "healthy",
+		"service":    "marketpulse-api",
+		"version":    build.Version,
+		"commit":     build.Commit,
+		"build_time": build.BuildTime,
+	})
+}
+
+// index lists the available endpoints
+func (s *server) index(c *fiber.Ctx) error {
+	return c.JSON(Response{
+		Status:  "success",
+		Message: "Welcome to OpenAI API",
+		Data: fiber.Map{
+			"version": version,
+			"endpoints": fiber.Map{
+				"health":   "/health",
+				"api":      "/api/ai",
+				"analyze":  "/api/analyze",
+				"batch":    "/api/analyze/batch",
+				"insights": "/api/analyze/insights",
+			},
+		},
+	})
+}
+
+// AnalyzeRequest is the body accepted by the analyze endpoint
+type AnalyzeRequest struct {
+	CompanyName string   `json:"company_name"`
+	Industry    string   `json:"industry"`
+	Industries  []string `json:"industries,omitempty"`
+	OrderBy     string   `json:"order_by,omitempty"`
+	Persona     string   `json:"persona,omitempty"`
+
+	// ProductsContains keeps only competitors with a product matching it
+	ProductsContains string `json:"products_contains,omitempty"`
+
+	// Highlight names competitors of interest to pin to the top of the report
+	Highlight []string `json:"highlight,omitempty"`
+
+	// Anonymize replaces competitor names with pseudonyms; the mapping is
+	// only returned to callers presenting the API key
+	Anonymize bool `json:"anonymize,omitempty"`
+
+	// WithRationale explains each recommendation, overriding the server default
+	WithRationale *bool `json:"with_rationale,omitempty"`
+
+	// Competitors, when given, are analyzed instead of researching the market
+	Competitors []adk.CompetitorData `json:"competitors,omitempty"`
+}
+
+// maxIndustries caps the distinct industries one request may research,
+// since each is researched separately
+const maxIndustries = 10
+
+// industries returns the requested industries, folding the single-industry
+// field into the list when both are given and dropping case-insensitive
+// duplicates
+func (r *AnalyzeRequest) industries() []string {
+	if len(r.Industries) == 0 {
+		return []string{r.Industry}
+	}
+	requested := r.Industries
+	if r.Industry != "" {
+		requested = append([]string{r.Industry}, r.Industries...)
+	}
+
+	seen := make(map[string]bool, len(requested))
+	industries := make([]string, 0, len(requested))
+	for _, industry := range requested {
+		key := strings.ToLower(strings.TrimSpace(industry))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		industries = append(industries, industry)
+	}
+	return industries
+}
+
+// analyze runs the competitor intelligence workflow
+func (s *server) analyze(c *fiber.Ctx) error {
+	req := validatedRequest[*AnalyzeRequest](c)
+
+	report, err := s.runAnalysis(c.UserContext(), req)
+	if err != nil && report != nil && report.Partial {
+		// Later stages failed; return what completed and say what is missing
+		c.Status(fiber.StatusPartialContent)
+		c.Set("X-Partial-Error", err.Error())
+		return s.sendReport(c, report)
+	}
+	if err != nil {
+		return sendPipelineError(c, err)
+	}
+
+	return s.sendReport(c, report)
+}
+
+// runAnalysis runs the competitor intelligence workflow for one request
+func (s *server) runAnalysis(ctx context.Context, req *AnalyzeRequest) (*adk.CompetitorReport, error) {
+	return runAnalysisWith(ctx, s.agent, req)
+}
+
+// runAnalysisWith runs one request on the given agent
+func runAnalysisWith(ctx context.Context, agent *adk.CompetitorIntelligenceAgent, req *AnalyzeRequest) (*adk.CompetitorReport, error) {
+	if req.OrderBy != "" {
+		order, err := adk.ParseOrder(req.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+		ctx = adk.WithOrder(ctx, order)
+	}
+	if req.Persona != "" {
+		persona, err := adk.ParsePersona(req.Persona)
+		if err != nil {
+			return nil, err
+		}
+		ctx = adk.WithPersona(ctx, persona)
+	}
+	if req.Competitors != nil {
+		ctx = adk.WithCompetitorData(ctx, req.Competitors)
+	}
+	if req.ProductsContains != "" {
+		ctx = adk.WithProductFilter(ctx, req.ProductsContains)
+	}
+	if len(req.Highlight) > 0 {
+		ctx = adk.WithHighlights(ctx, req.Highlight...)
+	}
+	if req.WithRationale != nil {
+		ctx = adk.WithRationale(ctx, *req.WithRationale)
+	}
+	if req.Anonymize {
+		// Stored reports are served by ID with real names, so an anonymized
+		// run must not leave one behind
+		ctx = adk.WithoutSaving(ctx)
+	}
+
+	// Run Google ADK competitor analysis
+	report, err := agent.RunIndustries(ctx, req.CompanyName, req.industries())
+	if report != nil && req.Anonymize {
+		report = anonymize(ctx, report)
+	}
+	return report, err
+}
+
+// anonymize pseudonymizes a report, disclosing the mapping only to API key
+// holders
+func anonymize(ctx context.Context, report *adk.CompetitorReport) *adk.CompetitorReport {
+	anonymized, pseudonyms := report.Anonymized()
+	if isAuthorized(ctx) {
+		anonymized.Pseudonyms = pseudonyms
+	}
+	return anonymized
+}
+
+// mimeProtobuf is the protobuf content type; mimeXProtobuf is its legacy alias
+const (
+	mimeProtobuf  = "application/protobuf"
+	mimeXProtobuf = "application/x-protobuf"
+)
+
+// mimeYAML is the YAML content type; mimeXYAML and mimeTextYAML are the
+// aliases clients still send
+const (
+	mimeYAML     = "application/yaml"
+	mimeXYAML    = "application/x-yaml"
+	mimeTextYAML = "text/yaml"
+)
+
+// exportContentTypes maps export formats to response content types
+var exportContentTypes = map[string]string{
+	adk.FormatJSON:     fiber.MIMEApplicationJSON,
+	adk.FormatMarkdown: "text/markdown; charset=utf-8",
+	adk.FormatCSV:      "text/csv; charset=utf-8",
+	adk.FormatRadar:    fiber.MIMEApplicationJSON,
+	adk.FormatMatrix:   fiber.MIMEApplicationJSON,
+	adk.FormatSheets:   "text/csv; charset=utf-8",
+	adk.FormatSWOT:     fiber.MIMEApplicationJSON,
+
+	adk.FormatRelationships:    fiber.MIMEApplicationJSON,
+	adk.FormatRelationshipsDOT: "text/vnd.graphviz; charset=utf-8",
+
+	adk.FormatKillSheet: "text/markdown; charset=utf-8",
+	adk.FormatEmail:     fiber.MIMETextHTMLCharsetUTF8,
+	adk.FormatFeatures:  fiber.MIMEApplicationJSON,
+	adk.FormatPricing:   fiber.MIMEApplicationJSON,
+	adk.FormatProtobuf:  mimeProtobuf,
+	adk.FormatYAML:      mimeYAML,
+}
+
+// sendReport renders the report in the format named by the format query
+// parameter, defaulting to JSON or protobuf by the Accept header, redacted
+// for the profile query parameter
+func (s *server) sendReport(c *fiber.Ctx, report *adk.CompetitorReport) error {
+	format := c.Query("format")
+	if format == "" {
+		format = negotiateReportFormat(c)
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgUnsupportedFormat, format)
+	}
+
+	profile, err := s.outputProfile(c)
+	if err != nil {
+		return sendProfileError(c, err)
+	}
+	body, err := s.agent.ExportProfile(report, format, profile)
+	if errors.Is(err, adk.ErrInvalidInput) {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgInvalidInput, inputDetail(err))
+	}
+	if err != nil {
+		return sendError(c, fiber.StatusInternalServerError, adk.MsgReportGenerationFailed)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	if updated := sourcesLastUpdatedHeader(report); updated != "" {
+		c.Set("X-Sources-Last-Updated", updated)
+	}
+	return c.Send(body)
+}
+
+// errProfileForbidden rejects a less restrictive output profile requested
+// without an API key
+var errProfileForbidden = errors.New("output profile requires an API key")
+
+// outputProfile returns the requested redaction profile, or the configured
+// default. Only API key holders may pick a profile that reveals more.
+func (s *server) outputProfile(c *fiber.Ctx) (string, error) {
+	profile := s.agent.Config.OutputProfile
+	requested := c.Query("profile")
+	if requested == "" {
+		return profile, nil
+	}
+	covered, err := s.agent.RedactsAtLeast(requested, profile)
+	if err != nil {
+		return "", err
+	}
+	if !covered && !isAuthorized(c.UserContext()) {
+		return "", errProfileForbidden
+	}
+	return requested, nil
+}
+
+// sendProfileError reports an output profile the caller may not use
+func sendProfileError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, errProfileForbidden) {
+		return sendError(c, fiber.StatusForbidden, adk.MsgProfileForbidden, c.Query("profile"))
+	}
+	return sendError(c, fiber.StatusBadRequest, adk.MsgInvalidInput, inputDetail(err))
+}
+
+// negotiateReportFormat picks protobuf or YAML for clients that prefer them
+// and JSON otherwise
+func negotiateReportFormat(c *fiber.Ctx) string {
+	c.Vary(fiber.HeaderAccept)
+	switch c.Accepts(fiber.MIMEApplicationJSON, mimeProtobuf, mimeXProtobuf, mimeYAML, mimeXYAML, mimeTextYAML) {
+	case mimeProtobuf, mimeXProtobuf:
+		return adk.FormatProtobuf
+	case mimeYAML, mimeXYAML, mimeTextYAML:
+		return adk.FormatYAML
+	default:
+		return adk.FormatJSON
+	}
+}
+
+// sourcesLastUpdatedHeader lists per-source freshness as source=RFC3339
+// pairs so non-JSON exports still expose data recency
+func sourcesLastUpdatedHeader(report *adk.CompetitorReport) string {
+	updated := report.SourceLastUpdated()
+	sources := make([]string, 0, len(updated))
+	for source := range updated {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	pairs := make([]string, len(sources))
+	for i, source := range sources {
+		pairs[i] = source + "=" + updated[source].UTC().Format(time.RFC3339)
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// ai echoes a mock AI response
+func (s *server) ai(c *fiber.Ctx) error {
+	var aiReq AIRequest
+	if err := c.BodyParser(&aiReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	}
+
+	return c.JSON(Response{
+		Status:  "success",
+		Message: fmt.Sprintf("Mock AI response for: %s", aiReq.Prompt),
+		Data: fiber.Map{
+			"framework": "OpenAI",
+		},
+	})
+}
+
+// limitConcurrency rejects analyses with 503 once the semaphore is saturated
+func (s *server) limitConcurrency(c *fiber.Ctx) error {
+	if !s.acquireAnalysis() {
+		return tooManyAnalyses(c)
+	}
+	defer s.releaseAnalysis()
+	return c.Next()
+}
+
+// acquireAnalysis takes a semaphore slot without blocking, reporting
+// whether one was free
+func (s *server) acquireAnalysis() bool {
+	if s.analyses == nil {
+		return true
+	}
+	select {
+	case s.analyses <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// releaseAnalysis returns a slot taken by acquireAnalysis
+func (s *server) releaseAnalysis() {
+	if s.analyses != nil {
+		<-s.analyses
+	}
+}
+
+// acquireAnalyses takes up to n semaphore slots without blocking and
+// returns how many it got
+func (s *server) acquireAnalyses(n int) int {
+	for acquired := 0; acquired < n; acquired++ {
+		if !s.acquireAnalysis() {
+			return acquired
+		}
+	}
+	return n
+}
+
+// releaseAnalyses returns n slots taken by acquireAnalyses
+func (s *server) releaseAnalyses(n int) {
+	for range n {
+		s.releaseAnalysis()
+	}
+}
+
+// tooManyAnalyses answers 503 when the semaphore is saturated
+func tooManyAnalyses(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderRetryAfter, "1")
+	return sendError(c, fiber.StatusServiceUnavailable, adk.MsgTooManyAnalyses)
+}
+
+// sendPipelineError answers a failed analysis run; invalid input is the
+// caller's fault, anything else is reported as a failed analysis
+func sendPipelineError(c *fiber.Ctx, err error) error {
+	var invalid adk.ValidationErrors
+	if errors.As(err, &invalid) {
+		return sendValidationErrors(c, invalid)
+	}
+	if errors.Is(err, adk.ErrInvalidInput) {
+		return sendError(c, stageStatus(err), adk.MsgInvalidInput, inputDetail(err))
+	}
+	return sendError(c, stageStatus(err), adk.MsgAnalysisFailed, err.Error())
+}
+
+// stageStatus maps a pipeline error to an HTTP status; research failures
+// come from upstream data sources and surface as 502
+func stageStatus(err error) int {
+	if errors.Is(err, adk.ErrInvalidInput) {
+		return fiber.StatusBadRequest
+	}
+	var stageErr *adk.StageError
+	if errors.As(err, &stageErr) && stageErr.Stage == adk.StageResearch {
+		return fiber.StatusBadGateway
+	}
+	return fiber.StatusInternalServerError
+}
+
+// injectFailure forces the pipeline stage named by X-Inject-Failure to fail
+func injectFailure(c *fiber.Ctx) error {
+	stage := c.Get("X-Inject-Failure")
+	if stage == "" {
+		return c.Next()
+	}
+
+	if !adk.IsStage(stage) {
+		return sendError(c, fiber.StatusBadRequest, adk.MsgUnknownFailureStage, stage)
+	}
+
+	c.SetUserContext(adk.WithInjectedFailure(c.UserContext(), stage))
+	return c.Next()
+}
+
+// parseAnalyzeRequest reads an analyze request from a JSON body or, for
+// simple HTML forms, from form-encoded fields
+func parseAnalyzeRequest(c *fiber.Ctx) (*AnalyzeRequest, error) {
+	if isFormEncoded(c) {
+		req := &AnalyzeRequest{
+			CompanyName: c.FormValue("company_name"),
+			Industry:    c.FormValue("industry"),
+			Industries:  formList(c, "industries"),
+			OrderBy:     c.FormValue("order_by"),
+			Persona:     c.FormValue("persona"),
+
+			ProductsContains: c.FormValue("products_contains"),
+			Highlight:        formList(c, "highlight"),
+		}
+		anonymize, err := formBool(c, "anonymize")
+		if err != nil {
+			return nil, err
+		}
+		req.Anonymize = anonymize != nil && *anonymize
+		if req.WithRationale, err = formBool(c, "with_rationale"); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+
+	req := new(AnalyzeRequest)
+	if err := c.BodyParser(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// formList collects a form field given repeatedly or as a comma-separated
+// list, skipping blank entries
+func formList(c *fiber.Ctx, key string) []string {
+	var values []string
+	for _, raw := range c.Request().PostArgs().PeekMulti(key) {
+		for _, value := range strings.Split(string(raw), ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// formBool parses an optional boolean form field, returning nil when it is
+// absent
+func formBool(c *fiber.Ctx, key string) (*bool, error) {
+	raw := c.FormValue(key)
+	if raw == "" {
+		return nil, nil
+	}
+	on, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return &on, nil
+}
+
+// isFormEncoded reports whether the request body is declared as form-encoded
+func isFormEncoded(c *fiber.Ctx) bool {
+	mediaType, _, err := mime.ParseMediaType(c.Get(fiber.HeaderContentType))
+	return err == nil && mediaType == fiber.MIMEApplicationForm
+}
+
+// requireAnalyzeBody accepts JSON, plus form-encoded bodies when form input
+// is enabled
+func (s *server) requireAnalyzeBody(c *fiber.Ctx) error {
+	if s.cfg.AcceptFormInput && isFormEncoded(c) {
+		return c.Next()
+	}
+	return requireJSON(c)
+}
+
+// requireJSON rejects request bodies that are not declared as application/json
+func requireJSON(c *fiber.Ctx) error {
+	mediaType, _, err := mime.ParseMediaType(c.Get(fiber.HeaderContentType))
+	if err != nil || mediaType != fiber.MIMEApplicationJSON {
+		return sendError(c, fiber.StatusUnsupportedMediaType, adk.MsgUnsupportedMediaType)
+	}
+	return c.Next()
+}
+
+// getEnv reads an environment variable or returns a default value
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvAsInt reads an environment variable as an integer
+func getEnvAsInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		intVal, err := strconv.Atoi(value)
+		if err == nil {
+			return intVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsFloat reads an environment variable as a float
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		floatVal, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsBool reads an environment variable as a boolean
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		boolVal, err := strconv.ParseBool(value)
+		if err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsDuration reads an environment variable as a time.Duration
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		durationVal, err := time.ParseDuration(value)
+		if err == nil {
+			return durationVal
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,1103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+	competitorv1 "github.com/mk-knight23/ai-sdk-openai/proto/competitor/v1"
+)
+
+// TestAnalyzeEndpoint_ConcurrencyLimit tests that a saturated semaphore yields 503
+func TestAnalyzeEndpoint_ConcurrencyLimit(t *testing.T) {
+	s := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{MaxConcurrentAnalyses: 1})
+	app := s.routes()
+
+	// Occupy the only slot as if another analysis were in flight
+	s.analyses <- struct{}{}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503, got %d", resp.StatusCode)
+	}
+
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("Expected Retry-After header on 503")
+	}
+
+	// Health must stay available while analyses are saturated
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/health", nil))
+	if err != nil {
+		t.Fatalf("Failed to test health endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected health status 200, got %d", resp.StatusCode)
+	}
+
+	// Releasing the slot lets analyses through again
+	<-s.analyses
+
+	req = httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 after release, got %d", resp.StatusCode)
+	}
+}
+
+// TestAnalyzeEndpoint_UnsupportedMediaType tests that non-JSON bodies are rejected
+func TestAnalyzeEndpoint_UnsupportedMediaType(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		name           string
+		contentType    string
+		expectedStatus int
+	}{
+		{name: "Plain text", contentType: "text/plain", expectedStatus: http.StatusUnsupportedMediaType},
+		{name: "Missing content type", contentType: "", expectedStatus: http.StatusUnsupportedMediaType},
+		{name: "JSON with charset", contentType: "application/json; charset=utf-8", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_FailureInjection tests that injected stage failures map to statuses
+func TestAnalyzeEndpoint_FailureInjection(t *testing.T) {
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(adk.NewMemoryReportStore()))
+	app := newServer(agent, serverConfig{EnableFailureInjection: true, Environment: "test"}).routes()
+
+	tests := []struct {
+		stage          string
+		expectedStatus int
+	}{
+		{stage: adk.StageResearch, expectedStatus: http.StatusBadGateway},
+		{stage: adk.StageAnalysis, expectedStatus: http.StatusInternalServerError},
+		{stage: adk.StageReport, expectedStatus: http.StatusInternalServerError},
+		{stage: adk.StagePersistence, expectedStatus: http.StatusInternalServerError},
+		{stage: "bogus", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.stage, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Inject-Failure", tt.stage)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_FailureInjectionGated tests that injection is ignored
+// unless enabled in an explicit development or test environment
+func TestAnalyzeEndpoint_FailureInjectionGated(t *testing.T) {
+	configs := map[string]serverConfig{
+		"disabled":   {Environment: "development"},
+		"unset":      {EnableFailureInjection: true},
+		"staging":    {EnableFailureInjection: true, Environment: "staging"},
+		"production": {EnableFailureInjection: true, Environment: "production"},
+	}
+
+	for name, cfg := range configs {
+		t.Run(name, func(t *testing.T) {
+			app := newServer(adk.NewCompetitorIntelligenceAgent(), cfg).routes()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Inject-Failure", adk.StageResearch)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected injection to be ignored with status 200, got %d", resp.StatusCode)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_MultipleIndustries tests that an industries array tags competitors
+func TestAnalyzeEndpoint_MultipleIndustries(t *testing.T) {
+	app := setupTestApp()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industries":["SaaS","Fintech"]}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if len(report.Competitors) != 3 {
+		t.Fatalf("Expected 3 merged competitors, got %d", len(report.Competitors))
+	}
+
+	for _, analysis := range report.Competitors {
+		if len(analysis.Industries) != 2 {
+			t.Errorf("%s: expected 2 industries, got %v", analysis.CompetitorName, analysis.Industries)
+		}
+	}
+}
+
+// TestAnalyzeEndpoint_ExportFormats tests rendering the report in each export format
+func TestAnalyzeEndpoint_ExportFormats(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		format         string
+		expectedStatus int
+		contentType    string
+		contains       string
+	}{
+		{format: "markdown", expectedStatus: http.StatusOK, contentType: "text/markdown", contains: "# Competitive Intelligence Report: TestCorp"},
+		{format: "csv", expectedStatus: http.StatusOK, contentType: "text/csv", contains: "competitor_name,threat_level"},
+		{format: "sheets", expectedStatus: http.StatusOK, contentType: "text/csv", contains: "Competitor,Rank,Threat Level"},
+		{format: "json", expectedStatus: http.StatusOK, contentType: "application/json", contains: `"target_company": "TestCorp"`},
+		{format: "pdf", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze?format="+tt.format, bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if !strings.HasPrefix(resp.Header.Get("Content-Type"), tt.contentType) {
+				t.Errorf("Expected content type %s, got %s", tt.contentType, resp.Header.Get("Content-Type"))
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if !strings.Contains(string(body), tt.contains) {
+				t.Errorf("Expected body to contain %q", tt.contains)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_SourceFreshness tests per-source freshness in the meta envelope and header
+func TestAnalyzeEndpoint_SourceFreshness(t *testing.T) {
+	app := setupTestApp()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if !strings.HasPrefix(resp.Header.Get("X-Sources-Last-Updated"), "stub=") {
+		t.Errorf("Expected stub source freshness header, got %q", resp.Header.Get("X-Sources-Last-Updated"))
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if _, ok := report.SourceLastUpdated()["stub"]; !ok {
+		t.Errorf("Expected stub source in meta, got %+v", report.Meta)
+	}
+}
+
+// TestAnalyzeEndpoint_PartialResults tests that a report-stage failure yields 206 with analyses
+func TestAnalyzeEndpoint_PartialResults(t *testing.T) {
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithPartialResults())
+	app := newServer(agent, serverConfig{EnableFailureInjection: true, Environment: "test"}).routes()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Inject-Failure", adk.StageReport)
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusPartialContent {
+		t.Fatalf("Expected status 206, got %d", resp.StatusCode)
+	}
+	if !strings.HasPrefix(resp.Header.Get("X-Partial-Error"), "report generation failed") {
+		t.Errorf("Expected partial error header, got %q", resp.Header.Get("X-Partial-Error"))
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if !report.Partial || len(report.Competitors) != 3 {
+		t.Errorf("Expected partial report with 3 analyses, got partial=%v competitors=%d", report.Partial, len(report.Competitors))
+	}
+}
+
+// TestAnalyzeEndpoint_OrderBy tests per-request competitor ordering and validation
+func TestAnalyzeEndpoint_OrderBy(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		orderBy        string
+		expectedStatus int
+		first          string
+	}{
+		{orderBy: "name", expectedStatus: http.StatusOK, first: "Competitor A"},
+		{orderBy: "share", expectedStatus: http.StatusOK, first: "Competitor A"},
+		{orderBy: "popularity", expectedStatus: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			body := `{"company_name":"TestCorp","industry":"SaaS","order_by":"` + tt.orderBy + `"}`
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var report adk.CompetitorReport
+			if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			if report.Competitors[0].CompetitorName != tt.first {
+				t.Errorf("Expected %s first, got %s", tt.first, report.Competitors[0].CompetitorName)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_Persona tests per-request personas and validation
+func TestAnalyzeEndpoint_Persona(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		persona        string
+		expectedStatus int
+	}{
+		{persona: "investor", expectedStatus: http.StatusOK},
+		{persona: "auditor", expectedStatus: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.persona, func(t *testing.T) {
+			body := `{"company_name":"TestCorp","industry":"SaaS","persona":"` + tt.persona + `"}`
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze?format=markdown", bytes.NewReader([]byte(body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			markdown, _ := io.ReadAll(resp.Body)
+			if !strings.Contains(string(markdown), "**Funding:**") {
+				t.Error("Expected the investor layout to show funding")
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_CompetitorValidation tests that every invalid field is reported with its path
+func TestAnalyzeEndpoint_CompetitorValidation(t *testing.T) {
+	app := setupTestApp()
+
+	body := `{"company_name":"TestCorp","industry":"SaaS","competitors":[
+		{"name":"Good","market_share":10},
+		{"name":"","market_share":10},
+		{"name":"Bad","market_share":-3}
+	]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected status 422, got %d", resp.StatusCode)
+	}
+
+	var got struct {
+		Errors []adk.FieldError `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	paths := make([]string, len(got.Errors))
+	for i, fieldErr := range got.Errors {
+		paths[i] = fieldErr.Path
+	}
+	if strings.Join(paths, ",") != "competitors[1].name,competitors[2].market_share" {
+		t.Errorf("Expected both problems with paths, got %v", paths)
+	}
+}
+
+// TestAnalyzeEndpoint_ReportTimezone tests that REPORT_TIMEZONE sets the serialized offset
+func TestAnalyzeEndpoint_ReportTimezone(t *testing.T) {
+	if _, err := buildAgent(serverConfig{Timezone: "Nowhere/Special"}); err == nil {
+		t.Error("Expected an unknown timezone to be rejected")
+	}
+
+	agent, err := buildAgent(serverConfig{Timezone: "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("Failed to build agent: %v", err)
+	}
+	app := newServer(agent, serverConfig{}).routes()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	var body struct {
+		GeneratedAt string `json:"generated_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if !strings.HasSuffix(body.GeneratedAt, "+09:00") {
+		t.Errorf("Expected generated_at in +09:00, got %s", body.GeneratedAt)
+	}
+}
+
+// TestAnalyzeEndpoint_InferredIndustry tests that a known company analyzed
+// without an industry is researched in its looked-up industry
+func TestAnalyzeEndpoint_InferredIndustry(t *testing.T) {
+	if _, err := buildAgent(serverConfig{IndustryLookup: "Stripe"}); err == nil {
+		t.Error("Expected a malformed industry lookup to be rejected")
+	}
+
+	agent, err := buildAgent(serverConfig{IndustryLookup: "Stripe=Fintech"})
+	if err != nil {
+		t.Fatalf("Failed to build agent: %v", err)
+	}
+	app := newServer(agent, serverConfig{}).routes()
+
+	tests := []struct {
+		name       string
+		body       string
+		wantSource string
+		wantFirst  string
+	}{
+		{name: "Inferred", body: `{"company_name":"Stripe"}`, wantSource: "inferred", wantFirst: "Fintech"},
+		{name: "Provided", body: `{"company_name":"Stripe","industry":"Payments"}`, wantSource: "provided", wantFirst: "Payments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+			}
+
+			var body struct {
+				Industries     []string `json:"industries"`
+				IndustrySource string   `json:"industry_source"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			if body.IndustrySource != tt.wantSource || len(body.Industries) == 0 || body.Industries[0] != tt.wantFirst {
+				t.Errorf("Expected %s industry %s, got %+v", tt.wantSource, tt.wantFirst, body)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_FormInput tests that HTML form posts produce the same report as JSON
+func TestAnalyzeEndpoint_FormInput(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptForm     bool
+		expectedStatus int
+	}{
+		{name: "Enabled", acceptForm: true, expectedStatus: http.StatusOK},
+		{name: "Disabled", acceptForm: false, expectedStatus: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{AcceptFormInput: tt.acceptForm}).routes()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", strings.NewReader("company_name=TestCorp&industry=SaaS"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var report adk.CompetitorReport
+			if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			if report.TargetCompany != "TestCorp" || len(report.Competitors) == 0 {
+				t.Errorf("Expected a TestCorp report with competitors, got %q with %d", report.TargetCompany, len(report.Competitors))
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_FormOptions tests that form-encoded requests honor
+// highlight and with_rationale like JSON ones
+func TestAnalyzeEndpoint_FormOptions(t *testing.T) {
+	app := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{AcceptFormInput: true}).routes()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		wantHighlights []string
+		wantRationale  bool
+		wantIndustries int
+	}{
+		{name: "Defaults", body: "company_name=TestCorp&industry=SaaS", expectedStatus: http.StatusOK},
+		{name: "Repeated highlight", body: "company_name=TestCorp&industry=SaaS&highlight=Competitor+B&highlight=Competitor+C", expectedStatus: http.StatusOK, wantHighlights: []string{"Competitor B", "Competitor C"}},
+		{name: "Comma-separated highlight", body: "company_name=TestCorp&industry=SaaS&highlight=Competitor+C,+Competitor+B", expectedStatus: http.StatusOK, wantHighlights: []string{"Competitor B", "Competitor C"}},
+		{name: "Rationale", body: "company_name=TestCorp&industry=SaaS&with_rationale=true", expectedStatus: http.StatusOK, wantRationale: true},
+		{name: "Malformed rationale", body: "company_name=TestCorp&industry=SaaS&with_rationale=maybe", expectedStatus: http.StatusBadRequest},
+		{name: "Industries", body: "company_name=TestCorp&industries=SaaS&industries=Fintech", expectedStatus: http.StatusOK, wantIndustries: 2},
+		{name: "Comma-separated industries", body: "company_name=TestCorp&industries=SaaS,+Fintech", expectedStatus: http.StatusOK, wantIndustries: 2},
+		{name: "Anonymize", body: "company_name=TestCorp&industry=SaaS&anonymize=1", expectedStatus: http.StatusOK},
+		{name: "Malformed anonymize", body: "company_name=TestCorp&industry=SaaS&anonymize=yes", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var report adk.CompetitorReport
+			if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			var highlighted []string
+			for _, analysis := range report.Competitors {
+				if analysis.Highlighted {
+					highlighted = append(highlighted, analysis.CompetitorName)
+				}
+			}
+			slices.Sort(highlighted)
+			if !slices.Equal(highlighted, tt.wantHighlights) {
+				t.Errorf("Expected highlights %v, got %v", tt.wantHighlights, highlighted)
+			}
+			if tt.wantIndustries > 0 {
+				for _, analysis := range report.Competitors {
+					if len(analysis.Industries) != tt.wantIndustries {
+						t.Errorf("%s: expected %d industries, got %v", analysis.CompetitorName, tt.wantIndustries, analysis.Industries)
+					}
+				}
+			}
+			for _, rec := range report.RecommendationDetails {
+				if (rec.Rationale != "") != tt.wantRationale {
+					t.Errorf("Expected rationale present = %v for %q, got %q", tt.wantRationale, rec.Text, rec.Rationale)
+				}
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_ProductsContains tests filtering competitors by product keyword
+func TestAnalyzeEndpoint_ProductsContains(t *testing.T) {
+	app := setupTestApp()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS","products_contains":"enterprise"}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if len(report.Competitors) != 1 || report.Competitors[0].CompetitorName != "Competitor C" {
+		t.Errorf("Expected only Competitor C, got %+v", report.Competitors)
+	}
+	if report.ExcludedByProduct != 2 {
+		t.Errorf("Expected 2 excluded competitors, got %d", report.ExcludedByProduct)
+	}
+}
+
+// TestAnalyzeEndpoint_Highlight tests pinning requested competitors to the top
+func TestAnalyzeEndpoint_Highlight(t *testing.T) {
+	app := setupTestApp()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS","highlight":["competitor c"]}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if len(report.Competitors) == 0 || report.Competitors[0].CompetitorName != "Competitor C" || !report.Competitors[0].Highlighted {
+		t.Fatalf("Expected Competitor C highlighted first, got %+v", report.Competitors)
+	}
+	for _, analysis := range report.Competitors[1:] {
+		if analysis.Highlighted {
+			t.Errorf("Expected only Competitor C highlighted, got %s", analysis.CompetitorName)
+		}
+	}
+}
+
+// TestAnalyzeEndpoint_Anonymize tests pseudonyms and that only API key holders get the mapping
+func TestAnalyzeEndpoint_Anonymize(t *testing.T) {
+	app := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{APIKey: "secret"}).routes()
+
+	tests := []struct {
+		name        string
+		apiKey      string
+		wantMapping bool
+	}{
+		{name: "Anonymous caller", apiKey: "", wantMapping: false},
+		{name: "Wrong key", apiKey: "guess", wantMapping: false},
+		{name: "Authorized caller", apiKey: "secret", wantMapping: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS","anonymize":true}`)))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if strings.Contains(string(body), "Competitor A") != tt.wantMapping {
+				t.Errorf("Expected real names present only in the mapping for authorized callers")
+			}
+
+			var report adk.CompetitorReport
+			if err := json.Unmarshal(body, &report); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			for _, analysis := range report.Competitors {
+				if !strings.HasPrefix(analysis.CompetitorName, "Competitor ") || len(analysis.CompetitorName) < len("Competitor 1") {
+					t.Errorf("Expected a pseudonym, got %q", analysis.CompetitorName)
+				}
+			}
+			if (report.Pseudonyms != nil) != tt.wantMapping {
+				t.Errorf("Expected mapping disclosed = %v, got %v", tt.wantMapping, report.Pseudonyms)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_AnonymizeNotStored tests that an anonymized run leaves
+// no stored report to look up by ID or search
+func TestAnalyzeEndpoint_AnonymizeNotStored(t *testing.T) {
+	store := adk.NewMemoryReportStore()
+	app := newServer(adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store)), serverConfig{}).routes()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS","anonymize":true}`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if report.ID != "" {
+		t.Errorf("Expected no report ID, got %q", report.ID)
+	}
+	if stored, _ := store.List(context.Background(), "TestCorp"); len(stored) != 0 {
+		t.Errorf("Expected the anonymized run not stored, got %d reports", len(stored))
+	}
+}
+
+// TestAnalyzeEndpoint_OutputProfile tests export redaction per output profile
+func TestAnalyzeEndpoint_OutputProfile(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		name        string
+		profile     string
+		wantStatus  int
+		wantFunding bool
+	}{
+		{name: "Default", wantStatus: http.StatusOK, wantFunding: true},
+		{name: "Internal", profile: "internal", wantStatus: http.StatusOK, wantFunding: true},
+		{name: "Public", profile: "public", wantStatus: http.StatusOK, wantFunding: false},
+		{name: "Unknown", profile: "partner", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze?profile="+tt.profile, bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if strings.Contains(string(body), `"funding"`) != tt.wantFunding {
+				t.Errorf("Expected funding present = %v, got %s", tt.wantFunding, body)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_ProfileRequiresKey tests that only API key holders
+// may request a profile that reveals more than the default
+func TestAnalyzeEndpoint_ProfileRequiresKey(t *testing.T) {
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithOutputProfile(adk.ProfilePublic))
+	app := newServer(agent, serverConfig{APIKey: "secret"}).routes()
+
+	tests := []struct {
+		name        string
+		profile     string
+		apiKey      string
+		wantStatus  int
+		wantFunding bool
+	}{
+		{name: "Default", wantStatus: http.StatusOK},
+		{name: "Same profile", profile: "public", wantStatus: http.StatusOK},
+		{name: "Less restrictive without key", profile: "internal", wantStatus: http.StatusForbidden},
+		{name: "Less restrictive with wrong key", profile: "internal", apiKey: "guess", wantStatus: http.StatusForbidden},
+		{name: "Less restrictive with key", profile: "internal", apiKey: "secret", wantStatus: http.StatusOK, wantFunding: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze?profile="+tt.profile, bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if strings.Contains(string(body), `"funding"`) != tt.wantFunding {
+				t.Errorf("Expected funding present = %v, got %s", tt.wantFunding, body)
+			}
+		})
+	}
+}
+
+// TestAnalyzeEndpoint_LocalizedErrors tests localized messages with stable codes
+func TestAnalyzeEndpoint_LocalizedErrors(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "English by default", path: "/api/analyze", want: "Invalid request body"},
+		{name: "Spanish header", path: "/api/analyze", acceptLanguage: "es-ES,es;q=0.9,en;q=0.5", want: "Cuerpo de la solicitud no válido"},
+		{name: "Language parameter", path: "/api/analyze?lang=es", acceptLanguage: "en", want: "Cuerpo de la solicitud no válido"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader([]byte(`{not json`)))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("Expected status 400, got %d", resp.StatusCode)
+			}
+
+			var result map[string]string
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("Failed to decode error: %v", err)
+			}
+			if result["error"] != tt.want {
+				t.Errorf("Expected message %q, got %q", tt.want, result["error"])
+			}
+			if result["code"] != adk.MsgInvalidRequestBody {
+				t.Errorf("Expected code %q, got %q", adk.MsgInvalidRequestBody, result["code"])
+			}
+		})
+	}
+}
+
+// TestStartReportCleanup tests that the cleanup job expires reports and stops with its context
+func TestStartReportCleanup(t *testing.T) {
+	store := adk.NewMemoryReportStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	store.Save(ctx, &adk.CompetitorReport{ID: "old", GeneratedAt: time.Now().Add(-48 * time.Hour)})
+	store.Save(ctx, &adk.CompetitorReport{ID: "fresh", GeneratedAt: time.Now()})
+
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store))
+	jobs := startReportCleanup(ctx, agent, serverConfig{ReportRetention: 24 * time.Hour, ReportCleanupInterval: time.Hour})
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, err := store.Get(ctx, "old"); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the expired report to be deleted")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if _, err := store.Get(ctx, "fresh"); err != nil {
+		t.Errorf("Expected the fresh report to be retained, got %v", err)
+	}
+
+	cancel()
+	stopped := make(chan struct{})
+	go func() {
+		jobs.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the cleanup job to stop on shutdown")
+	}
+}
+
+// TestAnalyzeEndpoint_Protobuf tests that Accept: application/protobuf selects protobuf output
+func TestAnalyzeEndpoint_Protobuf(t *testing.T) {
+	app := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{}).routes()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/protobuf")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test analyze endpoint: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "application/protobuf" {
+		t.Errorf("Expected protobuf content type, got %q", contentType)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	msg := &competitorv1.CompetitorReport{}
+	if err := proto.Unmarshal(body, msg); err != nil {
+		t.Fatalf("Failed to decode protobuf response: %v", err)
+	}
+	if msg.GetTargetCompany() != "TestCorp" || len(msg.GetCompetitors()) == 0 {
+		t.Errorf("Expected a TestCorp report with competitors, got %v", msg)
+	}
+}
+
+// TestAnalyzeEndpoint_YAML tests that YAML Accept headers select YAML output
+func TestAnalyzeEndpoint_YAML(t *testing.T) {
+	app := newServer(adk.NewCompetitorIntelligenceAgent(), serverConfig{}).routes()
+
+	for _, accept := range []string{"application/yaml", "application/x-yaml", "text/yaml"} {
+		t.Run(accept, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS"}`)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", accept)
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+			}
+			if contentType := resp.Header.Get("Content-Type"); contentType != "application/yaml" {
+				t.Errorf("Expected YAML content type, got %q", contentType)
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			report, err := adk.ReportFromYAML(body)
+			if err != nil {
+				t.Fatalf("Failed to decode YAML response: %v", err)
+			}
+			if report.TargetCompany != "TestCorp" || len(report.Competitors) == 0 {
+				t.Errorf("Expected a TestCorp report with competitors, got:\n%s", body)
+			}
+		})
+	}
+}
+
+func TestAnalyzeEndpoint_WithRationale(t *testing.T) {
+	agent, err := buildAgent(serverConfig{})
+	if err != nil {
+		t.Fatalf("Failed to build agent: %v", err)
+	}
+	app := newServer(agent, serverConfig{}).routes()
+
+	tests := []struct {
+		name          string
+		body          string
+		wantRationale bool
+	}{
+		{name: "Requested", body: `{"company_name":"TestCorp","industry":"SaaS","with_rationale":true}`, wantRationale: true},
+		{name: "Omitted", body: `{"company_name":"TestCorp","industry":"SaaS"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test analyze endpoint: %v", err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+			}
+
+			var body struct {
+				RecommendationDetails []adk.Recommendation `json:"recommendation_details"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode report: %v", err)
+			}
+			if len(body.RecommendationDetails) == 0 {
+				t.Fatal("Expected recommendations")
+			}
+			for _, rec := range body.RecommendationDetails {
+				if (rec.Rationale != "") != tt.wantRationale {
+					t.Errorf("Expected rationale present = %v for %q, got %q", tt.wantRationale, rec.Text, rec.Rationale)
+				}
+			}
+		})
+	}
+}
+
+// TestBuildAgent_MemoryStoreLimit tests that the default in-memory store
+// keeps only the configured number of reports
+func TestBuildAgent_MemoryStoreLimit(t *testing.T) {
+	agent, err := buildAgent(serverConfig{MemoryStoreMaxReports: 2})
+	if err != nil {
+		t.Fatalf("Failed to build agent: %v", err)
+	}
+	for range 3 {
+		if _, err := agent.Run(context.Background(), "TestCorp", "SaaS"); err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+	}
+
+	stored, err := agent.Store().List(context.Background(), "")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(stored) != 2 {
+		t.Errorf("Expected 2 stored reports, got %d", len(stored))
+	}
+}
+
+// TestConfigSettings tests that every serverConfig field has exactly one
+// setting, defaults match field types, and .env.example lists every setting
+func TestConfigSettings(t *testing.T) {
+	example, err := os.ReadFile(".env.example")
+	if err != nil {
+		t.Fatalf("Failed to read .env.example: %v", err)
+	}
+
+	var cfg serverConfig
+	covered := make(map[uintptr]string)
+	envs := make(map[string]bool)
+	keys := make(map[string]bool)
+	for _, setting := range configSettings {
+		field := reflect.ValueOf(setting.field(&cfg))
+		if prev, ok := covered[field.Pointer()]; ok {
+			t.Errorf("Expected one setting per field, got %s and %s", prev, setting.env)
+		}
+		covered[field.Pointer()] = setting.env
+
+		if setting.def != nil && reflect.TypeOf(setting.def) != field.Elem().Type() {
+			t.Errorf("Expected %s default of type %s, got %T", setting.env, field.Elem().Type(), setting.def)
+		}
+		if envs[setting.env] || keys[setting.key] {
+			t.Errorf("Expected unique env and key, got duplicate %s (%s)", setting.env, setting.key)
+		}
+		envs[setting.env], keys[setting.key] = true, true
+
+		if !strings.Contains("\n"+string(example), "\n"+setting.env+"=") {
+			t.Errorf("Expected .env.example to list %s", setting.env)
+		}
+	}
+
+	value := reflect.ValueOf(&cfg).Elem()
+	for i := range value.NumField() {
+		if _, ok := covered[value.Field(i).Addr().Pointer()]; !ok {
+			t.Errorf("Expected a setting for serverConfig.%s", value.Type().Field(i).Name)
+		}
+	}
+}
+
+// TestLoadServerConfig tests that settings read the environment and fall
+// back to their defaults
+func TestLoadServerConfig(t *testing.T) {
+	t.Setenv("MAX_CONCURRENT_ANALYSES", "4")
+	t.Setenv("NEWS_TIMEOUT", "5s")
+	t.Setenv("ACCEPT_FORM_INPUT", "false")
+	t.Setenv("MAX_FIELD_LENGTH_CSV", "80")
+	t.Setenv("BATCH_MAX_CONCURRENCY", "many")
+
+	cfg := loadServerConfig()
+	if cfg.MaxConcurrentAnalyses != 4 || cfg.NewsTimeout != 5*time.Second || cfg.AcceptFormInput {
+		t.Errorf("Expected environment values to be loaded, got %+v", cfg)
+	}
+	if cfg.maxFieldLengths()[adk.FormatCSV] != 80 {
+		t.Errorf("Expected CSV field limit 80, got %v", cfg.maxFieldLengths())
+	}
+	if cfg.BatchMaxConcurrency != 16 || cfg.Port != "8080" || cfg.ReportCleanupInterval != time.Hour {
+		t.Errorf("Expected defaults for unset and malformed values, got %+v", cfg)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// WhatIfOverrides are analysis settings replaced for a single what-if run;
+// omitted fields keep the server defaults
+type WhatIfOverrides struct {
+	HighThreatShare    *float64 `json:"high_threat_share,omitempty"`
+	MediumThreatShare  *float64 `json:"medium_threat_share,omitempty"`
+	MinThreatLevel     *string  `json:"min_threat_level,omitempty"`
+	EmergingGrowthRate *float64 `json:"emerging_growth_rate,omitempty"`
+	MinRecommendations *int     `json:"min_recommendations,omitempty"`
+	InferPositioning   *bool    `json:"infer_positioning,omitempty"`
+	NormalizeShares    *bool    `json:"normalize_shares,omitempty"`
+}
+
+// WhatIfRequest is an analyze request plus the overrides to apply
+type WhatIfRequest struct {
+	AnalyzeRequest
+	Overrides WhatIfOverrides `json:"overrides"`
+}
+
+// options validates the overrides and converts them to agent options
+func (o WhatIfOverrides) options(defaults adk.Config) ([]adk.Option, error) {
+	var opts []adk.Option
+
+	if o.HighThreatShare != nil || o.MediumThreatShare != nil {
+		high, medium := defaults.HighThreatShare, defaults.MediumThreatShare
+		if o.HighThreatShare != nil {
+			high = *o.HighThreatShare
+		}
+		if o.MediumThreatShare != nil {
+			medium = *o.MediumThreatShare
+		}
+		if err := adk.ValidateThreatShares(high, medium); err != nil {
+			return nil, err
+		}
+		opts = append(opts, adk.WithThreatShares(high, medium))
+	}
+	if o.MinThreatLevel != nil {
+		level := ""
+		if *o.MinThreatLevel != "" {
+			parsed, err := adk.ParseThreatLevel(*o.MinThreatLevel)
+			if err != nil {
+				return nil, err
+			}
+			level = parsed
+		}
+		opts = append(opts, func(a *adk.CompetitorIntelligenceAgent) {
+			a.Config.MinThreatLevel = level
+		})
+	}
+	if o.EmergingGrowthRate != nil {
+		opts = append(opts, adk.WithEmergingGrowthRate(*o.EmergingGrowthRate))
+	}
+	if o.MinRecommendations != nil {
+		opts = append(opts, adk.WithMinRecommendations(*o.MinRecommendations))
+	}
+	if o.InferPositioning != nil {
+		infer := *o.InferPositioning
+		opts = append(opts, func(a *adk.CompetitorIntelligenceAgent) {
+			a.Config.InferPositioning = infer
+		})
+	}
+	if o.NormalizeShares != nil {
+		normalize := *o.NormalizeShares
+		opts = append(opts, func(a *adk.CompetitorIntelligenceAgent) {
+			a.Config.NormalizeShares = normalize
+		})
+	}
+	return opts, nil
+}
+
+// analyzeWhatIf runs an analysis with overridden thresholds and settings on a
+// detached copy of the agent, leaving server defaults and stored history alone
+func (s *server) analyzeWhatIf(c *fiber.Ctx) error {
+	req := validatedRequest[*WhatIfRequest](c)
+
+	// Overrides are checked again against the server's own thresholds
+	opts, err := req.Overrides.options(s.agent.Config)
+	if err != nil {
+		return sendValidationErrors(c, adk.ValidationErrors{fieldError("overrides", err)})
+	}
+
+	report, err := runAnalysisWith(c.UserContext(), s.agent.WithOverrides(opts...), &req.AnalyzeRequest)
+	if err != nil {
+		return sendPipelineError(c, err)
+	}
+
+	return s.sendReport(c, report)
+}
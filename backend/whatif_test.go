@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mk-knight23/ai-sdk-openai/adk"
+)
+
+// threatCounts posts body to path and tallies competitors per threat level
+func threatCounts(t *testing.T, app *fiber.App, path, body string) map[string]int {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Failed to test %s: %v", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200 from %s, got %d", path, resp.StatusCode)
+	}
+
+	var report adk.CompetitorReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	counts := make(map[string]int)
+	for _, analysis := range report.Competitors {
+		counts[analysis.ThreatLevel]++
+	}
+	return counts
+}
+
+// TestAnalyzeWhatIfEndpoint tests that overrides change threat distribution
+// without touching server defaults or stored history
+func TestAnalyzeWhatIfEndpoint(t *testing.T) {
+	store := adk.NewMemoryReportStore()
+	agent := adk.NewCompetitorIntelligenceAgent(adk.WithReportStore(store))
+	app := newServer(agent, serverConfig{}).routes()
+
+	tests := []struct {
+		name      string
+		overrides string
+		want      map[string]int
+	}{
+		{name: "No overrides", overrides: `{}`, want: map[string]int{"High": 1, "Medium": 2}},
+		{name: "High threat from 15%", overrides: `{"high_threat_share":15}`, want: map[string]int{"High": 2, "Medium": 1}},
+		{name: "Medium threat from 13%", overrides: `{"medium_threat_share":13}`, want: map[string]int{"High": 1, "Medium": 1, "Low": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := threatCounts(t, app, "/api/analyze/whatif", `{"company_name":"TestCorp","industry":"SaaS","overrides":`+tt.overrides+`}`)
+			for level, count := range tt.want {
+				if got[level] != count {
+					t.Errorf("Expected %d %s threats, got %v", count, level, got)
+				}
+			}
+		})
+	}
+
+	if got := threatCounts(t, app, "/api/analyze", `{"company_name":"TestCorp","industry":"SaaS"}`); got["High"] != 1 || got["Medium"] != 2 {
+		t.Errorf("Expected server defaults unchanged after what-if runs, got %v", got)
+	}
+	if agent.Config.HighThreatShare != 0 {
+		t.Errorf("Expected the agent config untouched, got %v", agent.Config.HighThreatShare)
+	}
+
+	history, err := store.List(context.Background(), "TestCorp")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(history) != 1 {
+		t.Errorf("Expected only the regular analysis stored, got %d reports", len(history))
+	}
+}
+
+// TestAnalyzeWhatIfEndpoint_InvalidOverrides tests override validation
+func TestAnalyzeWhatIfEndpoint_InvalidOverrides(t *testing.T) {
+	app := setupTestApp()
+
+	tests := []struct {
+		name      string
+		overrides string
+	}{
+		{name: "Medium above high", overrides: `{"high_threat_share":8}`},
+		{name: "Out of range", overrides: `{"high_threat_share":120}`},
+		{name: "Unknown threat level", overrides: `{"min_threat_level":"severe"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/analyze/whatif", bytes.NewReader([]byte(`{"company_name":"TestCorp","industry":"SaaS","overrides":`+tt.overrides+`}`)))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("Failed to test what-if endpoint: %v", err)
+			}
+			if resp.StatusCode != http.StatusUnprocessableEntity {
+				t.Errorf("Expected status 422, got %d", resp.StatusCode)
+			}
+		})
+	}
+}